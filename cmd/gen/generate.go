@@ -0,0 +1,391 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// apiPrefix is the prefix of paths in spec which is included in the client base URL
+const apiPrefix = "/api/v2"
+
+// initialisms are name parts written in upper case as golint suggests
+var initialisms = map[string]bool{
+	"api": true, "cc": true, "html": true, "id": true, "ids": true, "ip": true,
+	"json": true, "sla": true, "ssl": true, "uri": true, "url": true, "uuid": true,
+}
+
+// goName converts snake_case name to Go identifier
+func goName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == '.' }) {
+		switch {
+		case part == "ids":
+			b.WriteString("IDs")
+		case initialisms[part]:
+			b.WriteString(strings.ToUpper(part))
+		default:
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+type fieldDef struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+type structDef struct {
+	Name   string
+	Source string
+	Doc    string
+	Fields []fieldDef
+}
+
+type operationDef struct {
+	ID      string
+	Summary string
+}
+
+// resourceDef is the model passed to the template
+type resourceDef struct {
+	TypeName       string
+	PluralTypeName string
+	Singular       string
+	VarName        string
+	Plural         string
+	CollectionPath string
+	MemberPath     string
+	List           *operationDef
+	Create         *operationDef
+	Show           *operationDef
+	Update         *operationDef
+	Delete         *operationDef
+	Structs        []structDef
+}
+
+// buildResource collects operations and schemas of the resource from spec
+func (s *spec) buildResource(resource string) (*resourceDef, error) {
+	collectionPath := apiPrefix + "/" + strings.Trim(resource, "/")
+	collection, ok := s.Paths[collectionPath]
+	if !ok {
+		return nil, fmt.Errorf("path %s is not defined in spec", collectionPath)
+	}
+
+	var member map[string]*operation
+	var memberPath string
+	for path, ops := range s.Paths {
+		rest := strings.TrimPrefix(path, collectionPath+"/")
+		if rest != path && strings.HasPrefix(rest, "{") && strings.HasSuffix(rest, "}") {
+			member, memberPath = ops, path
+			break
+		}
+	}
+
+	res := &resourceDef{
+		CollectionPath: strings.TrimPrefix(collectionPath, apiPrefix),
+		Plural:         resource,
+		PluralTypeName: goName(resource),
+	}
+	if memberPath != "" {
+		res.MemberPath = strings.TrimPrefix(memberPath[:strings.LastIndex(memberPath, "/")], apiPrefix)
+	}
+
+	opDef := func(op *operation) *operationDef {
+		if op == nil {
+			return nil
+		}
+		return &operationDef{ID: op.OperationID, Summary: op.Summary}
+	}
+	res.List = opDef(collection["get"])
+	res.Create = opDef(collection["post"])
+	res.Show = opDef(member["get"])
+	res.Update = opDef(member["put"])
+	res.Delete = opDef(member["delete"])
+
+	// the resource object is the only member of show or create response
+	var wrapper *schema
+	var err error
+	switch {
+	case member["get"] != nil:
+		wrapper, err = s.responseSchema(member["get"])
+	case collection["post"] != nil:
+		wrapper, err = s.responseSchema(collection["post"])
+	default:
+		return nil, fmt.Errorf("%s has neither show nor create operation to find the resource schema", resource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if wrapper == nil || len(wrapper.Properties) != 1 {
+		return nil, fmt.Errorf("response of %s does not have a single resource object", resource)
+	}
+
+	for key, prop := range wrapper.Properties {
+		res.Singular = key
+		res.TypeName = goName(key)
+		res.VarName = strings.ToLower(res.TypeName[:1]) + res.TypeName[1:]
+
+		g := &structGenerator{spec: s, done: map[string]bool{}}
+		if err := g.generate(res.TypeName, key, prop); err != nil {
+			return nil, err
+		}
+		res.Structs = g.structs
+	}
+	return res, nil
+}
+
+// structGenerator generates struct definitions from object schemas
+type structGenerator struct {
+	spec    *spec
+	structs []structDef
+	done    map[string]bool
+}
+
+func (g *structGenerator) generate(name string, source string, sc *schema) error {
+	if g.done[name] {
+		return nil
+	}
+	g.done[name] = true
+
+	resolved, err := g.spec.resolveSchema(sc)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(resolved.Properties))
+	for prop := range resolved.Properties {
+		names = append(names, prop)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		// ID comes first as in hand-written structs
+		if names[i] == "id" || names[j] == "id" {
+			return names[i] == "id"
+		}
+		return names[i] < names[j]
+	})
+
+	def := structDef{Name: name, Source: source, Doc: strings.TrimSpace(resolved.Description)}
+	for _, prop := range names {
+		typ, err := g.goType(resolved.Properties[prop])
+		if err != nil {
+			return err
+		}
+		def.Fields = append(def.Fields, fieldDef{
+			Name: goName(prop),
+			Type: typ,
+			Tag:  fmt.Sprintf("`json:\"%s,omitempty\"`", prop),
+		})
+	}
+	g.structs = append(g.structs, def)
+	return nil
+}
+
+// goType returns Go type of the schema, generating named struct for referenced objects
+func (g *structGenerator) goType(sc *schema) (string, error) {
+	if sc.Ref != "" {
+		resolved, err := g.spec.resolveSchema(sc)
+		if err != nil {
+			return "", err
+		}
+		if resolved.Type == "object" && len(resolved.Properties) > 0 {
+			name := goName(strings.TrimSuffix(refName(sc.Ref), "Object"))
+			return name, g.generate(name, refName(sc.Ref), sc)
+		}
+		sc = resolved
+	}
+
+	switch sc.Type {
+	case "integer":
+		return "int64", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "string":
+		// Time of package zendesk tolerates the timestamp formats of Zendesk,
+		// and the pointer is omitted when it is not set
+		if sc.Format == "date-time" {
+			return "*Time", nil
+		}
+		return "string", nil
+	case "array":
+		if sc.Items == nil {
+			return "[]interface{}", nil
+		}
+		elem, err := g.goType(sc.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		return "map[string]interface{}", nil
+	}
+	return "interface{}", nil
+}
+
+// generate renders Go source of the resource
+func (res *resourceDef) generate() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := resourceTemplate.Execute(&buf, res); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code is invalid: %w\n%s", err, buf.String())
+	}
+	return src, nil
+}
+
+var resourceTemplate = template.Must(template.New("resource").Parse(`// Code generated by cmd/gen from Zendesk OpenAPI spec.
+
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+{{- if .MemberPath }}
+	"fmt"
+{{- end }}
+)
+{{ range .Structs }}
+// {{ .Name }} is struct for {{ .Source }} payload
+{{- if .Doc }}
+// {{ .Doc }}
+{{- end }}
+type {{ .Name }} struct {
+{{- range .Fields }}
+	{{ .Name }} {{ .Type }} {{ .Tag }}
+{{- end }}
+}
+{{ end }}
+{{- if .List }}
+// {{ .TypeName }}ListOptions is options for Get{{ .PluralTypeName }}
+type {{ .TypeName }}ListOptions struct {
+	PageOptions
+}
+{{ end }}
+// {{ .TypeName }}API an interface containing all methods associated with zendesk {{ .Plural }}
+type {{ .TypeName }}API interface {
+{{- if .List }}
+	Get{{ .PluralTypeName }}(ctx context.Context, opts *{{ .TypeName }}ListOptions) ([]{{ .TypeName }}, Page, error)
+{{- end }}
+{{- if .Show }}
+	Get{{ .TypeName }}(ctx context.Context, id int64) ({{ .TypeName }}, error)
+{{- end }}
+{{- if .Create }}
+	Create{{ .TypeName }}(ctx context.Context, {{ .VarName }} {{ .TypeName }}) ({{ .TypeName }}, error)
+{{- end }}
+{{- if .Update }}
+	Update{{ .TypeName }}(ctx context.Context, id int64, {{ .VarName }} {{ .TypeName }}) ({{ .TypeName }}, error)
+{{- end }}
+{{- if .Delete }}
+	Delete{{ .TypeName }}(ctx context.Context, id int64) error
+{{- end }}
+}
+{{ if .List }}
+// Get{{ .PluralTypeName }} fetches {{ .Singular }} list
+// {{ .List.Summary }} ({{ .List.ID }})
+func (z *Client) Get{{ .PluralTypeName }}(ctx context.Context, opts *{{ .TypeName }}ListOptions) ([]{{ .TypeName }}, Page, error) {
+	var data struct {
+		{{ .PluralTypeName }} []{{ .TypeName }} ` + "`json:\"{{ .Plural }}\"`" + `
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &{{ .TypeName }}ListOptions{}
+	}
+
+	u, err := addOptions("{{ .CollectionPath }}.json", tmp)
+	if err != nil {
+		return []{{ .TypeName }}{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []{{ .TypeName }}{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []{{ .TypeName }}{}, Page{}, err
+	}
+	return data.{{ .PluralTypeName }}, data.Page, nil
+}
+{{ end }}
+{{- if .Show }}
+// Get{{ .TypeName }} gets a specified {{ .Singular }}
+// {{ .Show.Summary }} ({{ .Show.ID }})
+func (z *Client) Get{{ .TypeName }}(ctx context.Context, id int64) ({{ .TypeName }}, error) {
+	var result struct {
+		{{ .TypeName }} {{ .TypeName }} ` + "`json:\"{{ .Singular }}\"`" + `
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("{{ .MemberPath }}/%d.json", id))
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+	return result.{{ .TypeName }}, nil
+}
+{{ end }}
+{{- if .Create }}
+// Create{{ .TypeName }} creates new {{ .Singular }}
+// {{ .Create.Summary }} ({{ .Create.ID }})
+func (z *Client) Create{{ .TypeName }}(ctx context.Context, {{ .VarName }} {{ .TypeName }}) ({{ .TypeName }}, error) {
+	var data, result struct {
+		{{ .TypeName }} {{ .TypeName }} ` + "`json:\"{{ .Singular }}\"`" + `
+	}
+	data.{{ .TypeName }} = {{ .VarName }}
+
+	body, err := z.post(ctx, "{{ .CollectionPath }}.json", data)
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+	return result.{{ .TypeName }}, nil
+}
+{{ end }}
+{{- if .Update }}
+// Update{{ .TypeName }} updates a specified {{ .Singular }}
+// {{ .Update.Summary }} ({{ .Update.ID }})
+func (z *Client) Update{{ .TypeName }}(ctx context.Context, id int64, {{ .VarName }} {{ .TypeName }}) ({{ .TypeName }}, error) {
+	var data, result struct {
+		{{ .TypeName }} {{ .TypeName }} ` + "`json:\"{{ .Singular }}\"`" + `
+	}
+	data.{{ .TypeName }} = {{ .VarName }}
+
+	body, err := z.put(ctx, fmt.Sprintf("{{ .MemberPath }}/%d.json", id), data)
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{ .TypeName }}{}, err
+	}
+	return result.{{ .TypeName }}, nil
+}
+{{ end }}
+{{- if .Delete }}
+// Delete{{ .TypeName }} deletes the specified {{ .Singular }}
+// {{ .Delete.Summary }} ({{ .Delete.ID }})
+func (z *Client) Delete{{ .TypeName }}(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("{{ .MemberPath }}/%d.json", id))
+}
+{{ end }}`))
@@ -0,0 +1,63 @@
+// Command gen generates resource struct and client methods of go-zendesk
+// from Zendesk's OpenAPI spec.
+//
+// Usage:
+//
+//	gen -spec oas.yaml -resource custom_statuses -out zendesk/custom_status.go
+//
+// The resource is the collection path under /api/v2. The generator looks up
+// the collection path and its member path (e.g. /api/v2/custom_statuses/{id}),
+// then generates methods for the operations defined on them and the struct
+// for the resource object in the show response.
+//
+// The generated interface must be added to API in zendesk/api.go,
+// and the mock client must be regenerated with `go generate ./...`.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	var (
+		specPath = flag.String("spec", "", "path to Zendesk OpenAPI spec (YAML or JSON)")
+		resource = flag.String("resource", "", "collection path of resource under /api/v2, e.g. custom_statuses")
+		out      = flag.String("out", "", "output file (default: stdout)")
+	)
+	flag.Parse()
+
+	if *specPath == "" || *resource == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(*specPath, *resource, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath string, resource string, out string) error {
+	s, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.buildResource(resource)
+	if err != nil {
+		return err
+	}
+
+	src, err := res.generate()
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
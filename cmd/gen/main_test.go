@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGoName(t *testing.T) {
+	cases := map[string]string{
+		"id":             "ID",
+		"html_url":       "HTMLURL",
+		"email_cc_ids":   "EmailCCIDs",
+		"custom_status":  "CustomStatus",
+		"agent_label":    "AgentLabel",
+		"sla_policy_ids": "SLAPolicyIDs",
+	}
+	for in, expected := range cases {
+		if got := goName(in); got != expected {
+			t.Fatalf("goName(%s): expected %s, but got %s", in, expected, got)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "custom_status.go")
+	if err := run("testdata/spec.yaml", "custom_statuses", out); err != nil {
+		t.Fatalf("Failed to generate: %s", err)
+	}
+
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := strings.Join(strings.Fields(string(b)), " ")
+
+	for _, expected := range []string{
+		"type CustomStatus struct { ID int64",
+		"Translations []Translation",
+		"// Translation is struct for TranslationObject payload type Translation struct",
+		"CreatedAt *Time `json:\"created_at,omitempty\"`",
+		"func (z *Client) GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, Page, error)",
+		"func (z *Client) GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error)",
+		"func (z *Client) CreateCustomStatus(",
+		"func (z *Client) UpdateCustomStatus(",
+		`fmt.Sprintf("/custom_statuses/%d.json", id)`,
+	} {
+		if !strings.Contains(src, expected) {
+			t.Fatalf("generated code does not contain %q:\n%s", expected, src)
+		}
+	}
+
+	if strings.Contains(src, "DeleteCustomStatus") {
+		t.Fatal("delete operation is not defined in spec")
+	}
+}
+
+func TestRunUndefinedResource(t *testing.T) {
+	if err := run("testdata/spec.yaml", "undefined", ""); err == nil {
+		t.Fatal("expected error for undefined resource")
+	}
+}
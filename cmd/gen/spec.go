@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// spec is the subset of OpenAPI 3 document used by the generator
+type spec struct {
+	Paths      map[string]map[string]*operation `yaml:"paths"`
+	Components struct {
+		Schemas   map[string]*schema   `yaml:"schemas"`
+		Responses map[string]*response `yaml:"responses"`
+	} `yaml:"components"`
+}
+
+type operation struct {
+	OperationID string               `yaml:"operationId"`
+	Summary     string               `yaml:"summary"`
+	Responses   map[string]*response `yaml:"responses"`
+}
+
+type response struct {
+	Ref     string `yaml:"$ref"`
+	Content map[string]struct {
+		Schema *schema `yaml:"schema"`
+	} `yaml:"content"`
+}
+
+type schema struct {
+	Ref         string             `yaml:"$ref"`
+	Type        string             `yaml:"type"`
+	Format      string             `yaml:"format"`
+	Description string             `yaml:"description"`
+	Properties  map[string]*schema `yaml:"properties"`
+	Items       *schema            `yaml:"items"`
+	AllOf       []*schema          `yaml:"allOf"`
+}
+
+// loadSpec reads OpenAPI document in YAML or JSON
+func loadSpec(path string) (*spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s spec
+	if err := yaml.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse spec %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// refName returns the last element of JSON reference
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// resolveSchema follows $ref and merges allOf into a single schema
+func (s *spec) resolveSchema(sc *schema) (*schema, error) {
+	if sc == nil {
+		return nil, nil
+	}
+
+	if sc.Ref != "" {
+		target, ok := s.Components.Schemas[refName(sc.Ref)]
+		if !ok {
+			return nil, fmt.Errorf("schema %s is not defined", sc.Ref)
+		}
+		return s.resolveSchema(target)
+	}
+
+	if len(sc.AllOf) == 0 {
+		return sc, nil
+	}
+
+	merged := &schema{Type: "object", Description: sc.Description, Properties: map[string]*schema{}}
+	for _, part := range sc.AllOf {
+		resolved, err := s.resolveSchema(part)
+		if err != nil {
+			return nil, err
+		}
+		for name, prop := range resolved.Properties {
+			merged.Properties[name] = prop
+		}
+	}
+	return merged, nil
+}
+
+// responseSchema returns the schema of successful JSON response of op
+func (s *spec) responseSchema(op *operation) (*schema, error) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+
+		res := op.Responses[code]
+		if res.Ref != "" {
+			target, ok := s.Components.Responses[refName(res.Ref)]
+			if !ok {
+				return nil, fmt.Errorf("response %s is not defined", res.Ref)
+			}
+			res = target
+		}
+
+		if content, ok := res.Content["application/json"]; ok {
+			return s.resolveSchema(content.Schema)
+		}
+	}
+	return nil, fmt.Errorf("operation %s has no JSON response", op.OperationID)
+}
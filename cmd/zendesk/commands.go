@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/provision"
+)
+
+// cli runs subcommands with the client
+type cli struct {
+	client *zendesk.Client
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// searchResultsLimit is the maximum number of results the Search API returns
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/
+const searchResultsLimit = 1000
+
+// print writes v to stdout as indented JSON
+func (c *cli) print(v interface{}) error {
+	enc := json.NewEncoder(c.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// parseID parses the only argument as resource ID
+func parseID(args []string) (int64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("%w: ID is required", errUsage)
+	}
+	return strconv.ParseInt(args[0], 10, 64)
+}
+
+func (c *cli) tickets(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: tickets requires list, show or create", errUsage)
+	}
+
+	switch args[0] {
+	case "list":
+		tickets, err := zendesk.ListAll(ctx, zendesk.CursorLister(func(ctx context.Context, opts zendesk.CursorPagination) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+			return c.client.GetTicketsCursor(ctx, &opts)
+		}), zendesk.PageToken{})
+		if err != nil {
+			return err
+		}
+		return c.print(tickets)
+	case "show":
+		id, err := parseID(args[1:])
+		if err != nil {
+			return err
+		}
+		ticket, err := c.client.GetTicket(ctx, id)
+		if err != nil {
+			return err
+		}
+		return c.print(ticket)
+	case "create":
+		fs := flag.NewFlagSet("tickets create", flag.ContinueOnError)
+		subject := fs.String("subject", "", "subject of the ticket")
+		body := fs.String("body", "", "body of the first comment")
+		priority := fs.String("priority", "", "priority of the ticket")
+		if err := fs.Parse(args[1:]); err != nil {
+			return errUsage
+		}
+		if *body == "" {
+			return fmt.Errorf("%w: -body is required", errUsage)
+		}
+
+		ticket, err := c.client.CreateTicket(ctx, zendesk.Ticket{
+			Subject:  *subject,
+			Priority: zendesk.TicketPriority(*priority),
+			Comment:  &zendesk.TicketComment{Body: *body},
+		})
+		if err != nil {
+			return err
+		}
+		return c.print(ticket)
+	}
+	return fmt.Errorf("%w: unknown subcommand %s", errUsage, args[0])
+}
+
+func (c *cli) users(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: users requires list, show or create", errUsage)
+	}
+
+	switch args[0] {
+	case "list":
+		users, err := zendesk.ListAll(ctx, zendesk.CursorLister(func(ctx context.Context, opts zendesk.CursorPagination) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+			return c.client.GetUsersCursor(ctx, &opts)
+		}), zendesk.PageToken{})
+		if err != nil {
+			return err
+		}
+		return c.print(users)
+	case "show":
+		id, err := parseID(args[1:])
+		if err != nil {
+			return err
+		}
+		user, err := c.client.GetUser(ctx, id)
+		if err != nil {
+			return err
+		}
+		return c.print(user)
+	case "create":
+		fs := flag.NewFlagSet("users create", flag.ContinueOnError)
+		name := fs.String("name", "", "name of the user")
+		email := fs.String("email", "", "email address of the user")
+		if err := fs.Parse(args[1:]); err != nil {
+			return errUsage
+		}
+		if *name == "" {
+			return fmt.Errorf("%w: -name is required", errUsage)
+		}
+
+		user, err := c.client.CreateUser(ctx, zendesk.User{Name: *name, Email: *email})
+		if err != nil {
+			return err
+		}
+		return c.print(user)
+	}
+	return fmt.Errorf("%w: unknown subcommand %s", errUsage, args[0])
+}
+
+func (c *cli) search(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("search", flag.ContinueOnError)
+	count := fs.Bool("count", false, "print only the number of results")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: search requires query", errUsage)
+	}
+	query := strings.Join(fs.Args(), " ")
+
+	if *count {
+		n, err := c.client.SearchCount(ctx, &zendesk.CountOptions{Query: query})
+		if err != nil {
+			return err
+		}
+		return c.print(n)
+	}
+
+	// the Search API returns at most searchResultsLimit results for a query
+	all := []interface{}{}
+	opts := &zendesk.SearchOptions{Query: query, PageOptions: zendesk.PageOptions{Page: 1, PerPage: 100}}
+	for {
+		results, page, err := c.client.Search(ctx, opts)
+		if err != nil {
+			return err
+		}
+		all = append(all, results.List()...)
+		if !page.HasNext() {
+			break
+		}
+		if len(all) >= searchResultsLimit {
+			fmt.Fprintf(c.stderr, "search results were truncated to %d of %d, narrow down the query or use export\n", len(all), page.Count)
+			break
+		}
+		opts.Page++
+	}
+	return c.print(all)
+}
+
+// export writes records of incremental export to stdout as ndjson.
+// With -checkpoint, the export resumes from the cursor saved in the directory.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-cursor-based
+func (c *cli) export(ctx context.Context, args []string) error {
+	if len(args) == 0 || (args[0] != "tickets" && args[0] != "users") {
+		return fmt.Errorf("%w: export requires tickets or users", errUsage)
+	}
+
+	fs := flag.NewFlagSet("export "+args[0], flag.ContinueOnError)
+	startTime := fs.Int64("start-time", 0, "unix time to start export from")
+	checkpoint := fs.String("checkpoint", "", "directory to save the cursor of export")
+	if err := fs.Parse(args[1:]); err != nil {
+		return errUsage
+	}
+
+	var store zendesk.CursorStore = zendesk.NewMemoryCursorStore()
+	if *checkpoint != "" {
+		var err error
+		store, err = zendesk.NewFileCursorStore(*checkpoint)
+		if err != nil {
+			return err
+		}
+	}
+
+	runner := zendesk.NewSyncRunner[json.RawMessage](c.client, args[0], store)
+	runner.StartTime = time.Unix(*startTime, 0)
+
+	enc := json.NewEncoder(c.stdout)
+	return runner.Run(ctx, func(record json.RawMessage) error {
+		return enc.Encode(record)
+	})
+}
+
+// snapshot writes the configuration of the account to stdout
+func (c *cli) snapshot(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("snapshot", flag.ContinueOnError)
+	format := fs.String("format", "json", "output format, json or yaml")
+	stripIDs := fs.Bool("strip-ids", false, "remove IDs to compare accounts")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *format != "json" && *format != "yaml" {
+		return fmt.Errorf("%w: unknown format %s", errUsage, *format)
+	}
+
+	snapshot, err := provision.TakeSnapshot(ctx, c.client, provision.SnapshotOptions{StripIDs: *stripIDs})
+	if err != nil {
+		return err
+	}
+
+	if *format == "yaml" {
+		return snapshot.WriteYAML(c.stdout)
+	}
+	return snapshot.WriteJSON(c.stdout)
+}
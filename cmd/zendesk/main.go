@@ -0,0 +1,111 @@
+// Command zendesk is a command line client of Zendesk API built on go-zendesk.
+//
+// Usage:
+//
+//	zendesk [flags] <command> <subcommand> [args]
+//
+// Commands:
+//
+//	tickets list
+//	tickets show <id>
+//	tickets create -subject <subject> -body <body> [-priority <priority>]
+//	users list
+//	users show <id>
+//	users create -name <name> -email <email>
+//	search [-count] <query>
+//	export tickets|users [-start-time <unix time>] [-checkpoint <dir>]
+//	snapshot [-format json|yaml] [-strip-ids]
+//
+// Credentials are read from flags or ZENDESK_SUBDOMAIN, ZENDESK_EMAIL and
+// ZENDESK_API_TOKEN environment variables. Results are written to stdout as
+// JSON, and export writes one JSON object per line (ndjson). search prints at
+// most 1000 results, the limit of the Search API, and tells on stderr if the
+// results were truncated.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// errUsage is returned when the command line is invalid
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	err := run(context.Background(), os.Args[1:], os.Stdout, os.Stderr, os.Getenv)
+	if err == nil {
+		return
+	}
+
+	// bare errUsage follows the usage already printed by the flag set
+	if err != errUsage {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if errors.Is(err, errUsage) {
+		os.Exit(2)
+	}
+	os.Exit(1)
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, getenv func(string) string) error {
+	fs := flag.NewFlagSet("zendesk", flag.ContinueOnError)
+	subdomain := fs.String("subdomain", getenv("ZENDESK_SUBDOMAIN"), "subdomain of Zendesk account")
+	email := fs.String("email", getenv("ZENDESK_EMAIL"), "email address of the agent")
+	token := fs.String("token", getenv("ZENDESK_API_TOKEN"), "API token")
+	endpoint := fs.String("endpoint", "", "full URL of API endpoint, overrides -subdomain")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: zendesk [flags] <command> <subcommand> [args]")
+		fmt.Fprintln(fs.Output(), "Commands: tickets, users, search, export, snapshot")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	client, err := zendesk.NewClient(nil)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case *endpoint != "":
+		err = client.SetEndpointURL(*endpoint)
+	case *subdomain != "":
+		err = client.SetSubdomain(*subdomain)
+	default:
+		err = errors.New("subdomain is required")
+	}
+	if err != nil {
+		return err
+	}
+	client.SetCredential(zendesk.NewAPITokenCredential(*email, *token))
+
+	c := &cli{client: client, stdout: stdout, stderr: stderr}
+	command, rest := fs.Arg(0), fs.Args()[1:]
+	switch command {
+	case "tickets":
+		return c.tickets(ctx, rest)
+	case "users":
+		return c.users(ctx, rest)
+	case "search":
+		return c.search(ctx, rest)
+	case "export":
+		return c.export(ctx, rest)
+	case "snapshot":
+		return c.snapshot(ctx, rest)
+	}
+
+	fs.Usage()
+	return errUsage
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/zendesktest"
+)
+
+func runCLI(t *testing.T, server *zendesktest.Server, args ...string) (string, error) {
+	out, _, err := runCLIWithStderr(t, server, args...)
+	return out, err
+}
+
+func runCLIWithStderr(t *testing.T, server *zendesktest.Server, args ...string) (string, string, error) {
+	var out, errOut bytes.Buffer
+	args = append([]string{"-endpoint", server.URL + "/api/v2", "-email", "agent@example.com", "-token", "token"}, args...)
+	err := run(context.Background(), args, &out, &errOut, func(string) string { return "" })
+	return out.String(), errOut.String(), err
+}
+
+func TestTickets(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	out, err := runCLI(t, server, "tickets", "create", "-subject", "Help", "-body", "It is broken")
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	var created zendesk.Ticket
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("Failed to decode output: %s", err)
+	}
+
+	out, err = runCLI(t, server, "tickets", "show", fmt.Sprint(created.ID))
+	if err != nil {
+		t.Fatalf("Failed to show ticket: %s", err)
+	}
+	if !strings.Contains(out, `"subject": "Help"`) {
+		t.Fatalf("unexpected output %s", out)
+	}
+
+	out, err = runCLI(t, server, "tickets", "list")
+	if err != nil {
+		t.Fatalf("Failed to list tickets: %s", err)
+	}
+	var tickets []zendesk.Ticket
+	if err := json.Unmarshal([]byte(out), &tickets); err != nil || len(tickets) != 1 {
+		t.Fatalf("unexpected output %s", out)
+	}
+}
+
+func TestUsersAndSearch(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	if _, err := runCLI(t, server, "users", "create", "-name", "Alice", "-email", "alice@example.com"); err != nil {
+		t.Fatalf("Failed to create user: %s", err)
+	}
+
+	out, err := runCLI(t, server, "search", "type:user", "alice")
+	if err != nil {
+		t.Fatalf("Failed to search: %s", err)
+	}
+	if !strings.Contains(out, "alice@example.com") {
+		t.Fatalf("unexpected output %s", out)
+	}
+
+	out, err = runCLI(t, server, "search", "-count", "type:user", "alice")
+	if err != nil {
+		t.Fatalf("Failed to count search results: %s", err)
+	}
+	if strings.TrimSpace(out) != "1" {
+		t.Fatalf("expected count 1, but got %s", out)
+	}
+}
+
+func TestListAndSearchAllPages(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	for i := 0; i < searchResultsLimit+1; i++ {
+		server.AddUser(zendesk.User{Name: "user", Email: fmt.Sprintf("user%d@example.com", i)})
+	}
+
+	out, err := runCLI(t, server, "users", "list")
+	if err != nil {
+		t.Fatalf("Failed to list users: %s", err)
+	}
+	var users []zendesk.User
+	if err := json.Unmarshal([]byte(out), &users); err != nil || len(users) != searchResultsLimit+1 {
+		t.Fatalf("expected %d users, but got %d: %v", searchResultsLimit+1, len(users), err)
+	}
+
+	out, errOut, err := runCLIWithStderr(t, server, "search", "type:user")
+	if err != nil {
+		t.Fatalf("Failed to search: %s", err)
+	}
+	var results []interface{}
+	if err := json.Unmarshal([]byte(out), &results); err != nil || len(results) != searchResultsLimit {
+		t.Fatalf("expected %d results, but got %d: %v", searchResultsLimit, len(results), err)
+	}
+	if !strings.Contains(errOut, "truncated to 1000 of 1001") {
+		t.Fatalf("expected truncation notice, but got %q", errOut)
+	}
+}
+
+func TestExportTickets(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	server.HandleFunc(http.MethodGet, "/incremental/tickets/cursor.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"tickets":[{"id":1},{"id":2}],"after_cursor":"next","end_of_stream":false}`))
+			return
+		}
+		w.Write([]byte(`{"tickets":[{"id":3}],"after_cursor":"last","end_of_stream":true}`))
+	})
+
+	out, err := runCLI(t, server, "export", "tickets", "-start-time", "0")
+	if err != nil {
+		t.Fatalf("Failed to export: %s", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 3 {
+		t.Fatalf("expected 3 lines, but got %v", lines)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	if _, err := runCLI(t, server, "unknown"); !errors.Is(err, errUsage) {
+		t.Fatalf("expected usage error, but got %v", err)
+	}
+	if _, err := runCLI(t, server, "tickets", "show"); !errors.Is(err, errUsage) {
+		t.Fatalf("expected usage error, but got %v", err)
+	}
+}
+
+func TestExportCheckpoint(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	server.HandleFunc(http.MethodGet, "/incremental/users/cursor.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"users":[{"id":1}],"after_cursor":"next","end_of_stream":true}`))
+			return
+		}
+		w.Write([]byte(`{"users":[],"after_cursor":"next","end_of_stream":true}`))
+	})
+
+	dir := t.TempDir()
+	out, err := runCLI(t, server, "export", "users", "-checkpoint", dir)
+	if err != nil || strings.TrimSpace(out) != `{"id":1}` {
+		t.Fatalf("unexpected export %q, %v", out, err)
+	}
+
+	out, err = runCLI(t, server, "export", "users", "-checkpoint", dir)
+	if err != nil || out != "" {
+		t.Fatalf("export should resume from checkpoint, but got %q, %v", out, err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	server := zendesktest.NewServer()
+	defer server.Close()
+
+	for path, key := range map[string]string{
+		"/ticket_fields.json":            "ticket_fields",
+		"/ticket_forms.json":             "ticket_forms",
+		"/user_fields.json":              "user_fields",
+		"/groups.json":                   "groups",
+		"/triggers.json":                 "triggers",
+		"/automations.json":              "automations",
+		"/macros.json":                   "macros",
+		"/views.json":                    "views",
+		"/slas/policies.json":            "sla_policies",
+		"/business_hours/schedules.json": "schedules",
+		"/brands.json":                   "brands",
+		"/custom_roles.json":             "custom_roles",
+	} {
+		body := `{"` + key + `":[]}`
+		server.HandleFunc(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+	}
+	server.HandleFunc(http.MethodGet, "/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups":[{"id":1,"name":"Support","created_at":"2019-06-03T02:34:52Z"}]}`))
+	})
+
+	out, err := runCLI(t, server, "snapshot", "-format", "yaml", "-strip-ids")
+	if err != nil {
+		t.Fatalf("Failed to take snapshot: %s", err)
+	}
+	if !strings.Contains(out, "groups:\n  - name: Support") {
+		t.Fatalf("unexpected snapshot\n%s", out)
+	}
+}
@@ -0,0 +1,87 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Account is the basic information of the account
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/accounts/
+type Account struct {
+	Name         string `json:"name"`
+	Subdomain    string `json:"subdomain"`
+	URL          string `json:"url"`
+	Sandbox      bool   `json:"sandbox"`
+	Multiproduct bool   `json:"multiproduct"`
+	TimeFormat   int    `json:"time_format,omitempty"`
+	TimeZone     string `json:"time_zone,omitempty"`
+}
+
+// AccountSubscription is the plan and addons the account subscribes to
+type AccountSubscription struct {
+	PlanType     string         `json:"plan_type"`
+	PlanName     string         `json:"plan_name"`
+	MaxAgents    int64          `json:"max_agents"`
+	BillingCycle string         `json:"billing_cycle,omitempty"`
+	Addons       []AccountAddon `json:"addons,omitempty"`
+}
+
+// AccountAddon is an addon purchased for the account
+type AccountAddon struct {
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity,omitempty"`
+}
+
+// HasAddon reports whether the subscription includes the addon
+func (s AccountSubscription) HasAddon(name string) bool {
+	for _, addon := range s.Addons {
+		if addon.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AccountAPI an interface containing all account related methods
+type AccountAPI interface {
+	GetAccount(ctx context.Context) (Account, error)
+	GetAccountSubscription(ctx context.Context) (AccountSubscription, error)
+}
+
+// GetAccount fetches the information of the account
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/accounts/#show-account
+func (z *Client) GetAccount(ctx context.Context) (Account, error) {
+	var result struct {
+		Account Account `json:"account"`
+	}
+
+	body, err := z.get(ctx, "/account")
+	if err != nil {
+		return Account{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Account{}, err
+	}
+	return result.Account, nil
+}
+
+// GetAccountSubscription fetches the plan and addons of the account.
+// It requires the admin role.
+func (z *Client) GetAccountSubscription(ctx context.Context) (AccountSubscription, error) {
+	var result struct {
+		Subscription AccountSubscription `json:"subscription"`
+	}
+
+	body, err := z.get(ctx, "/account/subscription.json")
+	if err != nil {
+		return AccountSubscription{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSubscription{}, err
+	}
+	return result.Subscription, nil
+}
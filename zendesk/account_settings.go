@@ -0,0 +1,220 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// AccountSettings is the settings of the account.
+// Sections which are nil are left as they are on update.
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+type AccountSettings struct {
+	Security     *SecuritySettings     `json:"security,omitempty"`
+	CCsFollowers *CCsFollowersSettings `json:"ccs_followers,omitempty"`
+	Agents       *AgentSettings        `json:"agents,omitempty"`
+	// ActiveFeatures is read-only. Use GetActiveFeatures to feature-detect.
+	ActiveFeatures ActiveFeatures `json:"active_features,omitempty"`
+}
+
+// Names of ActiveFeatures
+const (
+	ActiveFeatureOnHoldStatus           = "on_hold_status"
+	ActiveFeatureTicketForms            = "ticket_forms"
+	ActiveFeatureTicketTagging          = "ticket_tagging"
+	ActiveFeatureUserTagging            = "user_tagging"
+	ActiveFeatureUserOrgFields          = "user_org_fields"
+	ActiveFeatureDynamicContents        = "dynamic_contents"
+	ActiveFeatureLightAgents            = "light_agents"
+	ActiveFeatureCustomerSatisfaction   = "customer_satisfaction"
+	ActiveFeatureSatisfactionPrediction = "satisfaction_prediction"
+	ActiveFeatureVoice                  = "voice"
+	ActiveFeatureChat                   = "chat"
+	ActiveFeatureTwitter                = "twitter"
+	ActiveFeatureFacebook               = "facebook"
+	ActiveFeatureSandbox                = "sandbox"
+	ActiveFeatureExplore                = "explore"
+	ActiveFeatureCustomDKIMDomain       = "custom_dkim_domain"
+	ActiveFeatureAllowCCs               = "allow_ccs"
+	ActiveFeatureMarkdown               = "markdown"
+)
+
+// ActiveFeatures is the features enabled on the account by their names
+type ActiveFeatures map[string]bool
+
+// Enabled reports whether the feature is enabled. Unknown features are disabled.
+func (f ActiveFeatures) Enabled(name string) bool {
+	return f[name]
+}
+
+// SecuritySettings is the security section of account settings
+type SecuritySettings struct {
+	PasswordPolicy *PasswordPolicy        `json:"password_policy,omitempty"`
+	TwoFactor      *TwoFactorSettings     `json:"two_factor,omitempty"`
+	IPRestriction  *IPRestrictionSettings `json:"ip_restriction,omitempty"`
+}
+
+const (
+	// PasswordPolicyLevelLow is the low password security level
+	PasswordPolicyLevelLow = "low"
+	// PasswordPolicyLevelMedium is the medium password security level
+	PasswordPolicyLevelMedium = "medium"
+	// PasswordPolicyLevelHigh is the high password security level
+	PasswordPolicyLevelHigh = "high"
+	// PasswordPolicyLevelCustom is the password security level configured by the rest of PasswordPolicy
+	PasswordPolicyLevelCustom = "custom"
+)
+
+// PasswordPolicy is the password requirements of the account
+type PasswordPolicy struct {
+	Level              string `json:"level"`
+	MinLength          int    `json:"min_length,omitempty"`
+	MaxSequence        int    `json:"max_sequence,omitempty"`
+	DisallowLocalPart  bool   `json:"disallow_local_part"`
+	RequireMixedCase   bool   `json:"require_mixed_case"`
+	RequireNumber      bool   `json:"require_number"`
+	RequireSpecialChar bool   `json:"require_special_char"`
+	HistoryLength      int    `json:"history_length,omitempty"`
+	ExpireAfterDays    int    `json:"expire_after_days,omitempty"`
+}
+
+// TwoFactorSettings is the two-factor authentication settings of the account
+type TwoFactorSettings struct {
+	Enabled        bool `json:"enabled"`
+	EnforceAgents  bool `json:"enforce_agents"`
+	EnforceAdmins  bool `json:"enforce_admins"`
+	RememberDevice bool `json:"remember_device"`
+}
+
+// IPRestrictionSettings is the IP restriction settings of the account
+type IPRestrictionSettings struct {
+	Enabled bool `json:"enabled"`
+	// AllowedRanges is a list of IP addresses and CIDR ranges
+	AllowedRanges []string `json:"allowed_ranges"`
+	// EnforceEndUsers applies the restriction to end users as well as agents
+	EnforceEndUsers bool `json:"enforce_end_users"`
+}
+
+// AgentSettings is the agents section of account settings
+type AgentSettings struct {
+	// AgentWorkspace is true if agents use the Zendesk Agent Workspace
+	AgentWorkspace bool `json:"agent_workspace"`
+	// FocusMode hides the secondary panels of the workspace
+	FocusMode bool `json:"focus_mode"`
+}
+
+// CCsFollowersSettings is the CCs and followers section of account settings
+type CCsFollowersSettings struct {
+	// EmailCCsEnabled allows agents and end users to CC others on tickets
+	EmailCCsEnabled bool `json:"email_ccs_enabled"`
+	// FollowersEnabled allows agents to follow tickets
+	FollowersEnabled bool `json:"followers_enabled"`
+	// LightAgentEmailCCsAllowed allows light agents to be CCed
+	LightAgentEmailCCsAllowed bool `json:"light_agent_email_ccs_allowed"`
+	// AgentCCsBecomeFollowers makes agents CCed on tickets followers automatically
+	AgentCCsBecomeFollowers bool `json:"agent_ccs_become_followers"`
+	// AutomaticCCsOnReply keeps end users who replied to all as CCs
+	AutomaticCCsOnReply bool `json:"automatic_ccs_on_reply"`
+	// FollowerEmailTemplate is the template of the email notification to followers
+	FollowerEmailTemplate string `json:"follower_email_template,omitempty"`
+	// FollowerSubjectTemplate is the subject of the email notification to followers
+	FollowerSubjectTemplate string `json:"follower_subject_template,omitempty"`
+}
+
+// AccountSettingsAPI an interface containing all account settings related methods
+type AccountSettingsAPI interface {
+	GetAccountSettings(ctx context.Context) (AccountSettings, error)
+	UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error)
+	GetSecuritySettings(ctx context.Context) (SecuritySettings, error)
+	UpdateSecuritySettings(ctx context.Context, security SecuritySettings) (SecuritySettings, error)
+	GetCCsFollowersSettings(ctx context.Context) (CCsFollowersSettings, error)
+	UpdateCCsFollowersSettings(ctx context.Context, ccsFollowers CCsFollowersSettings) (CCsFollowersSettings, error)
+	GetActiveFeatures(ctx context.Context) (ActiveFeatures, error)
+}
+
+// GetAccountSettings fetches the settings of the account
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#show-settings
+func (z *Client) GetAccountSettings(ctx context.Context) (AccountSettings, error) {
+	var result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+
+	body, err := z.get(ctx, "/account/settings.json")
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+	return result.Settings, nil
+}
+
+// UpdateAccountSettings updates the sections of account settings which are not nil
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#update-account-settings
+func (z *Client) UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error) {
+	var data, result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+	data.Settings = settings
+
+	body, err := z.put(ctx, "/account/settings.json", data)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+	return result.Settings, nil
+}
+
+// GetSecuritySettings fetches the security section of account settings
+func (z *Client) GetSecuritySettings(ctx context.Context) (SecuritySettings, error) {
+	settings, err := z.GetAccountSettings(ctx)
+	if err != nil || settings.Security == nil {
+		return SecuritySettings{}, err
+	}
+	return *settings.Security, nil
+}
+
+// UpdateSecuritySettings updates the security section of account settings.
+// Sub structures which are nil are left as they are.
+func (z *Client) UpdateSecuritySettings(ctx context.Context, security SecuritySettings) (SecuritySettings, error) {
+	settings, err := z.UpdateAccountSettings(ctx, AccountSettings{Security: &security})
+	if err != nil || settings.Security == nil {
+		return SecuritySettings{}, err
+	}
+	return *settings.Security, nil
+}
+
+// GetCCsFollowersSettings fetches the CCs and followers section of account settings
+func (z *Client) GetCCsFollowersSettings(ctx context.Context) (CCsFollowersSettings, error) {
+	settings, err := z.GetAccountSettings(ctx)
+	if err != nil || settings.CCsFollowers == nil {
+		return CCsFollowersSettings{}, err
+	}
+	return *settings.CCsFollowers, nil
+}
+
+// UpdateCCsFollowersSettings updates the CCs and followers section of account settings
+func (z *Client) UpdateCCsFollowersSettings(ctx context.Context, ccsFollowers CCsFollowersSettings) (CCsFollowersSettings, error) {
+	settings, err := z.UpdateAccountSettings(ctx, AccountSettings{CCsFollowers: &ccsFollowers})
+	if err != nil || settings.CCsFollowers == nil {
+		return CCsFollowersSettings{}, err
+	}
+	return *settings.CCsFollowers, nil
+}
+
+// GetActiveFeatures fetches the features enabled on the account
+func (z *Client) GetActiveFeatures(ctx context.Context) (ActiveFeatures, error) {
+	settings, err := z.GetAccountSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if settings.ActiveFeatures == nil {
+		return ActiveFeatures{}, nil
+	}
+	return settings.ActiveFeatures, nil
+}
@@ -0,0 +1,118 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSecuritySettings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	security, err := client.GetSecuritySettings(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get security settings: %s", err)
+	}
+
+	if security.PasswordPolicy.Level != PasswordPolicyLevelCustom || security.PasswordPolicy.MinLength != 12 {
+		t.Fatalf("unexpected password policy %v", security.PasswordPolicy)
+	}
+	if !security.TwoFactor.EnforceAgents {
+		t.Fatalf("unexpected two factor settings %v", security.TwoFactor)
+	}
+	if len(security.IPRestriction.AllowedRanges) != 2 {
+		t.Fatalf("unexpected IP restriction settings %v", security.IPRestriction)
+	}
+}
+
+func TestUpdateSecuritySettings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/account/settings.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload struct {
+			Settings struct {
+				Security map[string]json.RawMessage `json:"security"`
+			} `json:"settings"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if len(payload.Settings.Security) != 1 || payload.Settings.Security["two_factor"] == nil {
+			t.Fatalf("expected only two_factor to be sent, but got %v", payload.Settings.Security)
+		}
+
+		w.Write(readFixture(filepath.Join(http.MethodPut, "account_settings.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	security, err := client.UpdateSecuritySettings(ctx, SecuritySettings{
+		TwoFactor: &TwoFactorSettings{Enabled: true, EnforceAgents: true, EnforceAdmins: true},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update security settings: %s", err)
+	}
+	if !security.TwoFactor.Enabled {
+		t.Fatalf("unexpected two factor settings %v", security.TwoFactor)
+	}
+}
+
+func TestGetCCsFollowersSettings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ccsFollowers, err := client.GetCCsFollowersSettings(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get CCs and followers settings: %s", err)
+	}
+	if !ccsFollowers.EmailCCsEnabled || ccsFollowers.LightAgentEmailCCsAllowed {
+		t.Fatalf("unexpected CCs and followers settings %v", ccsFollowers)
+	}
+}
+
+func TestUpdateCCsFollowersSettings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Settings map[string]map[string]interface{} `json:"settings"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if _, ok := payload.Settings["security"]; ok {
+			t.Fatal("security section should not be sent")
+		}
+		if payload.Settings["ccs_followers"]["light_agent_email_ccs_allowed"] != false {
+			t.Fatalf("false value should be sent: %v", payload.Settings["ccs_followers"])
+		}
+
+		w.Write(readFixture(filepath.Join(http.MethodPut, "account_settings.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateCCsFollowersSettings(ctx, CCsFollowersSettings{EmailCCsEnabled: true, FollowersEnabled: true})
+	if err != nil {
+		t.Fatalf("Failed to update CCs and followers settings: %s", err)
+	}
+}
+
+func TestGetActiveFeatures(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	features, err := client.GetActiveFeatures(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get active features: %s", err)
+	}
+	if !features.Enabled(ActiveFeatureTicketForms) || features.Enabled(ActiveFeatureVoice) || features.Enabled("unknown") {
+		t.Fatalf("unexpected features %v", features)
+	}
+}
@@ -0,0 +1,37 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetAccount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	account, err := client.GetAccount(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get account: %s", err)
+	}
+	if account.Subdomain != "example" || !account.Multiproduct {
+		t.Fatalf("unexpected account %v", account)
+	}
+}
+
+func TestGetAccountSubscription(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_subscription.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscription, err := client.GetAccountSubscription(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get subscription: %s", err)
+	}
+	if subscription.PlanType != "enterprise" || subscription.MaxAgents != 25 {
+		t.Fatalf("unexpected subscription %v", subscription)
+	}
+	if !subscription.HasAddon("advanced_ai") || subscription.HasAddon("talk") {
+		t.Fatalf("unexpected addons %v", subscription.Addons)
+	}
+}
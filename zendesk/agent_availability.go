@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// AgentStatusOnline is the status of agents accepting work
+	AgentStatusOnline = "online"
+	// AgentStatusAway is the status of agents temporarily not accepting work
+	AgentStatusAway = "away"
+	// AgentStatusTransfersOnly is the status of agents accepting only transfers
+	AgentStatusTransfersOnly = "transfers_only"
+	// AgentStatusOffline is the status of agents not working
+	AgentStatusOffline = "offline"
+)
+
+// AgentAvailability is the status of an agent in omnichannel routing
+type AgentAvailability struct {
+	AgentID int64
+	// Status is the agent status such as AgentStatusOnline in lower case
+	Status   string
+	StatusID int64
+	// Channels are the statuses of the agent per channel such as "support" and "messaging"
+	Channels []AgentChannelAvailability
+}
+
+// AgentChannelAvailability is the status of an agent in a channel
+type AgentChannelAvailability struct {
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	StatusUpdatedAt *Time  `json:"status_updated_at,omitempty"`
+}
+
+// Available reports whether the agent is online, and also online in the
+// channel unless channel is empty
+func (a AgentAvailability) Available(channel string) bool {
+	if a.Status != AgentStatusOnline {
+		return false
+	}
+	if channel == "" {
+		return true
+	}
+	for _, c := range a.Channels {
+		if c.Name == channel {
+			return strings.EqualFold(c.Status, AgentStatusOnline)
+		}
+	}
+	return false
+}
+
+// AgentAvailabilityAPI an interface containing agent availability related methods
+type AgentAvailabilityAPI interface {
+	GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error)
+}
+
+// GetAgentAvailability fetches the status of the agent in omnichannel routing.
+// It requires omnichannel routing to be enabled on the account.
+func (z *Client) GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error) {
+	var result struct {
+		Data struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				AgentStatus struct {
+					ID   int64  `json:"id"`
+					Name string `json:"name"`
+				} `json:"agent_status"`
+			} `json:"attributes"`
+		} `json:"data"`
+		Included []struct {
+			Type       string                   `json:"type"`
+			Attributes AgentChannelAvailability `json:"attributes"`
+		} `json:"included"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/agent_availabilities/%d", agentID))
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+
+	availability := AgentAvailability{
+		AgentID:  agentID,
+		Status:   strings.ReplaceAll(strings.ToLower(result.Data.Attributes.AgentStatus.Name), " ", "_"),
+		StatusID: result.Data.Attributes.AgentStatus.ID,
+	}
+	if id, err := strconv.ParseInt(result.Data.ID, 10, 64); err == nil {
+		availability.AgentID = id
+	}
+	for _, inc := range result.Included {
+		if inc.Type == "agent_channels" {
+			availability.Channels = append(availability.Channels, inc.Attributes)
+		}
+	}
+	return availability, nil
+}
@@ -0,0 +1,23 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetAgentAvailability(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "agent_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.GetAgentAvailability(ctx, 12)
+	if err != nil {
+		t.Fatalf("Failed to get agent availability: %s", err)
+	}
+	if availability.AgentID != 12 || availability.Status != AgentStatusOnline || len(availability.Channels) != 2 {
+		t.Fatalf("unexpected availability %+v", availability)
+	}
+	if !availability.Available("support") || availability.Available("messaging") || availability.Available("talk") {
+		t.Fatalf("unexpected channel availability %+v", availability)
+	}
+}
@@ -0,0 +1,114 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// AnswerBotQuery is the enquiry to get article suggestions for
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot_availability_api/
+type AnswerBotQuery struct {
+	Enquiry   string   `json:"enquiry"`
+	Locale    string   `json:"locale,omitempty"`
+	Reference string   `json:"reference,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	BrandID   int64    `json:"brand_id,omitempty"`
+}
+
+// AnswerBotArticle is an article suggested by Answer Bot
+type AnswerBotArticle struct {
+	ArticleID int64  `json:"article_id"`
+	HTMLURL   string `json:"html_url"`
+	Title     string `json:"title"`
+	Snippet   string `json:"snippet"`
+	Locale    string `json:"locale,omitempty"`
+	BrandID   int64  `json:"brand_id,omitempty"`
+	Score     int64  `json:"score,omitempty"`
+}
+
+// AnswerBotDeflection is the result of article suggestions. Pass its ID and
+// InteractionAccessToken to ResolveAnswerBotDeflection or RejectAnswerBotArticle.
+type AnswerBotDeflection struct {
+	ID                     int64              `json:"id"`
+	Articles               []AnswerBotArticle `json:"articles"`
+	InteractionAccessToken string             `json:"interaction_access_token"`
+}
+
+// AnswerBotRejectionReason is the reason the end user rejected the article
+type AnswerBotRejectionReason int
+
+const (
+	// AnswerBotRejectionReasonUnknown means the reason is not given
+	AnswerBotRejectionReasonUnknown AnswerBotRejectionReason = iota
+	// AnswerBotRejectionReasonNotRelated means the article is not related to the enquiry
+	AnswerBotRejectionReasonNotRelated
+	// AnswerBotRejectionReasonRelatedButNotAnswered means the article is related but did not answer the enquiry
+	AnswerBotRejectionReasonRelatedButNotAnswered
+)
+
+// AnswerBotAPI an interface containing all Answer Bot related methods
+type AnswerBotAPI interface {
+	GetAnswerBotArticleSuggestions(ctx context.Context, query AnswerBotQuery) (AnswerBotDeflection, error)
+	ResolveAnswerBotDeflection(ctx context.Context, deflection AnswerBotDeflection, articleID int64) error
+	RejectAnswerBotArticle(ctx context.Context, deflection AnswerBotDeflection, articleID int64, reason AnswerBotRejectionReason) error
+}
+
+// GetAnswerBotArticleSuggestions gets the articles which may answer the enquiry
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot_availability_api/#list-articles
+func (z *Client) GetAnswerBotArticleSuggestions(ctx context.Context, query AnswerBotQuery) (AnswerBotDeflection, error) {
+	var result struct {
+		Deflection             AnswerBotDeflection `json:"deflection"`
+		DeflectionArticles     []AnswerBotArticle  `json:"deflection_articles"`
+		InteractionAccessToken string              `json:"interaction_access_token"`
+	}
+
+	body, err := z.post(ctx, "/answer_bot/answers/articles.json", query)
+	if err != nil {
+		return AnswerBotDeflection{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AnswerBotDeflection{}, err
+	}
+
+	deflection := result.Deflection
+	deflection.Articles = result.DeflectionArticles
+	deflection.InteractionAccessToken = result.InteractionAccessToken
+	return deflection, nil
+}
+
+// ResolveAnswerBotDeflection marks the enquiry as resolved by the article
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot_availability_api/#resolve-deflection
+func (z *Client) ResolveAnswerBotDeflection(ctx context.Context, deflection AnswerBotDeflection, articleID int64) error {
+	var data struct {
+		DeflectionID           int64  `json:"deflection_id"`
+		ArticleID              int64  `json:"article_id"`
+		InteractionAccessToken string `json:"interaction_access_token"`
+	}
+	data.DeflectionID = deflection.ID
+	data.ArticleID = articleID
+	data.InteractionAccessToken = deflection.InteractionAccessToken
+
+	_, err := z.post(ctx, "/answer_bot/resolution.json", data)
+	return err
+}
+
+// RejectAnswerBotArticle marks the article as not answering the enquiry
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot_availability_api/#reject-article
+func (z *Client) RejectAnswerBotArticle(ctx context.Context, deflection AnswerBotDeflection, articleID int64, reason AnswerBotRejectionReason) error {
+	var data struct {
+		DeflectionID           int64                    `json:"deflection_id"`
+		ArticleID              int64                    `json:"article_id"`
+		InteractionAccessToken string                   `json:"interaction_access_token"`
+		ReasonID               AnswerBotRejectionReason `json:"reason_id"`
+	}
+	data.DeflectionID = deflection.ID
+	data.ArticleID = articleID
+	data.InteractionAccessToken = deflection.InteractionAccessToken
+	data.ReasonID = reason
+
+	_, err := z.post(ctx, "/answer_bot/rejection.json", data)
+	return err
+}
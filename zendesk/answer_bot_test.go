@@ -0,0 +1,63 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAnswerBotArticleSuggestions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "answer_bot_articles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	deflection, err := client.GetAnswerBotArticleSuggestions(ctx, AnswerBotQuery{Enquiry: "reset password", Locale: "en-us"})
+	if err != nil {
+		t.Fatalf("Failed to get article suggestions: %s", err)
+	}
+	if deflection.ID != 361 || len(deflection.Articles) != 1 || deflection.InteractionAccessToken == "" {
+		t.Fatalf("unexpected deflection %v", deflection)
+	}
+}
+
+func TestRejectAnswerBotArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/answer_bot/rejection.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if payload["deflection_id"] != float64(361) || payload["reason_id"] != float64(2) || payload["interaction_access_token"] != "token" {
+			t.Fatalf("unexpected payload %v", payload)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	deflection := AnswerBotDeflection{ID: 361, InteractionAccessToken: "token"}
+	err := client.RejectAnswerBotArticle(ctx, deflection, 360001722397, AnswerBotRejectionReasonRelatedButNotAnswered)
+	if err != nil {
+		t.Fatalf("Failed to reject article: %s", err)
+	}
+}
+
+func TestResolveAnswerBotDeflection(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/answer_bot/resolution.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ResolveAnswerBotDeflection(ctx, AnswerBotDeflection{ID: 361, InteractionAccessToken: "token"}, 360001722397)
+	if err != nil {
+		t.Fatalf("Failed to resolve deflection: %s", err)
+	}
+}
@@ -5,32 +5,64 @@ package zendesk
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	AccountAPI
+	AccountSettingsAPI
+	AgentAvailabilityAPI
+	AnswerBotAPI
 	AppAPI
+	ArticleAPI
+	ArticleLabelAPI
+	ArticleRevisionAPI
 	AttachmentAPI
 	AutomationAPI
 	BaseAPI
 	BrandAPI
+	CapabilityAPI
+	ChannelIntegrationAPI
+	ContentTagAPI
+	CountAPI
 	CustomRoleAPI
 	DynamicContentAPI
+	EmailThreadAPI
+	FacebookChannelAPI
 	GroupAPI
 	GroupMembershipAPI
+	JobStatusAPI
+	KnowledgeAPI
 	LocaleAPI
 	MacroAPI
+	MobileSDKAppAPI
 	OrganizationAPI
 	OrganizationMembershipAPI
+	OrganizationMergeAPI
+	PermissionGroupAPI
+	ProblemAPI
+	PushNotificationDeviceAPI
+	RecipientAddressAPI
+	ScheduleAPI
 	SearchAPI
+	SharingAgreementAPI
 	SLAPolicyAPI
 	TagAPI
 	TargetAPI
 	TicketAuditAPI
+	TicketBundleAPI
+	TicketEventAPI
+	TicketImportAPI
 	TicketAPI
 	TicketCommentAPI
+	TicketDedupAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TicketMetricEventAPI
 	TriggerAPI
+	TwitterChannelAPI
 	UserAPI
+	UserDeletionAPI
+	UserSegmentAPI
 	UserFieldAPI
 	ViewAPI
+	WatcherAPI
 	WebhookAPI
 }
 
@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+// clientOptionSetters are the setters for client configuration, which are not part of API
+var clientOptionSetters = map[string]bool{
+	"SetAPIVersion":         true,
+	"SetCache":              true,
+	"SetCircuitBreaker":     true,
+	"SetCompression":        true,
+	"SetCredential":         true,
+	"SetDryRun":             true,
+	"SetEndpointURL":        true,
+	"SetHeader":             true,
+	"SetMaxResponseSize":    true,
+	"SetMaxRetry":           true,
+	"SetMaxRetrySleepDelay": true,
+	"SetNetworkRetry":       true,
+	"SetReadOnly":           true,
+	"SetRequestQueue":       true,
+	"SetRetryObserver":      true,
+	"SetRetryPolicy":        true,
+	"SetSubdomain":          true,
+	"SetTransport":          true,
+	"SetUserAgentSuffix":    true,
+}
+
+// TestAPIIncludesAllClientMethods ensures that the mock client covers every API method.
+// The methods returning ListPage are in ListPageAPI instead.
+func TestAPIIncludesAllClientMethods(t *testing.T) {
+	api := reflect.TypeOf((*API)(nil)).Elem()
+	listPage := reflect.TypeOf((*ListPageAPI)(nil)).Elem()
+	client := reflect.TypeOf(&Client{})
+
+	for i := 0; i < client.NumMethod(); i++ {
+		name := client.Method(i).Name
+		if clientOptionSetters[name] {
+			continue
+		}
+		if _, ok := listPage.MethodByName(name); ok {
+			continue
+		}
+
+		if _, ok := api.MethodByName(name); !ok {
+			t.Errorf("Client.%s is not included in API interface", name)
+		}
+	}
+}
+
+func TestClientOptionSettersExist(t *testing.T) {
+	client := reflect.TypeOf(&Client{})
+	for name := range clientOptionSetters {
+		if _, ok := client.MethodByName(name); !ok {
+			t.Errorf("Client.%s does not exist", name)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Article is a Help Center article
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+type Article struct {
+	ID                int64    `json:"id,omitempty"`
+	URL               string   `json:"url,omitempty"`
+	HTMLURL           string   `json:"html_url,omitempty"`
+	AuthorID          int64    `json:"author_id,omitempty"`
+	SectionID         int64    `json:"section_id,omitempty"`
+	Title             string   `json:"title"`
+	Body              string   `json:"body,omitempty"`
+	Locale            string   `json:"locale,omitempty"`
+	SourceLocale      string   `json:"source_locale,omitempty"`
+	Draft             bool     `json:"draft,omitempty"`
+	Promoted          bool     `json:"promoted,omitempty"`
+	Outdated          bool     `json:"outdated,omitempty"`
+	CommentsDisabled  bool     `json:"comments_disabled,omitempty"`
+	Position          int64    `json:"position,omitempty"`
+	VoteSum           int64    `json:"vote_sum,omitempty"`
+	VoteCount         int64    `json:"vote_count,omitempty"`
+	PermissionGroupID int64    `json:"permission_group_id,omitempty"`
+	UserSegmentID     *int64   `json:"user_segment_id,omitempty"`
+	LabelNames        []string `json:"label_names,omitempty"`
+	ContentTagIDs     []string `json:"content_tag_ids,omitempty"`
+	CreatedAt         *Time    `json:"created_at,omitempty"`
+	UpdatedAt         *Time    `json:"updated_at,omitempty"`
+	EditedAt          *Time    `json:"edited_at,omitempty"`
+	// PublishAt and UnpublishAt are the schedule of the article on accounts
+	// with scheduled publishing. Use ScheduleArticle to set or clear them.
+	PublishAt   *Time `json:"publish_at,omitempty"`
+	UnpublishAt *Time `json:"unpublish_at,omitempty"`
+}
+
+// IsPublishedAt reports whether the article is visible at t according to
+// its draft state and schedule
+func (a Article) IsPublishedAt(t time.Time) bool {
+	if a.Draft && a.PublishAt == nil {
+		return false
+	}
+	if a.PublishAt != nil && t.Before(a.PublishAt.Time) {
+		return false
+	}
+	if a.UnpublishAt != nil && !t.Before(a.UnpublishAt.Time) {
+		return false
+	}
+	return true
+}
+
+// ArticleListOptions is options for GetArticles
+type ArticleListOptions struct {
+	PageOptions
+
+	// LabelNames filters the articles having any of the labels
+	LabelNames []string         `url:"label_names,comma,omitempty"`
+	SortBy     ArticleSortField `url:"sort_by,omitempty"`
+	SortOrder  SortOrder        `url:"sort_order,omitempty"`
+}
+
+// ArticleSortField is the field which articles are sorted by
+type ArticleSortField string
+
+const (
+	// ArticleSortByPosition sorts articles by the position in the section
+	ArticleSortByPosition ArticleSortField = "position"
+	// ArticleSortByTitle sorts articles by title
+	ArticleSortByTitle ArticleSortField = "title"
+	// ArticleSortByCreatedAt sorts articles by creation time
+	ArticleSortByCreatedAt ArticleSortField = "created_at"
+	// ArticleSortByUpdatedAt sorts articles by last update time
+	ArticleSortByUpdatedAt ArticleSortField = "updated_at"
+)
+
+// Validate checks the sort field and order
+func (o ArticleListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		ArticleSortByPosition, ArticleSortByTitle, ArticleSortByCreatedAt, ArticleSortByUpdatedAt); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
+}
+
+// ArticleAPI an interface containing all article related methods
+type ArticleAPI interface {
+	GetArticles(ctx context.Context, opts *ArticleListOptions) ([]Article, Page, error)
+	GetArticle(ctx context.Context, articleID int64) (Article, error)
+	UpdateArticle(ctx context.Context, articleID int64, article Article) (Article, error)
+	ScheduleArticle(ctx context.Context, articleID int64, publishAt, unpublishAt *time.Time) (Article, error)
+}
+
+// GetArticles fetches the articles
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#list-articles
+func (z *Client) GetArticles(ctx context.Context, opts *ArticleListOptions) ([]Article, Page, error) {
+	var data struct {
+		Articles []Article `json:"articles"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleListOptions{}
+	}
+
+	u, err := addOptions("/help_center/articles.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Articles, data.Page, nil
+}
+
+// GetArticlesPage fetches the page of articles at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetArticlesPage(ctx context.Context, token PageToken, opts *ArticleListOptions) (ListPage[Article], error) {
+	tmp := ArticleListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Article, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetArticles(ctx, &tmp)
+	})(ctx, token)
+}
+
+// GetArticle fetches the article
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#show-article
+func (z *Client) GetArticle(ctx context.Context, articleID int64) (Article, error) {
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID))
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// UpdateArticle updates the metadata of the article, e.g. section, labels and schedule
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#update-article
+func (z *Client) UpdateArticle(ctx context.Context, articleID int64, article Article) (Article, error) {
+	var data, result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// ScheduleArticle sets the times when the article is published and unpublished.
+// Unlike UpdateArticle, nil clears the schedule.
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#update-article
+func (z *Client) ScheduleArticle(ctx context.Context, articleID int64, publishAt, unpublishAt *time.Time) (Article, error) {
+	var data struct {
+		Article struct {
+			PublishAt   *time.Time `json:"publish_at"`
+			UnpublishAt *time.Time `json:"unpublish_at"`
+		} `json:"article"`
+	}
+	data.Article.PublishAt = publishAt
+	data.Article.UnpublishAt = unpublishAt
+
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ArticleLabel is a label attached to Help Center articles.
+// Use ArticleListOptions.LabelNames to list the articles by labels.
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/article_labels/
+type ArticleLabel struct {
+	ID        int64  `json:"id,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Name      string `json:"name"`
+	CreatedAt *Time  `json:"created_at,omitempty"`
+	UpdatedAt *Time  `json:"updated_at,omitempty"`
+}
+
+// ArticleLabelAPI an interface containing all article label related methods
+type ArticleLabelAPI interface {
+	GetArticleLabels(ctx context.Context, opts *PageOptions) ([]ArticleLabel, Page, error)
+	GetArticleLabelsByArticle(ctx context.Context, articleID int64) ([]ArticleLabel, error)
+	CreateArticleLabel(ctx context.Context, articleID int64, name string) (ArticleLabel, error)
+	DeleteArticleLabel(ctx context.Context, articleID int64, labelID int64) error
+}
+
+// GetArticleLabels fetches all the article labels
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_labels/#list-article-labels
+func (z *Client) GetArticleLabels(ctx context.Context, opts *PageOptions) ([]ArticleLabel, Page, error) {
+	var data struct {
+		Labels []ArticleLabel `json:"labels"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/help_center/articles/labels.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Labels, data.Page, nil
+}
+
+// GetArticleLabelsPage fetches the page of article labels at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetArticleLabelsPage(ctx context.Context, token PageToken) (ListPage[ArticleLabel], error) {
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]ArticleLabel, Page, error) {
+		return z.GetArticleLabels(ctx, &opts)
+	})(ctx, token)
+}
+
+// GetArticleLabelsByArticle fetches the labels attached to the article
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_labels/#list-article-labels
+func (z *Client) GetArticleLabelsByArticle(ctx context.Context, articleID int64) ([]ArticleLabel, error) {
+	var result struct {
+		Labels []ArticleLabel `json:"labels"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/labels.json", articleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Labels, nil
+}
+
+// CreateArticleLabel attaches the label to the article. The label is created if it doesn't exist.
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_labels/#create-label
+func (z *Client) CreateArticleLabel(ctx context.Context, articleID int64, name string) (ArticleLabel, error) {
+	var data, result struct {
+		Label ArticleLabel `json:"label"`
+	}
+	data.Label.Name = name
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/articles/%d/labels.json", articleID), data)
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleLabel{}, err
+	}
+	return result.Label, nil
+}
+
+// DeleteArticleLabel detaches the label from the article
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_labels/#delete-label
+func (z *Client) DeleteArticleLabel(ctx context.Context, articleID int64, labelID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/%d/labels/%d.json", articleID, labelID))
+}
@@ -0,0 +1,51 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetArticleLabels(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_labels.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	labels, page, err := client.GetArticleLabels(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get article labels: %s", err)
+	}
+	if len(labels) != 2 || labels[0].Name != "password" || page.Count != 2 {
+		t.Fatalf("unexpected labels %v", labels)
+	}
+}
+
+func TestCreateArticleLabel(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "article_label.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	label, err := client.CreateArticleLabel(ctx, 360001, "security")
+	if err != nil {
+		t.Fatalf("Failed to create article label: %s", err)
+	}
+	if label.ID != 9 || label.Name != "security" {
+		t.Fatalf("unexpected label %v", label)
+	}
+}
+
+func TestDeleteArticleLabel(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/articles/360001/labels/9.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteArticleLabel(ctx, 360001, 9)
+	if err != nil {
+		t.Fatalf("Failed to delete article label: %s", err)
+	}
+}
@@ -0,0 +1,96 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ArticleRevision is a saved edit of an article translation
+type ArticleRevision struct {
+	ID        int64  `json:"id"`
+	ArticleID int64  `json:"article_id,omitempty"`
+	AuthorID  int64  `json:"author_id"`
+	Locale    string `json:"locale"`
+	Title     string `json:"title"`
+	Body      string `json:"body,omitempty"`
+	Draft     bool   `json:"draft,omitempty"`
+	CreatedAt Time   `json:"created_at"`
+}
+
+// ArticleRevisionListOptions is options for GetArticleRevisions
+type ArticleRevisionListOptions struct {
+	CursorPagination
+
+	// Locale filters the revisions of the translation
+	Locale string `url:"locale,omitempty"`
+}
+
+// ArticleRevisionAPI an interface containing all article revision related methods
+type ArticleRevisionAPI interface {
+	GetArticleRevisions(ctx context.Context, articleID int64, opts *ArticleRevisionListOptions) ([]ArticleRevision, CursorPaginationMeta, error)
+	GetArticleRevision(ctx context.Context, articleID int64, revisionID int64) (ArticleRevision, error)
+}
+
+// GetArticleRevisions fetches the revisions of the article in the order of creation
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+func (z *Client) GetArticleRevisions(ctx context.Context, articleID int64, opts *ArticleRevisionListOptions) ([]ArticleRevision, CursorPaginationMeta, error) {
+	var result struct {
+		Revisions []ArticleRevision    `json:"article_revisions"`
+		Meta      CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleRevisionListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/help_center/articles/%d/revisions.json", articleID), tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Revisions, result.Meta, nil
+}
+
+// GetArticleRevisionsPage fetches the page of revisions of the article at token as ListPage.
+// The zero PageToken is the first page, and the cursor options of opts are
+// replaced with token.
+func (z *Client) GetArticleRevisionsPage(ctx context.Context, articleID int64, token PageToken, opts *ArticleRevisionListOptions) (ListPage[ArticleRevision], error) {
+	tmp := ArticleRevisionListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return CursorLister(func(ctx context.Context, opts CursorPagination) ([]ArticleRevision, CursorPaginationMeta, error) {
+		tmp.CursorPagination = opts
+		return z.GetArticleRevisions(ctx, articleID, &tmp)
+	})(ctx, token)
+}
+
+// GetArticleRevision fetches the revision of the article including its body
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+func (z *Client) GetArticleRevision(ctx context.Context, articleID int64, revisionID int64) (ArticleRevision, error) {
+	var result struct {
+		Revision ArticleRevision `json:"article_revision"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/revisions/%d.json", articleID, revisionID))
+	if err != nil {
+		return ArticleRevision{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleRevision{}, err
+	}
+	return result.Revision, nil
+}
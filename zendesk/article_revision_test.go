@@ -0,0 +1,40 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetArticleRevisions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/360001/revisions.json" || r.URL.Query().Get("locale") != "en-us" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture("GET/article_revisions.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	revisions, meta, err := client.GetArticleRevisions(ctx, 360001, &ArticleRevisionListOptions{Locale: "en-us"})
+	if err != nil {
+		t.Fatalf("Failed to get article revisions: %s", err)
+	}
+	if len(revisions) != 2 || revisions[1].AuthorID != 3466 || meta.HasMore {
+		t.Fatalf("unexpected revisions %v", revisions)
+	}
+}
+
+func TestGetArticleRevision(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_revision.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	revision, err := client.GetArticleRevision(ctx, 360001, 9002)
+	if err != nil {
+		t.Fatalf("Failed to get article revision: %s", err)
+	}
+	if revision.ID != 9002 || revision.Body == "" || revision.CreatedAt.IsZero() {
+		t.Fatalf("unexpected revision %v", revision)
+	}
+}
@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetArticles(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "articles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	articles, page, err := client.GetArticles(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get articles: %s", err)
+	}
+	if len(articles) != 1 || articles[0].Title != "How to reset your password" || page.HasNext() {
+		t.Fatalf("unexpected articles %v", articles)
+	}
+	if len(articles[0].LabelNames) != 2 || articles[0].UserSegmentID != nil {
+		t.Fatalf("unexpected article %v", articles[0])
+	}
+}
+
+func TestGetArticlesByLabelNames(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("label_names") != "password,account" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/articles.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	articles, _, err := client.GetArticles(ctx, &ArticleListOptions{LabelNames: []string{"password", "account"}})
+	if err != nil {
+		t.Fatalf("Failed to get articles: %s", err)
+	}
+	if len(articles) != 1 {
+		t.Fatalf("expected 1 article, but got %d", len(articles))
+	}
+}
+
+func TestScheduleArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Article map[string]interface{} `json:"article"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if len(payload.Article) != 2 || payload.Article["publish_at"] != "2023-05-01T09:00:00Z" {
+			t.Fatalf("unexpected payload %v", payload)
+		}
+		if v, ok := payload.Article["unpublish_at"]; !ok || v != nil {
+			t.Fatalf("expected unpublish_at to be cleared, but got %v", payload)
+		}
+		w.Write(readFixture("PUT/article.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	publishAt := time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC)
+	article, err := client.ScheduleArticle(ctx, 360001, &publishAt, nil)
+	if err != nil {
+		t.Fatalf("Failed to schedule article: %s", err)
+	}
+	if article.PublishAt == nil || !article.PublishAt.Equal(publishAt) || article.UnpublishAt != nil {
+		t.Fatalf("unexpected article %v", article)
+	}
+}
+
+func TestArticleIsPublishedAt(t *testing.T) {
+	publishAt := time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC)
+	unpublishAt := publishAt.Add(24 * time.Hour)
+	publish, unpublish := NewTime(publishAt), NewTime(unpublishAt)
+
+	cases := []struct {
+		article  Article
+		at       time.Time
+		expected bool
+	}{
+		{Article{}, publishAt, true},
+		{Article{Draft: true}, publishAt, false},
+		{Article{Draft: true, PublishAt: &publish}, publishAt.Add(-time.Second), false},
+		{Article{Draft: true, PublishAt: &publish}, publishAt, true},
+		{Article{PublishAt: &publish, UnpublishAt: &unpublish}, unpublishAt.Add(-time.Second), true},
+		{Article{PublishAt: &publish, UnpublishAt: &unpublish}, unpublishAt, false},
+	}
+	for i, c := range cases {
+		if got := c.article.IsPublishedAt(c.at); got != c.expected {
+			t.Errorf("case %d: expected %v, but got %v", i, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoAssignableAgent is returned by TicketAssigner when no agent in the group can be assigned
+var ErrNoAssignableAgent = errors.New("zendesk: no assignable agent in the group")
+
+// AssignmentStrategy is how TicketAssigner picks the agent
+type AssignmentStrategy int
+
+const (
+	// AssignRoundRobin assigns the agents in turn in the order of their IDs
+	AssignRoundRobin AssignmentStrategy = iota
+	// AssignLeastLoaded assigns the agent with the fewest unsolved tickets
+	AssignLeastLoaded
+)
+
+// TicketAssignerOptions are the options of TicketAssigner
+type TicketAssignerOptions struct {
+	GroupID  int64
+	Strategy AssignmentStrategy
+	// Channel makes only the agents online in the channel, e.g. "support",
+	// assignable with the agent availability of omnichannel routing.
+	// Availability is not checked if empty.
+	Channel string
+	// Concurrency is the number of requests checking the agents at the same time. The default is 4.
+	Concurrency int
+}
+
+// TicketAssigner assigns tickets to the agents of a group, as a building
+// block of custom routing. It keeps the turn of round-robin in memory, so use
+// a single TicketAssigner per group in the process. It is safe for concurrent use.
+type TicketAssigner struct {
+	client *Client
+	opts   TicketAssignerOptions
+
+	mu     sync.Mutex
+	lastID int64
+}
+
+// NewTicketAssigner creates TicketAssigner
+func NewTicketAssigner(z *Client, opts TicketAssignerOptions) *TicketAssigner {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	return &TicketAssigner{client: z, opts: opts}
+}
+
+// AssignableAgents lists the active agents of the group which are available
+// in Channel if set, sorted by ID
+func (a *TicketAssigner) AssignableAgents(ctx context.Context) ([]User, error) {
+	agents, err := a.client.ListAssignableGroupAgents(ctx, a.opts.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.opts.Channel != "" {
+		available, err := fetchConcurrently(ctx, agents, a.opts.Concurrency, func(ctx context.Context, u User) ([]User, error) {
+			availability, err := a.client.GetAgentAvailability(ctx, u.ID)
+			if err != nil || !availability.Available(a.opts.Channel) {
+				return nil, err
+			}
+			return []User{u}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		agents = agents[:0]
+		for _, u := range available {
+			agents = append(agents, u...)
+		}
+	}
+	return agents, nil
+}
+
+// Next picks the agent to assign the next ticket to by Strategy
+func (a *TicketAssigner) Next(ctx context.Context) (User, error) {
+	agents, err := a.AssignableAgents(ctx)
+	if err != nil {
+		return User{}, err
+	}
+	if len(agents) == 0 {
+		return User{}, ErrNoAssignableAgent
+	}
+
+	if a.opts.Strategy == AssignLeastLoaded {
+		return a.leastLoaded(ctx, agents)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	next := agents[0]
+	for _, u := range agents {
+		if u.ID > a.lastID {
+			next = u
+			break
+		}
+	}
+	a.lastID = next.ID
+	return next, nil
+}
+
+// leastLoaded returns the agent with the fewest unsolved tickets, the smallest ID on a tie
+func (a *TicketAssigner) leastLoaded(ctx context.Context, agents []User) (User, error) {
+	counts, err := fetchConcurrently(ctx, agents, a.opts.Concurrency, func(ctx context.Context, u User) ([]int, error) {
+		count, err := a.client.SearchCount(ctx, &CountOptions{
+			Query: fmt.Sprintf("type:ticket assignee:%d status<solved", u.ID),
+		})
+		return []int{count}, err
+	})
+	if err != nil {
+		return User{}, err
+	}
+
+	best := 0
+	for i := range agents {
+		if counts[i][0] < counts[best][0] {
+			best = i
+		}
+	}
+	return agents[best], nil
+}
+
+// Assign assigns the ticket to the next agent and the group
+func (a *TicketAssigner) Assign(ctx context.Context, ticketID int64) (Ticket, error) {
+	agent, err := a.Next(ctx)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return a.client.UpdateTicket(ctx, ticketID, Ticket{AssigneeID: agent.ID, GroupID: a.opts.GroupID})
+}
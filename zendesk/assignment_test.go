@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newAssignmentMockAPI(t *testing.T, assigned *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/groups/10/users.json":
+			w.Write([]byte(`{"users": [
+				{"id": 3, "role": "agent", "active": true},
+				{"id": 1, "role": "agent", "active": true},
+				{"id": 2, "role": "admin", "active": true},
+				{"id": 4, "role": "agent", "active": true, "suspended": true}
+			], "next_page": null}`))
+		case strings.HasPrefix(r.URL.Path, "/agent_availabilities/"):
+			status := "online"
+			if r.URL.Path == "/agent_availabilities/2" {
+				status = "offline"
+			}
+			id := strings.TrimPrefix(r.URL.Path, "/agent_availabilities/")
+			fmt.Fprintf(w, `{"data": {"id": %q, "attributes": {"agent_status": {"name": "Online"}}},
+				"included": [{"type": "agent_channels", "attributes": {"name": "support", "status": %q}}]}`, id, status)
+		case r.URL.Path == "/search/count.json":
+			count := 5
+			if strings.Contains(r.URL.Query().Get("query"), "assignee:3 ") {
+				count = 1
+			}
+			fmt.Fprintf(w, `{"count": %d}`, count)
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/tickets/"):
+			*assigned = append(*assigned, r.URL.Path)
+			w.Write([]byte(`{"ticket": {"id": 1}}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+}
+
+func TestTicketAssignerRoundRobin(t *testing.T) {
+	var assigned []string
+	mockAPI := newAssignmentMockAPI(t, &assigned)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	assigner := NewTicketAssigner(client, TicketAssignerOptions{GroupID: 10})
+	var picked []int64
+	for i := 0; i < 4; i++ {
+		agent, err := assigner.Next(ctx)
+		if err != nil {
+			t.Fatalf("Failed to pick agent: %s", err)
+		}
+		picked = append(picked, agent.ID)
+	}
+	if fmt.Sprint(picked) != "[1 2 3 1]" {
+		t.Fatalf("unexpected turns %v", picked)
+	}
+
+	assigner = NewTicketAssigner(client, TicketAssignerOptions{GroupID: 10, Channel: "support"})
+	agents, err := assigner.AssignableAgents(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list agents: %s", err)
+	}
+	if len(agents) != 2 || agents[0].ID != 1 || agents[1].ID != 3 {
+		t.Fatalf("unexpected agents %v", agents)
+	}
+}
+
+func TestTicketAssignerLeastLoaded(t *testing.T) {
+	var assigned []string
+	mockAPI := newAssignmentMockAPI(t, &assigned)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	assigner := NewTicketAssigner(client, TicketAssignerOptions{GroupID: 10, Strategy: AssignLeastLoaded})
+	if _, err := assigner.Assign(ctx, 7); err != nil {
+		t.Fatalf("Failed to assign: %s", err)
+	}
+	agent, err := assigner.Next(ctx)
+	if err != nil || agent.ID != 3 {
+		t.Fatalf("expected agent 3, got %v %v", agent.ID, err)
+	}
+	if len(assigned) != 1 || assigned[0] != "/tickets/7.json" {
+		t.Fatalf("unexpected updates %v", assigned)
+	}
+}
+
+func TestTicketAssignerNoAgent(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"users": [], "next_page": null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := NewTicketAssigner(client, TicketAssignerOptions{GroupID: 10}).Next(ctx)
+	if !errors.Is(err, ErrNoAssignableAgent) {
+		t.Fatalf("expected ErrNoAssignableAgent, got %v", err)
+	}
+}
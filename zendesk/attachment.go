@@ -3,9 +3,11 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sync"
 )
 
@@ -20,6 +22,27 @@ type Attachment struct {
 	Size             int64   `json:"size,omitempty"`
 	Thumbnails       []Photo `json:"thumbnails,omitempty"`
 	Inline           bool    `json:"inline,omitempty"`
+
+	// MalwareAccessOverride allows downloading the attachment even if it is flagged as malware
+	MalwareAccessOverride bool `json:"malware_access_override,omitempty"`
+	// MalwareScanResult is one of MalwareScanResult* values
+	MalwareScanResult string `json:"malware_scan_result,omitempty"`
+}
+
+const (
+	// MalwareScanResultMalwareFound means the attachment was flagged as malware
+	MalwareScanResultMalwareFound = "malware_found"
+	// MalwareScanResultMalwareNotFound means no malware was found in the attachment
+	MalwareScanResultMalwareNotFound = "malware_not_found"
+	// MalwareScanResultFailedToScan means the scan of the attachment failed
+	MalwareScanResultFailedToScan = "failed_to_scan"
+	// MalwareScanResultNotScanned means the attachment has not been scanned
+	MalwareScanResultNotScanned = "not_scanned"
+)
+
+// IsQuarantined reports whether the attachment is flagged as malware and cannot be downloaded
+func (a Attachment) IsQuarantined() bool {
+	return a.MalwareScanResult == MalwareScanResultMalwareFound && !a.MalwareAccessOverride
 }
 
 // Photo is thumbnail which is included in attachment
@@ -53,19 +76,42 @@ type UploadWriter interface {
 type writer struct {
 	*Client
 	once     sync.Once
-	w        io.WriteCloser
+	err      error
+	w        *io.PipeWriter
 	filename string
 	token    string
 	c        chan result
 	ctx      context.Context
+
+	// discard is set in dry-run mode, in which the upload is recorded
+	// instead of being sent and size counts the bytes written to it
+	discard bool
+	size    int64
 }
 
 func (wr *writer) open() error {
+	if wr.readOnly {
+		return ErrReadOnly
+	}
+
+	q := url.Values{}
+	if wr.token != "" {
+		q.Add("token", wr.token)
+	}
+	q.Add("filename", wr.filename)
+
+	path := "/uploads.json"
+	if wr.dryRun != nil {
+		wr.dryRun.record(DryRunRequest{Method: http.MethodPost, Path: path + "?" + q.Encode()})
+		wr.discard = true
+		return nil
+	}
+
 	r, w := io.Pipe()
-	wr.c = make(chan result)
+	// buffered so that the request goroutine exits even if Close is never called
+	wr.c = make(chan result, 1)
 
 	wr.w = w
-	path := "/uploads.json"
 	req, err := http.NewRequest(http.MethodPost, wr.baseURL.String()+path, r)
 	if err != nil {
 		return err
@@ -73,13 +119,6 @@ func (wr *writer) open() error {
 
 	req = wr.prepareRequest(wr.ctx, req)
 	req.Header.Set("Content-Type", "application/binary")
-
-	q := req.URL.Query()
-	if wr.token != "" {
-		q.Add("token", wr.token)
-	}
-
-	q.Add("filename", wr.filename)
 	req.URL.RawQuery = q.Encode()
 
 	go func() {
@@ -92,6 +131,7 @@ func (wr *writer) open() error {
 		}
 
 		defer resp.Body.Close()
+		recordResponse(wr.ctx, resp)
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
 			wr.c <- result{
@@ -111,17 +151,31 @@ func (wr *writer) open() error {
 
 func (wr *writer) Write(p []byte) (n int, err error) {
 	wr.once.Do(func() {
-		err = wr.open()
+		wr.err = wr.open()
 	})
 
-	if err != nil {
-		return 0, err
+	if wr.err != nil {
+		return 0, wr.err
+	}
+	if wr.discard {
+		wr.size += int64(len(p))
+		return len(p), nil
 	}
 
 	return wr.w.Write(p)
 }
 
 func (wr *writer) Close() (Upload, error) {
+	wr.once.Do(func() {
+		wr.err = wr.open()
+	})
+	if wr.err != nil {
+		return Upload{}, wr.err
+	}
+	if wr.discard {
+		return wr.dryRunUpload(), nil
+	}
+
 	defer close(wr.c)
 	err := wr.w.Close()
 	if err != nil {
@@ -153,11 +207,36 @@ func (wr *writer) Close() (Upload, error) {
 	return data.Upload, nil
 }
 
+// abort cancels the upload in progress with err, which ends the request
+func (wr *writer) abort(err error) {
+	if wr.w != nil {
+		wr.w.CloseWithError(err)
+	}
+}
+
+// dryRunUpload returns the upload synthesized in dry-run mode. The token is
+// the given one, or a placeholder so that it can be passed to comments.
+func (wr *writer) dryRunUpload() Upload {
+	token := wr.token
+	if token == "" {
+		token = "dry-run"
+	}
+	attachment := Attachment{FileName: wr.filename, Size: wr.size}
+	return Upload{
+		Attachment:  attachment,
+		Attachments: []Attachment{attachment},
+		Token:       token,
+	}
+}
+
 // AttachmentAPI an interface containing all of the attachment related zendesk methods
 type AttachmentAPI interface {
 	UploadAttachment(ctx context.Context, filename string, token string) UploadWriter
 	DeleteUpload(ctx context.Context, token string) error
 	GetAttachment(ctx context.Context, id int64) (Attachment, error)
+	UpdateAttachmentMalwareAccessOverride(ctx context.Context, id int64, override bool) (Attachment, error)
+	RedactCommentAttachment(ctx context.Context, ticketID, commentID, attachmentID int64) error
+	DownloadAttachment(ctx context.Context, attachment Attachment, w io.Writer) (int64, error)
 }
 
 // UploadAttachment returns a writer that can be used to create a zendesk attachment
@@ -171,6 +250,32 @@ func (z *Client) UploadAttachment(ctx context.Context, filename string, token st
 	}
 }
 
+// errEmptyUpload is returned by uploadFile when content is empty
+var errEmptyUpload = errors.New("upload content is empty")
+
+// uploadFile uploads content as a new file and returns its upload token
+func (z *Client) uploadFile(ctx context.Context, filename string, content io.Reader) (string, error) {
+	w := &writer{
+		Client:   z,
+		filename: filename,
+		ctx:      ctx,
+	}
+	n, err := io.Copy(w, content)
+	if err != nil {
+		w.abort(err)
+		return "", err
+	}
+	if n == 0 {
+		return "", errEmptyUpload
+	}
+
+	upload, err := w.Close()
+	if err != nil {
+		return "", err
+	}
+	return upload.Token, nil
+}
+
 // DeleteUpload deletes a previously uploaded file
 // ref: https://developer.zendesk.com/rest_api/docs/support/attachments#delete-upload
 func (z *Client) DeleteUpload(ctx context.Context, token string) error {
@@ -197,6 +302,33 @@ func (z *Client) GetAttachment(ctx context.Context, id int64) (Attachment, error
 	return result.Attachment, nil
 }
 
+// UpdateAttachmentMalwareAccessOverride allows or disallows downloading the attachment flagged as malware
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-attachments/#update-attachment-for-malware
+func (z *Client) UpdateAttachmentMalwareAccessOverride(ctx context.Context, id int64, override bool) (Attachment, error) {
+	var data struct {
+		Attachment struct {
+			MalwareAccessOverride bool `json:"malware_access_override"`
+		} `json:"attachment"`
+	}
+	data.Attachment.MalwareAccessOverride = override
+
+	var result struct {
+		Attachment Attachment `json:"attachment"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/attachments/%d.json", id), data)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	return result.Attachment, nil
+}
+
 // RedactCommentAttachment deletes an attachment with attachmentID on comment with commentID for ticket with ticketID
 // https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-attachments/#redact-comment-attachment
 func (z *Client) RedactCommentAttachment(ctx context.Context, ticketID, commentID, attachmentID int64) error {
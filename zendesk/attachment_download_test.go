@@ -0,0 +1,95 @@
+package zendesk
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDownloadAttachment(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Fatal("credential must not be sent to the storage")
+		}
+		w.Write([]byte("content"))
+	}))
+	defer storage.Close()
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, storage.URL+"/signed?token=x", http.StatusFound)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var buf bytes.Buffer
+	n, err := client.DownloadAttachment(ctx, Attachment{ContentURL: mockAPI.URL + "/attachments/token/abc/?name=a.txt"}, &buf)
+	if err != nil {
+		t.Fatalf("Failed to download attachment: %s", err)
+	}
+	if n != 7 || buf.String() != "content" {
+		t.Fatalf("unexpected content %q", buf.String())
+	}
+}
+
+func TestDownloadAttachmentFromOtherHost(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Fatal("credential must not be sent to other hosts")
+		}
+		w.Write([]byte("content"))
+	}))
+	defer storage.Close()
+
+	client, _ := NewClient(nil)
+	client.SetSubdomain("example")
+	client.SetCredential(NewAPITokenCredential("agent@example.com", "token"))
+
+	var buf bytes.Buffer
+	if _, err := client.DownloadAttachment(ctx, Attachment{ContentURL: storage.URL + "/a.txt"}, &buf); err != nil {
+		t.Fatalf("Failed to download attachment: %s", err)
+	}
+	if buf.String() != "content" {
+		t.Fatalf("unexpected content %q", buf.String())
+	}
+}
+
+func TestIsZendeskURL(t *testing.T) {
+	client, _ := NewClient(nil)
+	client.SetSubdomain("example")
+
+	tests := map[string]bool{
+		"https://example.zendesk.com/attachments/token/x":     true,
+		"https://EXAMPLE.zendesk.com/attachments/token/x":     true,
+		"https://cdn.example.zendesk.com/attachments/token/x": true,
+		"http://example.zendesk.com/attachments/token/x":      false,
+		"https://other.zendesk.com/attachments/token/x":       false,
+		"https://example.zendesk.com.evil.test/x":             false,
+		"https://storage.example.com/x":                       false,
+	}
+	for raw, expected := range tests {
+		u, _ := url.Parse(raw)
+		if actual := client.isZendeskURL(u); actual != expected {
+			t.Errorf("isZendeskURL(%s) = %v, expected %v", raw, actual, expected)
+		}
+	}
+}
+
+func TestDownloadAttachmentError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DownloadAttachment(ctx, Attachment{ContentURL: mockAPI.URL + "/attachments/token/abc"}, &bytes.Buffer{})
+	zerr, ok := err.(Error)
+	if !ok || zerr.Status() != http.StatusNotFound {
+		t.Fatalf("expected 404 error, got %v", err)
+	}
+}
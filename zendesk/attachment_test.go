@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestWrite(t *testing.T) {
@@ -74,6 +78,27 @@ func TestWriteCancelledContext(t *testing.T) {
 	}
 }
 
+func TestUploadAttachmentReadOnly(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer mockAPI.Close()
+
+	client := newTestClient(mockAPI)
+	client.SetReadOnly(true)
+
+	w := client.UploadAttachment(ctx, "foo", "")
+	if _, err := w.Write([]byte("body")); err != ErrReadOnly {
+		t.Fatalf("expected ErrReadOnly from write, but got %v", err)
+	}
+	if _, err := w.Close(); err != ErrReadOnly {
+		t.Fatalf("expected ErrReadOnly from close, but got %v", err)
+	}
+	if _, err := client.uploadFile(ctx, "foo", bytes.NewReader([]byte("body"))); err != ErrReadOnly {
+		t.Fatalf("expected ErrReadOnly from uploadFile, but got %v", err)
+	}
+}
+
 func TestDeleteUpload(t *testing.T) {
 	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
@@ -119,6 +144,24 @@ func TestGetAttachment(t *testing.T) {
 	}
 }
 
+func TestUpdateAttachmentMalwareAccessOverride(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "attachment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.UpdateAttachmentMalwareAccessOverride(ctx, 498483, true)
+	if err != nil {
+		t.Fatalf("Failed to update attachment: %s", err)
+	}
+
+	if attachment.MalwareScanResult != MalwareScanResultMalwareFound || !attachment.MalwareAccessOverride {
+		t.Fatalf("unexpected attachment %v", attachment)
+	}
+	if attachment.IsQuarantined() {
+		t.Fatal("attachment with access override should not be quarantined")
+	}
+}
+
 func TestRedactCommentAttachment(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodPut, "redact_ticket_comment_attachment.json")
 	client := newTestClient(mockAPI)
@@ -130,3 +173,66 @@ func TestRedactCommentAttachment(t *testing.T) {
 		t.Fatalf("Failed to redact ticket comment attachment: %s", err)
 	}
 }
+
+// newMockUploadAPI serves /uploads.json and checks the upload token is sent to
+// the resource at path as payload[key][field].token
+func newMockUploadAPI(t *testing.T, path string, key string, field string, filename string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/uploads.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "upload.json")))
+		case path:
+			var payload map[string]map[string]struct {
+				Token string `json:"token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request: %s", err)
+			}
+			if token := payload[key][field].Token; token != "6bk3gql82em5nmf" {
+				t.Fatalf("unexpected upload token %q", token)
+			}
+			w.Write(readFixture(filepath.Join(http.MethodPut, filename)))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestUploadFileReadError(t *testing.T) {
+	aborted := make(chan error, 1)
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.ReadAll(r.Body)
+		aborted <- err
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	content := io.MultiReader(strings.NewReader("partial"), failingReader{})
+	if _, err := client.uploadFile(ctx, "foo", content); err == nil {
+		t.Fatal("expected error for read failure")
+	}
+
+	select {
+	case err := <-aborted:
+		if err == nil {
+			t.Fatal("expected the upload request to be aborted")
+		}
+	case <-time.After(5 * time.Second):
+		mockAPI.CloseClientConnections()
+		t.Fatal("the upload request was not aborted")
+	}
+}
+
+func TestUploadFileEmpty(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, err := client.uploadFile(ctx, "empty.png", bytes.NewReader(nil)); err != errEmptyUpload {
+		t.Fatalf("expected errEmptyUpload, but got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"encoding/json"
+)
+
+// syncMetadataKey is the key of the custom audit metadata holding SyncAnnotation
+const syncMetadataKey = "sync"
+
+// SyncAnnotation is the provenance of an update made by an integration
+// syncing tickets with another system. It is saved on the audit of the
+// update, so that the integration can tell its own updates from others,
+// e.g. to avoid syncing them back.
+type SyncAnnotation struct {
+	// Source is the name of the integration or the system
+	Source string `json:"source"`
+	// ExternalID is the ID of the record in the system
+	ExternalID string `json:"external_id,omitempty"`
+	// Revision is the revision of the record in the system
+	Revision string `json:"revision,omitempty"`
+	SyncedAt *Time  `json:"synced_at,omitempty"`
+}
+
+// SetAuditMetadata sets the custom metadata saved on the audit of the
+// update of the ticket. Zendesk limits the metadata to 1 kilobyte, and
+// doesn't save it if the update doesn't change the ticket.
+func (t *Ticket) SetAuditMetadata(key string, value interface{}) {
+	if t.Metadata == nil {
+		t.Metadata = map[string]interface{}{}
+	}
+	t.Metadata[key] = value
+}
+
+// SetSyncAnnotation sets the annotation to the custom metadata of the audit
+func (t *Ticket) SetSyncAnnotation(a SyncAnnotation) {
+	t.SetAuditMetadata(syncMetadataKey, a)
+}
+
+// CustomMetadata decodes the custom metadata of the audit with the key into v.
+// It returns false if the audit doesn't have the key.
+func (a TicketAudit) CustomMetadata(key string, v interface{}) (bool, error) {
+	if a.Metadata == nil {
+		return false, nil
+	}
+
+	var metadata struct {
+		Custom map[string]json.RawMessage `json:"custom"`
+	}
+	b, err := json.Marshal(a.Metadata)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &metadata); err != nil {
+		return false, err
+	}
+
+	raw, ok := metadata.Custom[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+// SyncAnnotation returns the annotation set by SetSyncAnnotation.
+// It returns false if the audit was not made by a sync.
+func (a TicketAudit) SyncAnnotation() (SyncAnnotation, bool) {
+	var annotation SyncAnnotation
+	ok, err := a.CustomMetadata(syncMetadataKey, &annotation)
+	if !ok || err != nil {
+		return SyncAnnotation{}, false
+	}
+	return annotation, true
+}
@@ -0,0 +1,32 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSyncAnnotation(t *testing.T) {
+	syncedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	synced := NewTime(syncedAt)
+	var ticket Ticket
+	ticket.SetSyncAnnotation(SyncAnnotation{Source: "jira", ExternalID: "PROJ-1", SyncedAt: &synced})
+
+	b, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"custom": ticket.Metadata}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var audit TicketAudit
+	if err := json.Unmarshal(b, &audit); err != nil {
+		t.Fatal(err)
+	}
+
+	annotation, ok := audit.SyncAnnotation()
+	if !ok || annotation.Source != "jira" || annotation.ExternalID != "PROJ-1" || !annotation.SyncedAt.Equal(syncedAt) {
+		t.Fatalf("unexpected annotation %v", annotation)
+	}
+
+	if _, ok := (TicketAudit{Metadata: map[string]interface{}{"system": map[string]interface{}{}}}).SyncAnnotation(); ok {
+		t.Fatal("expected no annotation")
+	}
+}
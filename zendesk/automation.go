@@ -47,9 +47,19 @@ type Automation struct {
 // ref: https://developer.zendesk.com/rest_api/docs/support/automations#list-automations
 type AutomationListOptions struct {
 	PageOptions
-	Active    bool   `url:"active,omitempty"`
-	SortBy    string `url:"sort_by,omitempty"`
-	SortOrder string `url:"sort_order,omitempty"`
+	Active    bool          `url:"active,omitempty"`
+	SortBy    RuleSortField `url:"sort_by,omitempty"`
+	SortOrder SortOrder     `url:"sort_order,omitempty"`
+}
+
+// Validate checks the sort field and order
+func (o AutomationListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		RuleSortByAlphabetical, RuleSortByCreatedAt, RuleSortByUpdatedAt, RuleSortByPosition,
+		RuleSortByUsage1h, RuleSortByUsage24h, RuleSortByUsage7d); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
 }
 
 // AutomationAPI an interface containing all automation related methods
@@ -92,6 +102,20 @@ func (z *Client) GetAutomations(ctx context.Context, opts *AutomationListOptions
 	return data.Automations, data.Page, nil
 }
 
+// GetAutomationsPage fetches the page of automations at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetAutomationsPage(ctx context.Context, token PageToken, opts *AutomationListOptions) (ListPage[Automation], error) {
+	tmp := AutomationListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Automation, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetAutomations(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateAutomation creates new automation
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/automations#create-automation
@@ -0,0 +1,96 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// batchLimit is the maximum number of records create_many and update_many endpoints accept
+const batchLimit = 100
+
+// BatchOptions are the options of SubmitInBatches
+type BatchOptions struct {
+	// Concurrency is the number of jobs submitted and tracked at the same time. The default is 1.
+	Concurrency int
+	// PollInterval is the interval of polling job statuses. The default is 1 second.
+	PollInterval time.Duration
+}
+
+// BatchResult is the aggregated result of jobs submitted by SubmitInBatches
+type BatchResult struct {
+	// Jobs are the final statuses of the jobs in the order of batches
+	Jobs []JobStatus
+	// Results are the results of all the records. Index of each result
+	// is the position of the record in the items given to SubmitInBatches.
+	Results []JobResult
+}
+
+// Failures returns the results of the records which failed with errors
+func (r BatchResult) Failures() []JobResult {
+	var failures []JobResult
+	for _, result := range r.Results {
+		if !result.Success && result.Error != "" {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// SubmitInBatches splits items into batches of 100 records, submits each
+// batch with submit, e.g. CreateManyTickets, and waits until all the jobs finish.
+// If some batches fail or their jobs are not completed, the results of the
+// other batches are returned along with the errors joined.
+//
+//	result, err := zendesk.SubmitInBatches(ctx, client, tickets, client.CreateManyTickets, nil)
+func SubmitInBatches[T any](ctx context.Context, api JobStatusAPI, items []T, submit func(ctx context.Context, batch []T) (JobStatus, error), opts *BatchOptions) (BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var offsets []int
+	for start := 0; start < len(items); start += batchLimit {
+		offsets = append(offsets, start)
+	}
+
+	jobs := make([]JobStatus, len(offsets))
+	errs := make([]error, len(offsets))
+	_, err := fetchConcurrently(ctx, offsets, opts.Concurrency, func(ctx context.Context, start int) ([]struct{}, error) {
+		i := start / batchLimit
+		end := start + batchLimit
+		if end > len(items) {
+			end = len(items)
+		}
+
+		job, err := submit(ctx, items[start:end])
+		if err == nil {
+			tracked := NewJob(api, job, func(JobStatus) []struct{} { return nil })
+			tracked.PollInterval = interval
+			_, err = tracked.Wait(ctx)
+			job = tracked.Status()
+		}
+		if err != nil {
+			errs[i] = fmt.Errorf("items %d..%d: %w", start, end-1, err)
+		}
+
+		for j := range job.Results {
+			job.Results[j].Index += start
+		}
+		jobs[i] = job
+		return nil, nil
+	})
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	result := BatchResult{Jobs: jobs}
+	for _, job := range jobs {
+		result.Results = append(result.Results, job.Results...)
+	}
+	return result, errors.Join(errs...)
+}
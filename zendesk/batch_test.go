@@ -0,0 +1,90 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSubmitInBatches(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		sizes = map[string]int{}
+		polls = map[string]int{}
+	)
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/tickets/create_many.json":
+			var payload struct {
+				Tickets []Ticket `json:"tickets"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request: %s", err)
+			}
+			if len(payload.Tickets) > 100 {
+				t.Fatalf("batch has %d tickets", len(payload.Tickets))
+			}
+
+			id := payload.Tickets[0].Subject
+			sizes[id] = len(payload.Tickets)
+			fmt.Fprintf(w, `{"job_status":{"id":%q,"status":"queued"}}`, id)
+		case strings.HasPrefix(r.URL.Path, "/job_statuses/"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/job_statuses/"), ".json")
+			polls[id]++
+			if polls[id] < 2 {
+				fmt.Fprintf(w, `{"job_status":{"id":%q,"status":"working"}}`, id)
+				return
+			}
+			if id == "ticket-200" {
+				fmt.Fprintf(w, `{"job_status":{"id":%q,"status":"killed","message":"timeout"}}`, id)
+				return
+			}
+
+			job := JobStatus{ID: id, Status: JobStatusCompleted}
+			for i := 0; i < sizes[id]; i++ {
+				result := JobResult{Index: i, Action: "create", Success: i != 1}
+				if !result.Success {
+					result.Error = "RecordInvalid"
+				}
+				job.Results = append(job.Results, result)
+			}
+			json.NewEncoder(w).Encode(map[string]JobStatus{"job_status": job})
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets := make([]Ticket, 250)
+	for i := range tickets {
+		tickets[i].Subject = fmt.Sprintf("ticket-%d", i)
+	}
+
+	result, err := SubmitInBatches(ctx, client, tickets, client.CreateManyTickets, &BatchOptions{
+		Concurrency:  2,
+		PollInterval: time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "items 200..249") {
+		t.Fatalf("expected error of the killed job, but got %v", err)
+	}
+	if len(result.Jobs) != 3 || result.Jobs[2].Status != JobStatusKilled {
+		t.Fatalf("unexpected jobs %v", result.Jobs)
+	}
+	if len(result.Results) != 200 {
+		t.Fatalf("expected 200 results, but got %d", len(result.Results))
+	}
+
+	failures := result.Failures()
+	if len(failures) != 2 || failures[0].Index != 1 || failures[1].Index != 101 {
+		t.Fatalf("unexpected failures %v", failures)
+	}
+}
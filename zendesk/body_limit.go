@@ -0,0 +1,58 @@
+package zendesk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ResponseTooLargeError is returned when the response body exceeds the
+// limit set with SetMaxResponseSize
+type ResponseTooLargeError struct {
+	Method string
+	URL    string
+	Limit  int64
+}
+
+// Error implements error
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("zendesk: response of %s %s exceeds %d bytes", e.Method, e.URL, e.Limit)
+}
+
+// SetMaxResponseSize sets the maximum size of response bodies in bytes, which
+// protects memory-constrained services from unexpectedly huge payloads.
+// Larger responses fail with *ResponseTooLargeError without being read to the end.
+// StreamList is not limited as it doesn't load the whole body. Zero, the default, means no limit.
+func (z *Client) SetMaxResponseSize(size int64) {
+	if size >= 0 {
+		z.maxResponseSize = size
+	}
+}
+
+// readBody reads and closes the response body within the size limit
+func (z *Client) readBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	limit := z.maxResponseSize
+	if limit <= 0 {
+		return io.ReadAll(resp.Body)
+	}
+
+	tooLarge := &ResponseTooLargeError{Limit: limit}
+	if resp.Request != nil {
+		tooLarge.Method = resp.Request.Method
+		tooLarge.URL = resp.Request.URL.String()
+	}
+	if resp.ContentLength > limit {
+		return nil, tooLarge
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > limit {
+		return nil, tooLarge
+	}
+	return body, nil
+}
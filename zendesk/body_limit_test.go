@@ -0,0 +1,43 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetMaxResponseSize(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetMaxResponseSize(16)
+	_, err := client.get(ctx, "/groups.json")
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 16 || tooLarge.Method != http.MethodGet {
+		t.Fatalf("expected ResponseTooLargeError, but got %v", err)
+	}
+
+	client.SetMaxResponseSize(1 << 20)
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+}
+
+func TestSetMaxResponseSizeWithoutContentLength(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat(" ", 4096)))
+		w.(http.Flusher).Flush()
+		w.Write([]byte("{}"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetMaxResponseSize(1024)
+	var tooLarge *ResponseTooLargeError
+	if _, err := client.get(ctx, "/groups.json"); !errors.As(err, &tooLarge) {
+		t.Fatalf("expected ResponseTooLargeError, but got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -27,14 +28,67 @@ type Brand struct {
 	UpdatedAt         time.Time  `json:"updated_at,omitempty"`
 }
 
+// BrandListOptions is options for GetBrands
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#list-brands
+type BrandListOptions struct {
+	PageOptions
+}
+
 // BrandAPI an interface containing all methods associated with zendesk brands
 type BrandAPI interface {
+	GetBrands(ctx context.Context, opts *BrandListOptions) ([]Brand, Page, error)
 	CreateBrand(ctx context.Context, brand Brand) (Brand, error)
 	GetBrand(ctx context.Context, brandID int64) (Brand, error)
 	UpdateBrand(ctx context.Context, brandID int64, brand Brand) (Brand, error)
+	SetBrandLogo(ctx context.Context, brandID int64, filename string, content io.Reader) (Brand, error)
 	DeleteBrand(ctx context.Context, brandID int64) error
 }
 
+// GetBrands fetches brand list
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#list-brands
+func (z *Client) GetBrands(ctx context.Context, opts *BrandListOptions) ([]Brand, Page, error) {
+	var data struct {
+		Brands []Brand `json:"brands"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &BrandListOptions{}
+	}
+
+	u, err := addOptions("/brands.json", tmp)
+	if err != nil {
+		return []Brand{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []Brand{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Brand{}, Page{}, err
+	}
+	return data.Brands, data.Page, nil
+}
+
+// GetBrandsPage fetches the page of brands at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetBrandsPage(ctx context.Context, token PageToken, opts *BrandListOptions) (ListPage[Brand], error) {
+	tmp := BrandListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Brand, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetBrands(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateBrand creates new brand
 // https://developer.zendesk.com/rest_api/docs/support/brands#create-brand
 func (z *Client) CreateBrand(ctx context.Context, brand Brand) (Brand, error) {
@@ -99,6 +153,39 @@ func (z *Client) UpdateBrand(ctx context.Context, brandID int64, brand Brand) (B
 	return result.Brand, err
 }
 
+// SetBrandLogo uploads the image and sets it as the logo of the brand
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#update-brand
+func (z *Client) SetBrandLogo(ctx context.Context, brandID int64, filename string, content io.Reader) (Brand, error) {
+	token, err := z.uploadFile(ctx, filename, content)
+	if err != nil {
+		return Brand{}, err
+	}
+
+	var data struct {
+		Brand struct {
+			Logo struct {
+				Token string `json:"token"`
+			} `json:"logo"`
+		} `json:"brand"`
+	}
+	data.Brand.Logo.Token = token
+
+	var result struct {
+		Brand Brand `json:"brand"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/brands/%d.json", brandID), data)
+	if err != nil {
+		return Brand{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Brand{}, err
+	}
+	return result.Brand, nil
+}
+
 // DeleteBrand deletes the specified brand
 // ref: https://developer.zendesk.com/rest_api/docs/support/brands#delete-brand
 func (z *Client) DeleteBrand(ctx context.Context, brandID int64) error {
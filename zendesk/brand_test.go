@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -89,3 +90,32 @@ func TestDeleteBrand(t *testing.T) {
 		t.Fatalf("Failed to delete brand: %s", err)
 	}
 }
+
+func TestGetBrands(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "brands.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brands, _, err := client.GetBrands(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get brands: %s", err)
+	}
+
+	if len(brands) == 0 || brands[0].Name != "brand2" {
+		t.Fatalf("unexpected brands %v", brands)
+	}
+}
+
+func TestSetBrandLogo(t *testing.T) {
+	mockAPI := newMockUploadAPI(t, "/brands/360002143133.json", "brand", "logo", "brands.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brand, err := client.SetBrandLogo(ctx, 360002143133, "logo.png", strings.NewReader("png"))
+	if err != nil {
+		t.Fatalf("Failed to set brand logo: %s", err)
+	}
+	if brand.ID == 0 {
+		t.Fatalf("unexpected brand %v", brand)
+	}
+}
@@ -0,0 +1,103 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// CachedResponse is a response of GET request stored in Cache
+type CachedResponse struct {
+	Body         []byte
+	ETag         string
+	LastModified string
+}
+
+// Cache is a storage of GET responses keyed by request URL.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (CachedResponse, bool)
+	Set(key string, res CachedResponse)
+}
+
+// MemoryCache is an in-memory Cache
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]CachedResponse
+}
+
+// NewMemoryCache creates MemoryCache and returns its pointer
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: map[string]CachedResponse{},
+	}
+}
+
+// Get returns the response stored with key
+func (c *MemoryCache) Get(key string) (CachedResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	res, ok := c.entries[key]
+	return res, ok
+}
+
+// Set stores the response with key
+func (c *MemoryCache) Set(key string, res CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = res
+}
+
+// SetCache sets the cache of GET responses. Cached responses are always
+// revalidated with If-None-Match or If-Modified-Since header, and the cached
+// body is used when Zendesk responds 304 Not Modified. Only responses with
+// ETag or Last-Modified header are cached. Pass nil to disable caching.
+func (z *Client) SetCache(cache Cache) {
+	z.cache = cache
+}
+
+// getWithCache fetches data from API using the client cache
+func (z *Client) getWithCache(ctx context.Context, path string) ([]byte, error) {
+	key := z.baseURL.String() + path
+	cached, ok := z.cache.Get(key)
+	if ok {
+		header := http.Header{}
+		if cached.ETag != "" {
+			header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			header.Set("If-Modified-Since", cached.LastModified)
+		}
+		ctx = withRequestHeader(ctx, header)
+	}
+
+	resp, err := z.doRequest(ctx, path, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && ok:
+		return cached.Body, nil
+	case resp.StatusCode == http.StatusOK:
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			z.cache.Set(key, CachedResponse{
+				Body:         body,
+				ETag:         etag,
+				LastModified: lastModified,
+			})
+		}
+		return body, nil
+	}
+
+	return nil, Error{
+		body: body,
+		resp: resp,
+	}
+}
@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetWithCache(t *testing.T) {
+	etag := `"abc"`
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Write(readFixture("GET/ticket_fields.json"))
+	}))
+	client := newTestClient(mockAPI)
+	client.SetCache(NewMemoryCache())
+	defer mockAPI.Close()
+
+	first, _, err := client.GetTicketFields(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields: %s", err)
+	}
+
+	second, _, err := client.GetTicketFields(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields from cache: %s", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, but got %d", requests)
+	}
+	if len(first) == 0 || len(first) != len(second) {
+		t.Fatalf("cached response differs: %d != %d", len(first), len(second))
+	}
+}
+
+func TestGetWithCacheWithoutValidator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_fields.json")
+	client := newTestClient(mockAPI)
+	cache := NewMemoryCache()
+	client.SetCache(cache)
+	defer mockAPI.Close()
+
+	if _, _, err := client.GetTicketFields(ctx); err != nil {
+		t.Fatalf("Failed to get ticket fields: %s", err)
+	}
+
+	if len(cache.entries) != 0 {
+		t.Fatal("response without ETag or Last-Modified should not be cached")
+	}
+}
+
+func TestGetWithCacheFailure(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "ticket_fields.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	client.SetCache(NewMemoryCache())
+	defer mockAPI.Close()
+
+	_, _, err := client.GetTicketFields(ctx)
+	if _, ok := err.(Error); !ok {
+		t.Fatalf("Did not return a zendesk error %s", err)
+	}
+}
@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// capabilitiesTTL is how long Capabilities caches the result
+var capabilitiesTTL = time.Hour
+
+// Capabilities is the set of features available on the account, so that
+// higher-level code can skip the APIs which the account doesn't support.
+type Capabilities struct {
+	// Multibrand is true if the account has more than one brand
+	Multibrand bool
+	// HelpCenter is true if any brand has an enabled Help Center
+	HelpCenter     bool
+	AgentWorkspace bool
+	CustomStatuses bool
+	CustomObjects  bool
+	Sandbox        bool
+	// Features are the active features of account settings
+	Features ActiveFeatures
+	// FetchedAt is when the capabilities were probed
+	FetchedAt time.Time
+}
+
+// CapabilityAPI an interface containing feature detection methods
+type CapabilityAPI interface {
+	Capabilities(ctx context.Context) (Capabilities, error)
+}
+
+// capabilityCache is the result of Capabilities cached in Client
+type capabilityCache struct {
+	mu   sync.Mutex
+	caps *Capabilities
+}
+
+// Capabilities probes account settings, brands, custom statuses and custom
+// objects, and returns the features available on the account. The result is
+// cached for an hour per client. Endpoints responding 403 or 404 are treated
+// as unavailable, and other errors are returned as they are.
+func (z *Client) Capabilities(ctx context.Context) (Capabilities, error) {
+	z.capabilities.mu.Lock()
+	defer z.capabilities.mu.Unlock()
+
+	if c := z.capabilities.caps; c != nil && time.Since(c.FetchedAt) < capabilitiesTTL {
+		return *c, nil
+	}
+
+	settings, err := z.GetAccountSettings(ctx)
+	if err != nil {
+		return Capabilities{}, err
+	}
+
+	caps := Capabilities{
+		Features:  settings.ActiveFeatures,
+		Sandbox:   settings.ActiveFeatures.Enabled(ActiveFeatureSandbox),
+		FetchedAt: time.Now(),
+	}
+	if caps.Features == nil {
+		caps.Features = ActiveFeatures{}
+	}
+	if settings.Agents != nil {
+		caps.AgentWorkspace = settings.Agents.AgentWorkspace
+	}
+
+	brands, _, err := z.GetBrands(ctx, nil)
+	if err != nil {
+		return Capabilities{}, err
+	}
+	caps.Multibrand = len(brands) > 1
+	for _, b := range brands {
+		if b.HasHelpCenter && b.HelpCenterState == "enabled" {
+			caps.HelpCenter = true
+		}
+	}
+
+	if caps.CustomStatuses, err = z.probe(ctx, "/custom_statuses.json"); err != nil {
+		return Capabilities{}, err
+	}
+	if caps.CustomObjects, err = z.probe(ctx, "/custom_objects"); err != nil {
+		return Capabilities{}, err
+	}
+
+	z.capabilities.caps = &caps
+	return caps, nil
+}
+
+// probe reports whether the endpoint is available on the account
+func (z *Client) probe(ctx context.Context, path string) (bool, error) {
+	_, err := z.get(ctx, path)
+	if err == nil {
+		return true, nil
+	}
+
+	var zerr Error
+	if errors.As(err, &zerr) {
+		switch zerr.Status() {
+		case http.StatusForbidden, http.StatusNotFound:
+			return false, nil
+		}
+	}
+	return false, err
+}
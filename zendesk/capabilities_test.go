@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch r.URL.Path {
+		case "/account/settings.json":
+			w.Write(readFixture("GET/account_settings.json"))
+		case "/brands.json":
+			w.Write([]byte(`{"brands": [
+				{"id": 1, "has_help_center": true, "help_center_state": "enabled"},
+				{"id": 2, "has_help_center": false}
+			]}`))
+		case "/custom_statuses.json":
+			w.WriteHeader(http.StatusForbidden)
+		case "/custom_objects":
+			w.Write([]byte(`{"custom_objects": []}`))
+		default:
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	caps, err := client.Capabilities(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get capabilities: %s", err)
+	}
+	if !caps.Features.Enabled(ActiveFeatureTicketForms) {
+		t.Fatalf("unexpected features %v", caps.Features)
+	}
+
+	caps.Features = nil
+	expected := Capabilities{
+		Multibrand:     true,
+		HelpCenter:     true,
+		AgentWorkspace: true,
+		CustomObjects:  true,
+		FetchedAt:      caps.FetchedAt,
+	}
+	if !reflect.DeepEqual(caps, expected) {
+		t.Fatalf("expected %+v, but got %+v", expected, caps)
+	}
+
+	if _, err := client.Capabilities(ctx); err != nil {
+		t.Fatalf("Failed to get cached capabilities: %s", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 4 {
+		t.Fatalf("expected capabilities to be cached, but %d requests were sent", n)
+	}
+}
+
+func TestCapabilitiesError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "account_settings.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.Capabilities(ctx); err == nil {
+		t.Fatal("expected an error")
+	}
+}
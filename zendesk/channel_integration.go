@@ -0,0 +1,97 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// ChannelIntegrationTypeLine is LINE integration
+	ChannelIntegrationTypeLine = "line"
+	// ChannelIntegrationTypeWeChat is WeChat integration
+	ChannelIntegrationTypeWeChat = "wechat"
+	// ChannelIntegrationTypeWhatsApp is WhatsApp integration
+	ChannelIntegrationTypeWhatsApp = "whatsapp"
+	// ChannelIntegrationTypeMessenger is Facebook Messenger integration
+	ChannelIntegrationTypeMessenger = "messenger"
+	// ChannelIntegrationTypeInstagram is Instagram Direct integration
+	ChannelIntegrationTypeInstagram = "instagram"
+	// ChannelIntegrationTypeWeb is Web Widget and mobile SDK integration
+	ChannelIntegrationTypeWeb = "web"
+)
+
+// ChannelIntegration is a messaging channel integrated with the account
+type ChannelIntegration struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	DisplayName string `json:"display_name"`
+	BrandID     int64  `json:"brand_id,omitempty"`
+	Status      string `json:"status"`
+	CreatedAt   *Time  `json:"created_at,omitempty"`
+	UpdatedAt   *Time  `json:"updated_at,omitempty"`
+}
+
+// Active reports whether the channel is live
+func (c ChannelIntegration) Active() bool {
+	return c.Status == "active"
+}
+
+// ChannelIntegrationListOptions is options for GetChannelIntegrations
+type ChannelIntegrationListOptions struct {
+	// Type filters integrations by one of ChannelIntegrationType* values
+	Type string `url:"type,omitempty"`
+}
+
+// ChannelIntegrationAPI an interface containing all messaging channel integration related methods
+type ChannelIntegrationAPI interface {
+	GetChannelIntegrations(ctx context.Context, opts *ChannelIntegrationListOptions) ([]ChannelIntegration, error)
+	GetChannelIntegration(ctx context.Context, integrationID string) (ChannelIntegration, error)
+}
+
+// GetChannelIntegrations fetches the messaging channels integrated with the account,
+// e.g. LINE, WeChat and WhatsApp
+func (z *Client) GetChannelIntegrations(ctx context.Context, opts *ChannelIntegrationListOptions) ([]ChannelIntegration, error) {
+	var result struct {
+		Integrations []ChannelIntegration `json:"integrations"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChannelIntegrationListOptions{}
+	}
+
+	u, err := addOptions("/channels/integrations.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Integrations, nil
+}
+
+// GetChannelIntegration fetches the messaging channel integration
+func (z *Client) GetChannelIntegration(ctx context.Context, integrationID string) (ChannelIntegration, error) {
+	var result struct {
+		Integration ChannelIntegration `json:"integration"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/integrations/%s.json", integrationID))
+	if err != nil {
+		return ChannelIntegration{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChannelIntegration{}, err
+	}
+	return result.Integration, nil
+}
@@ -0,0 +1,41 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetChannelIntegrations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != ChannelIntegrationTypeLine {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "channel_integrations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	integrations, err := client.GetChannelIntegrations(ctx, &ChannelIntegrationListOptions{Type: ChannelIntegrationTypeLine})
+	if err != nil {
+		t.Fatalf("Failed to get channel integrations: %s", err)
+	}
+	if len(integrations) != 2 || !integrations[0].Active() || integrations[1].Active() {
+		t.Fatalf("unexpected integrations %v", integrations)
+	}
+}
+
+func TestGetChannelIntegration(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "channel_integration.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	integration, err := client.GetChannelIntegration(ctx, "5e4af71a81966b000f0eb8c1")
+	if err != nil {
+		t.Fatalf("Failed to get channel integration: %s", err)
+	}
+	if integration.Type != ChannelIntegrationTypeLine {
+		t.Fatalf("unexpected integration %v", integration)
+	}
+}
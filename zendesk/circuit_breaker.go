@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request while the circuit
+// breaker is open after consecutive failures
+var ErrCircuitOpen = errors.New("zendesk: circuit breaker is open")
+
+// CircuitBreakerOptions is options for SetCircuitBreaker
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures which opens
+	// the circuit. The default is 5.
+	FailureThreshold int
+	// CoolDown is how long the circuit stays open before a trial request is
+	// let through. The default is 30 seconds.
+	CoolDown time.Duration
+	// IsFailure reports whether the result of a request counts as a failure.
+	// The default counts transport errors and 5xx responses.
+	IsFailure func(resp *http.Response, err error) bool
+}
+
+// circuitBreaker is closed while failures are below the threshold, open
+// during the cool-down, and half-open while the trial request is in flight
+type circuitBreaker struct {
+	mu        sync.Mutex
+	opts      CircuitBreakerOptions
+	failures  int
+	openedAt  time.Time
+	open      bool
+	trialSent bool
+}
+
+// SetCircuitBreaker enables the circuit breaker, which makes requests fail
+// fast with ErrCircuitOpen after consecutive failures until the cool-down
+// passes. If opts is nil, the circuit breaker is disabled.
+func (z *Client) SetCircuitBreaker(opts *CircuitBreakerOptions) {
+	if opts == nil {
+		z.breaker = nil
+		return
+	}
+
+	cb := &circuitBreaker{opts: *opts}
+	if cb.opts.FailureThreshold <= 0 {
+		cb.opts.FailureThreshold = 5
+	}
+	if cb.opts.CoolDown <= 0 {
+		cb.opts.CoolDown = 30 * time.Second
+	}
+	if cb.opts.IsFailure == nil {
+		cb.opts.IsFailure = isServerFailure
+	}
+	z.breaker = cb
+}
+
+// isServerFailure is the default of CircuitBreakerOptions.IsFailure
+func isServerFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// allow returns ErrCircuitOpen if the request must not be sent
+func (cb *circuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !cb.open {
+		return nil
+	}
+	if cb.trialSent || time.Since(cb.openedAt) < cb.opts.CoolDown {
+		return ErrCircuitOpen
+	}
+	cb.trialSent = true
+	return nil
+}
+
+// record updates the state with the result of the request. A request
+// abandoned by the caller is neither a success nor a failure, and a trial
+// request abandoned in the half-open state lets the next request be the trial.
+func (cb *circuitBreaker) record(ctx context.Context, resp *http.Response, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err != nil && ctx.Err() != nil {
+		cb.trialSent = false
+		return
+	}
+
+	if !cb.opts.IsFailure(resp, err) {
+		cb.failures = 0
+		cb.open = false
+		cb.trialSent = false
+		return
+	}
+
+	cb.failures++
+	if cb.open || cb.failures >= cb.opts.FailureThreshold {
+		cb.open = true
+		cb.openedAt = time.Now()
+		cb.trialSent = false
+	}
+}
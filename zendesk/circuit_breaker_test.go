@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCircuitBreaker(&CircuitBreakerOptions{FailureThreshold: 2, CoolDown: 50 * time.Millisecond})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.get(ctx, "/groups.json"); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected server error, but got %v", err)
+		}
+	}
+	if _, err := client.get(ctx, "/groups.json"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected open circuit, but got %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("request was sent while the circuit is open: %d", requests)
+	}
+
+	// the trial request fails and the circuit opens again
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.get(ctx, "/groups.json"); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected server error, but got %v", err)
+	}
+	if _, err := client.get(ctx, "/groups.json"); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected open circuit, but got %v", err)
+	}
+
+	// the trial request succeeds and the circuit closes
+	status = http.StatusOK
+	time.Sleep(60 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if _, err := client.get(ctx, "/groups.json"); err != nil {
+			t.Fatalf("Failed to send request: %s", err)
+		}
+	}
+	if requests != 6 {
+		t.Fatalf("unexpected requests %d", requests)
+	}
+}
+
+func TestCircuitBreakerIgnoresCanceledTrial(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCircuitBreaker(&CircuitBreakerOptions{FailureThreshold: 1, CoolDown: 50 * time.Millisecond})
+	if _, err := client.get(ctx, "/groups.json"); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected server error, but got %v", err)
+	}
+
+	// the trial request canceled by the caller does not close the circuit
+	status = http.StatusOK
+	time.Sleep(60 * time.Millisecond)
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := client.get(canceled, "/groups.json"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled error, but got %v", err)
+	}
+	if client.breaker.failures != 1 || !client.breaker.open {
+		t.Fatalf("canceled trial changed the state %+v", client.breaker)
+	}
+
+	// the next request is the trial, and it closes the circuit
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send trial request: %s", err)
+	}
+	if client.breaker.open {
+		t.Fatal("circuit should be closed after the successful trial")
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetCircuitBreaker(&CircuitBreakerOptions{FailureThreshold: 1})
+	for i := 0; i < 3; i++ {
+		if _, err := client.get(ctx, "/groups/1.json"); errors.Is(err, ErrCircuitOpen) {
+			t.Fatal("circuit opened by client errors")
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// ClientPoolOptions is options for NewClientPool
+type ClientPoolOptions struct {
+	// HTTPClient is shared by the clients of all accounts. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+	// RequestQueue enables the request queue of each client, so that every
+	// account has its own rate limit budget.
+	RequestQueue *RequestQueueOptions
+	// CredentialFunc looks up the credential of the account not registered
+	// with Register, e.g. from a database. Client fails for such accounts if nil.
+	CredentialFunc func(subdomain string) (Credential, error)
+	// Configure is called for each client created by the pool, e.g. to set
+	// the retry policy or the cache.
+	Configure func(subdomain string, client *Client) error
+}
+
+// ClientPool manages the clients of many Zendesk accounts keyed by subdomain.
+// It is safe for concurrent use.
+type ClientPool struct {
+	opts ClientPoolOptions
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewClientPool creates ClientPool
+func NewClientPool(opts *ClientPoolOptions) *ClientPool {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ClientPoolOptions{}
+	}
+	return &ClientPool{
+		opts:    *tmp,
+		clients: map[string]*Client{},
+	}
+}
+
+// Register creates the client of the account with the credential. If the account
+// has been registered, its client is replaced, e.g. after the token is rotated.
+// The clients obtained before keep using the old credential.
+func (p *ClientPool) Register(subdomain string, cred Credential) (*Client, error) {
+	client, err := p.newClient(subdomain, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clients[subdomain] = client
+	return client, nil
+}
+
+// Client returns the client of the account. The client is created with
+// CredentialFunc if the account has not been registered.
+func (p *ClientPool) Client(subdomain string) (*Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[subdomain]; ok {
+		return client, nil
+	}
+	if p.opts.CredentialFunc == nil {
+		return nil, fmt.Errorf("account %s is not registered", subdomain)
+	}
+
+	cred, err := p.opts.CredentialFunc(subdomain)
+	if err != nil {
+		return nil, err
+	}
+	client, err := p.newClient(subdomain, cred)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[subdomain] = client
+	return client, nil
+}
+
+// Remove removes the client of the account, e.g. when it uninstalls the integration
+func (p *ClientPool) Remove(subdomain string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, subdomain)
+}
+
+// Subdomains returns the subdomains of the accounts in the pool in sorted order
+func (p *ClientPool) Subdomains() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	subdomains := make([]string, 0, len(p.clients))
+	for subdomain := range p.clients {
+		subdomains = append(subdomains, subdomain)
+	}
+	sort.Strings(subdomains)
+	return subdomains
+}
+
+func (p *ClientPool) newClient(subdomain string, cred Credential) (*Client, error) {
+	client, err := NewClient(p.opts.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.SetSubdomain(subdomain); err != nil {
+		return nil, err
+	}
+	client.SetCredential(cred)
+	if p.opts.RequestQueue != nil {
+		client.SetRequestQueue(p.opts.RequestQueue)
+	}
+	if p.opts.Configure != nil {
+		if err := p.opts.Configure(subdomain, client); err != nil {
+			return nil, err
+		}
+	}
+	return client, nil
+}
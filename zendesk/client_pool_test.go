@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClientPool(t *testing.T) {
+	var configured []string
+	pool := NewClientPool(&ClientPoolOptions{
+		RequestQueue: &RequestQueueOptions{RequestsPerMinute: 700},
+		CredentialFunc: func(subdomain string) (Credential, error) {
+			if subdomain == "unknown" {
+				return nil, errors.New("not installed")
+			}
+			return NewAPITokenCredential("admin@"+subdomain+".com", "token"), nil
+		},
+		Configure: func(subdomain string, client *Client) error {
+			configured = append(configured, subdomain)
+			return nil
+		},
+	})
+
+	acme, err := pool.Register("acme", NewAPITokenCredential("admin@acme.com", "secret"))
+	if err != nil {
+		t.Fatalf("Failed to register account: %s", err)
+	}
+	if acme.baseURL.Host != "acme.zendesk.com" || acme.credential.Secret() != "secret" || acme.queue == nil {
+		t.Fatalf("unexpected client %v", acme)
+	}
+
+	if c, err := pool.Client("acme"); err != nil || c != acme {
+		t.Fatalf("unexpected client %v %v", c, err)
+	}
+
+	globex, err := pool.Client("globex")
+	if err != nil {
+		t.Fatalf("Failed to get client: %s", err)
+	}
+	if !strings.HasPrefix(globex.credential.Email(), "admin@globex.com") || globex.queue == acme.queue {
+		t.Fatalf("unexpected client %v", globex)
+	}
+
+	if _, err := pool.Client("unknown"); err == nil {
+		t.Fatal("Client did not return error of CredentialFunc")
+	}
+	if _, err := pool.Register(".invalid", NewAPITokenCredential("", "")); err == nil {
+		t.Fatal("Register did not return error for invalid subdomain")
+	}
+
+	if !reflect.DeepEqual(pool.Subdomains(), []string{"acme", "globex"}) {
+		t.Fatalf("unexpected subdomains %v", pool.Subdomains())
+	}
+	pool.Remove("acme")
+	if !reflect.DeepEqual(pool.Subdomains(), []string{"globex"}) {
+		t.Fatalf("unexpected subdomains %v", pool.Subdomains())
+	}
+	if !reflect.DeepEqual(configured, []string{"acme", "globex"}) {
+		t.Fatalf("unexpected configured clients %v", configured)
+	}
+}
+
+func TestClientPoolWithoutCredentialFunc(t *testing.T) {
+	pool := NewClientPool(nil)
+	if _, err := pool.Client("acme"); err == nil {
+		t.Fatal("Client did not return error for unregistered account")
+	}
+}
@@ -12,6 +12,36 @@ type Collaborator struct {
 	Email string `json:"email,omitempty"`
 }
 
+// CCAction is the action of EmailCC and Follower on ticket update
+type CCAction string
+
+const (
+	// CCActionPut adds the user
+	CCActionPut CCAction = "put"
+	// CCActionDelete removes the user
+	CCActionDelete CCAction = "delete"
+)
+
+// EmailCC adds or removes an email CC of ticket. The user is identified by
+// UserID or UserEmail, and UserName is used when a new user is created.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-cc-s-and-followers/
+type EmailCC struct {
+	UserID    int64    `json:"user_id,omitempty"`
+	UserEmail string   `json:"user_email,omitempty"`
+	UserName  string   `json:"user_name,omitempty"`
+	Action    CCAction `json:"action,omitempty"`
+}
+
+// Follower adds or removes a follower of ticket. The user is identified by UserID or UserEmail.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-cc-s-and-followers/
+type Follower struct {
+	UserID    int64    `json:"user_id,omitempty"`
+	UserEmail string   `json:"user_email,omitempty"`
+	Action    CCAction `json:"action,omitempty"`
+}
+
 // Collaborators hold array of interface which can take Collaborator
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-collaborators
@@ -64,3 +64,29 @@ func TestCanBeRemarshalled(t *testing.T) {
 		t.Fatalf("remarshalling is inconsistent")
 	}
 }
+
+func TestTicketEmailCCsAndFollowers(t *testing.T) {
+	var additional Collaborators
+	_ = additional.Append("someone@example.com")
+
+	ticket := Ticket{
+		EmailCCs: []EmailCC{
+			{UserID: 1, Action: CCActionPut},
+			{UserEmail: "former@example.com", Action: CCActionDelete},
+		},
+		Followers:               []Follower{{UserID: 2, Action: CCActionPut}},
+		AdditionalCollaborators: &additional,
+	}
+
+	out, err := json.Marshal(ticket)
+	if err != nil {
+		t.Fatalf("Marshal returned an error %v", err)
+	}
+
+	expected := `{"additional_collaborators":["someone@example.com"],` +
+		`"email_ccs":[{"user_id":1,"action":"put"},{"user_email":"former@example.com","action":"delete"}],` +
+		`"followers":[{"user_id":2,"action":"put"}]}`
+	if string(out) != expected {
+		t.Fatalf("Json output %s did not match expected output %s", out, expected)
+	}
+}
@@ -0,0 +1,408 @@
+package zendesk
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// htmlTokenType is the kind of htmlToken
+type htmlTokenType int
+
+const (
+	htmlTextToken htmlTokenType = iota
+	htmlStartTagToken
+	htmlEndTagToken
+)
+
+// htmlToken is a text or a tag of HTML. data is the raw text with entities
+// escaped, or the lower cased tag name.
+type htmlToken struct {
+	typ   htmlTokenType
+	data  string
+	attrs [][2]string
+}
+
+// htmlRawTextTags are the elements whose contents are not HTML
+var htmlRawTextTags = map[string]bool{"script": true, "style": true, "textarea": true, "title": true}
+
+// tokenizeHTML splits s into texts and tags. It is lenient like browsers, so
+// that a stray "<" is a text, and comments and doctypes are skipped.
+func tokenizeHTML(s string) []htmlToken {
+	var tokens []htmlToken
+	text := func(t string) {
+		if t == "" {
+			return
+		}
+		if n := len(tokens); n > 0 && tokens[n-1].typ == htmlTextToken {
+			tokens[n-1].data += t
+			return
+		}
+		tokens = append(tokens, htmlToken{typ: htmlTextToken, data: t})
+	}
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			text(s)
+			break
+		}
+		text(s[:i])
+		s = s[i:]
+
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s[4:], "-->")
+			if end < 0 {
+				return tokens
+			}
+			s = s[4+end+3:]
+			continue
+		case strings.HasPrefix(s, "<!") || strings.HasPrefix(s, "<?"):
+			end := strings.IndexByte(s, '>')
+			if end < 0 {
+				return tokens
+			}
+			s = s[end+1:]
+			continue
+		}
+
+		tok, rest, ok := parseHTMLTag(s)
+		if !ok {
+			text("<")
+			s = s[1:]
+			continue
+		}
+		tokens = append(tokens, tok)
+		s = rest
+
+		if tok.typ == htmlStartTagToken && htmlRawTextTags[tok.data] {
+			end := indexHTMLEndTag(s, tok.data)
+			text(html.EscapeString(s[:end]))
+			s = s[end:]
+		}
+	}
+	return tokens
+}
+
+// indexHTMLEndTag returns the index of the end tag of the lower cased name in s,
+// or len(s) if not found. The tag name is compared case-insensitively in ASCII on
+// s itself because lower casing s may change the byte length of other runes.
+func indexHTMLEndTag(s string, name string) int {
+	for i := 0; i+2+len(name) <= len(s); i++ {
+		if s[i] == '<' && s[i+1] == '/' && asciiEqualFold(s[i+2:i+2+len(name)], name) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// asciiEqualFold reports whether s equals the lower cased ASCII name ignoring case
+func asciiEqualFold(s string, name string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != name[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseHTMLTag parses the tag at the beginning of s
+func parseHTMLTag(s string) (htmlToken, string, bool) {
+	tok := htmlToken{typ: htmlStartTagToken}
+	i := 1
+	if i < len(s) && s[i] == '/' {
+		tok.typ = htmlEndTagToken
+		i++
+	}
+
+	start := i
+	for i < len(s) && isHTMLNameChar(s[i]) {
+		i++
+	}
+	if i == start || !isASCIILetter(s[start]) {
+		return htmlToken{}, s, false
+	}
+	tok.data = strings.ToLower(s[start:i])
+
+	for {
+		for i < len(s) && (isHTMLSpace(s[i]) || s[i] == '/') {
+			i++
+		}
+		if i >= len(s) {
+			return htmlToken{}, s, false
+		}
+		if s[i] == '>' {
+			return tok, s[i+1:], true
+		}
+
+		start := i
+		for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '=' && s[i] != '>' && s[i] != '/' {
+			i++
+		}
+		name := strings.ToLower(s[start:i])
+		for i < len(s) && isHTMLSpace(s[i]) {
+			i++
+		}
+
+		var value string
+		if i < len(s) && s[i] == '=' {
+			i++
+			for i < len(s) && isHTMLSpace(s[i]) {
+				i++
+			}
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				end := strings.IndexByte(s[i+1:], s[i])
+				if end < 0 {
+					return htmlToken{}, s, false
+				}
+				value = s[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				start := i
+				for i < len(s) && !isHTMLSpace(s[i]) && s[i] != '>' {
+					i++
+				}
+				value = s[start:i]
+			}
+		}
+		tok.attrs = append(tok.attrs, [2]string{name, html.UnescapeString(value)})
+	}
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isHTMLNameChar(c byte) bool {
+	return isASCIILetter(c) || ('0' <= c && c <= '9') || c == '-' || c == ':'
+}
+
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// htmlVoidTags are the elements without contents
+var htmlVoidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// sanitizedHTMLTags are the elements kept by SanitizeHTML with their allowed attributes
+var sanitizedHTMLTags = map[string][]string{
+	"a": {"href", "title"}, "img": {"src", "alt", "title", "width", "height"},
+	"b": nil, "strong": nil, "i": nil, "em": nil, "u": nil, "s": nil, "strike": nil, "del": nil,
+	"sub": nil, "sup": nil, "span": nil, "div": nil, "p": nil, "br": nil, "hr": nil,
+	"blockquote": nil, "pre": nil, "code": nil, "ul": nil, "ol": nil, "li": nil,
+	"h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+	"table": nil, "thead": nil, "tbody": nil, "tfoot": nil, "tr": nil,
+	"th": {"colspan", "rowspan"}, "td": {"colspan", "rowspan"},
+}
+
+// droppedHTMLTags are the elements removed by SanitizeHTML with their contents
+var droppedHTMLTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"template": true, "noscript": true, "textarea": true, "title": true, "head": true,
+}
+
+// htmlImplicitlyClosedTags are the elements closed by the next sibling of the same name
+var htmlImplicitlyClosedTags = map[string]bool{"p": true, "li": true, "tr": true, "td": true, "th": true}
+
+var safeURLRegexp = regexp.MustCompile(`^(?i)(https?:|mailto:|[^:]*$)`)
+
+// SanitizeHTML returns htmlBody with only the elements and attributes safe to
+// render in a web page, such as html_body of ticket comments. Scripts, styles,
+// event handlers and links other than http, https and mailto are removed, and
+// unclosed elements are closed.
+func SanitizeHTML(htmlBody string) string {
+	var b strings.Builder
+	var open []string
+	dropping := 0
+
+	for _, tok := range tokenizeHTML(htmlBody) {
+		switch tok.typ {
+		case htmlTextToken:
+			if dropping == 0 {
+				b.WriteString(html.EscapeString(html.UnescapeString(tok.data)))
+			}
+		case htmlStartTagToken:
+			if droppedHTMLTags[tok.data] {
+				dropping++
+				continue
+			}
+			allowed, ok := sanitizedHTMLTags[tok.data]
+			if !ok || dropping > 0 {
+				continue
+			}
+
+			if n := len(open); n > 0 && open[n-1] == tok.data && htmlImplicitlyClosedTags[tok.data] {
+				b.WriteString("</" + tok.data + ">")
+				open = open[:n-1]
+			}
+
+			b.WriteString("<" + tok.data)
+			for _, attr := range tok.attrs {
+				if !containsString(allowed, attr[0]) {
+					continue
+				}
+				if (attr[0] == "href" || attr[0] == "src") && !safeURLRegexp.MatchString(strings.TrimSpace(attr[1])) {
+					continue
+				}
+				b.WriteString(" " + attr[0] + `="` + html.EscapeString(attr[1]) + `"`)
+			}
+			b.WriteString(">")
+			if !htmlVoidTags[tok.data] {
+				open = append(open, tok.data)
+			}
+		case htmlEndTagToken:
+			if droppedHTMLTags[tok.data] {
+				if dropping > 0 {
+					dropping--
+				}
+				continue
+			}
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == tok.data {
+					for j := len(open) - 1; j >= i; j-- {
+						b.WriteString("</" + open[j] + ">")
+					}
+					open = open[:i]
+					break
+				}
+			}
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return b.String()
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// htmlParagraphTags are the elements rendered as paragraphs in plain text
+var htmlParagraphTags = map[string]bool{
+	"p": true, "div": true, "blockquote": true, "pre": true, "ul": true, "ol": true, "table": true, "hr": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+var (
+	htmlSpacesRegexp     = regexp.MustCompile(`[ \t\r\n\f]+`)
+	plainBlankLineRegexp = regexp.MustCompile(`\n{3,}`)
+)
+
+// HTMLToPlainText converts htmlBody such as html_body of ticket comments to
+// plain text. Paragraphs are separated by blank lines, list items are
+// prefixed with "- ", and the URLs of links are appended to their texts.
+func HTMLToPlainText(htmlBody string) string {
+	var b strings.Builder
+	var href string
+	pre, dropping := 0, 0
+
+	for _, tok := range tokenizeHTML(htmlBody) {
+		switch tok.typ {
+		case htmlTextToken:
+			if dropping > 0 {
+				continue
+			}
+			t := html.UnescapeString(tok.data)
+			if pre == 0 {
+				t = htmlSpacesRegexp.ReplaceAllString(t, " ")
+				if b.Len() == 0 || strings.HasSuffix(b.String(), "\n") {
+					t = strings.TrimLeft(t, " ")
+				}
+			}
+			b.WriteString(strings.ReplaceAll(t, "\u00a0", " "))
+		case htmlStartTagToken:
+			switch {
+			case droppedHTMLTags[tok.data]:
+				dropping++
+			case htmlParagraphTags[tok.data]:
+				b.WriteString("\n\n")
+			case tok.data == "br" || tok.data == "tr":
+				b.WriteString("\n")
+			case tok.data == "li":
+				b.WriteString("\n- ")
+			case tok.data == "td" || tok.data == "th":
+				b.WriteString(" ")
+			case tok.data == "a":
+				href = ""
+				for _, attr := range tok.attrs {
+					if attr[0] == "href" && strings.HasPrefix(attr[1], "http") {
+						href = attr[1]
+					}
+				}
+			}
+			if tok.data == "pre" {
+				pre++
+			}
+		case htmlEndTagToken:
+			switch {
+			case droppedHTMLTags[tok.data]:
+				if dropping > 0 {
+					dropping--
+				}
+			case htmlParagraphTags[tok.data]:
+				b.WriteString("\n\n")
+			case tok.data == "a" && href != "":
+				if !strings.HasSuffix(b.String(), href) {
+					b.WriteString(" (" + href + ")")
+				}
+				href = ""
+			}
+			if tok.data == "pre" && pre > 0 {
+				pre--
+			}
+		}
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	text := plainBlankLineRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(text)
+}
+
+var plainParagraphRegexp = regexp.MustCompile(`\n[ \t]*\n\s*`)
+
+// PlainTextToHTML converts plain text such as body of ticket comments to HTML
+// which can be sent as html_body. Paragraphs separated by blank lines become
+// <p> elements, and the other line breaks become <br>.
+func PlainTextToHTML(body string) string {
+	body = strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+	if body == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, paragraph := range plainParagraphRegexp.Split(body, -1) {
+		lines := strings.Split(paragraph, "\n")
+		for i := range lines {
+			lines[i] = html.EscapeString(lines[i])
+		}
+		b.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>")
+	}
+	return b.String()
+}
+
+// PlainText returns the comment as plain text. It is PlainBody if available,
+// otherwise HTMLBody converted with HTMLToPlainText, or Body.
+func (c TicketComment) PlainText() string {
+	switch {
+	case c.PlainBody != "":
+		return c.PlainBody
+	case c.HTMLBody != "":
+		return HTMLToPlainText(c.HTMLBody)
+	}
+	return c.Body
+}
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			`<p class="x" onclick="steal()">Hello <b>world</b></p>`,
+			`<p>Hello <b>world</b></p>`,
+		},
+		{
+			`<script>alert("x")</script><style>p{}</style>Text`,
+			`Text`,
+		},
+		{
+			`<a href="javascript:alert(1)">bad</a> <a href="https://example.com/?a=1&amp;b=2" target="_blank">good</a>`,
+			`<a>bad</a> <a href="https://example.com/?a=1&amp;b=2">good</a>`,
+		},
+		{
+			`<img src=x onerror=alert(1)><br/><iframe src="https://evil"></iframe>`,
+			`<img src="x"><br>`,
+		},
+		{
+			`<ul><li>one<li>two</ul><div><em>unclosed`,
+			`<ul><li>one</li><li>two</li></ul><div><em>unclosed</em></div>`,
+		},
+		{
+			`1 < 2 &amp; <!-- comment --><font color=red>3 > 2</font>`,
+			`1 &lt; 2 &amp; 3 &gt; 2`,
+		},
+		{
+			// Ⱥ becomes longer when lower cased
+			"<style>" + strings.Repeat("Ⱥ", 10) + "</STYLE><p>Ⱥ</p>",
+			`<p>Ⱥ</p>`,
+		},
+	}
+	for _, test := range tests {
+		if actual := SanitizeHTML(test.input); actual != test.expected {
+			t.Errorf("SanitizeHTML(%q) = %q, expected %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestHTMLToPlainText(t *testing.T) {
+	input := `<div class="zd-comment"><p>Hi  <b>John</b>,</p>
+<p>Please see the   <a href="https://example.com/guide">guide</a>.<br>Steps:</p>
+<ul><li>Restart</li><li>Retry&nbsp;later</li></ul>
+<pre>  indented
+code</pre><style>p { color: red }</style><p>Thanks &amp; regards</p></div>`
+	expected := "Hi John,\n\nPlease see the guide (https://example.com/guide).\nSteps:\n\n- Restart\n- Retry later\n\n  indented\ncode\n\nThanks & regards"
+
+	if actual := HTMLToPlainText(input); actual != expected {
+		t.Fatalf("unexpected plain text %q", actual)
+	}
+}
+
+func TestHTMLToPlainTextRawTextWithUnicode(t *testing.T) {
+	input := "<title>" + strings.Repeat("Ⱥ", 10) + "</title>Text"
+	if actual := HTMLToPlainText(input); actual != "Text" {
+		t.Fatalf("unexpected plain text %q", actual)
+	}
+}
+
+func TestPlainTextToHTML(t *testing.T) {
+	input := "Hi <John>,\r\n\r\nLine 1\nLine 2\n\n\nBye"
+	expected := "<p>Hi &lt;John&gt;,</p><p>Line 1<br>Line 2</p><p>Bye</p>"
+
+	if actual := PlainTextToHTML(input); actual != expected {
+		t.Fatalf("unexpected html %q", actual)
+	}
+	if actual := HTMLToPlainText(PlainTextToHTML(input)); actual != "Hi <John>,\n\nLine 1\nLine 2\n\nBye" {
+		t.Fatalf("unexpected round trip %q", actual)
+	}
+}
+
+func TestTicketCommentPlainText(t *testing.T) {
+	if text := (TicketComment{Body: "body", HTMLBody: "<p>html</p>", PlainBody: "plain"}).PlainText(); text != "plain" {
+		t.Fatalf("unexpected text %s", text)
+	}
+	if text := (TicketComment{Body: "body", HTMLBody: "<p>html</p>"}).PlainText(); text != "html" {
+		t.Fatalf("unexpected text %s", text)
+	}
+	if text := (TicketComment{Body: "body"}).PlainText(); text != "body" {
+		t.Fatalf("unexpected text %s", text)
+	}
+}
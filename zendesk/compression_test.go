@@ -0,0 +1,64 @@
+package zendesk
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newCompressedMockAPI(t *testing.T, encoding string, filename string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), encoding) {
+			t.Fatalf("Accept-Encoding does not include %s: %s", encoding, r.Header.Get("Accept-Encoding"))
+		}
+
+		var cw io.WriteCloser
+		switch encoding {
+		case "gzip":
+			cw = gzip.NewWriter(w)
+		case "deflate":
+			cw = zlib.NewWriter(w)
+		}
+
+		w.Header().Set("Content-Encoding", encoding)
+		cw.Write(readFixture(filename))
+		cw.Close()
+	}))
+}
+
+func TestCompressedResponse(t *testing.T) {
+	for _, encoding := range []string{"gzip", "deflate"} {
+		mockAPI := newCompressedMockAPI(t, encoding, "GET/ticket.json")
+		client := newTestClient(mockAPI)
+		client.SetCompression(true)
+
+		ticket, err := client.GetTicket(ctx, 2)
+		if err != nil {
+			t.Fatalf("Failed to get %s encoded ticket: %s", encoding, err)
+		}
+		if ticket.ID != 2 {
+			t.Fatalf("Returned ticket does not have the expected ID 2. Ticket id is %d", ticket.ID)
+		}
+		mockAPI.Close()
+	}
+}
+
+func TestCompressionDisabled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") == "gzip, deflate" {
+			t.Fatal("Accept-Encoding should not be set by client")
+		}
+		w.Write(readFixture("GET/ticket.json"))
+	}))
+	client := newTestClient(mockAPI)
+	client.SetCompression(false)
+	defer mockAPI.Close()
+
+	if _, err := client.GetTicket(ctx, 2); err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+}
@@ -0,0 +1,163 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ContentTag is a tag attached to Help Center articles and community posts
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/
+type ContentTag struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name"`
+	CreatedAt *Time  `json:"created_at,omitempty"`
+	UpdatedAt *Time  `json:"updated_at,omitempty"`
+}
+
+// ContentTagListOptions is options for GetContentTags
+type ContentTagListOptions struct {
+	CursorPagination
+
+	// NamePrefix filters the content tags whose names start with the prefix
+	NamePrefix string         `url:"filter[name_prefix],omitempty"`
+	Sort       ContentTagSort `url:"sort,omitempty"`
+}
+
+// ContentTagSort is the order of content tags
+type ContentTagSort string
+
+const (
+	// ContentTagSortByName sorts content tags by name in ascending order
+	ContentTagSortByName ContentTagSort = "name"
+	// ContentTagSortByNameDesc sorts content tags by name in descending order
+	ContentTagSortByNameDesc ContentTagSort = "-name"
+	// ContentTagSortByCreatedAt sorts content tags by creation time in ascending order
+	ContentTagSortByCreatedAt ContentTagSort = "created_at"
+	// ContentTagSortByCreatedAtDesc sorts content tags by creation time in descending order
+	ContentTagSortByCreatedAtDesc ContentTagSort = "-created_at"
+)
+
+// Validate checks the sort
+func (o ContentTagListOptions) Validate() error {
+	return validateSort("sort", o.Sort,
+		ContentTagSortByName, ContentTagSortByNameDesc, ContentTagSortByCreatedAt, ContentTagSortByCreatedAtDesc)
+}
+
+// ContentTagAPI an interface containing all content tag related methods
+type ContentTagAPI interface {
+	GetContentTags(ctx context.Context, opts *ContentTagListOptions) ([]ContentTag, CursorPaginationMeta, error)
+	GetContentTag(ctx context.Context, tagID string) (ContentTag, error)
+	CreateContentTag(ctx context.Context, tag ContentTag) (ContentTag, error)
+	UpdateContentTag(ctx context.Context, tagID string, tag ContentTag) (ContentTag, error)
+	DeleteContentTag(ctx context.Context, tagID string) error
+}
+
+// GetContentTags fetches the content tags
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/#list-content-tags
+func (z *Client) GetContentTags(ctx context.Context, opts *ContentTagListOptions) ([]ContentTag, CursorPaginationMeta, error) {
+	var result struct {
+		Records []ContentTag         `json:"records"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ContentTagListOptions{}
+	}
+
+	u, err := addOptions("/guide/content_tags", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Records, result.Meta, nil
+}
+
+// GetContentTagsPage fetches the page of content tags at token as ListPage.
+// The zero PageToken is the first page, and the cursor options of opts are
+// replaced with token.
+func (z *Client) GetContentTagsPage(ctx context.Context, token PageToken, opts *ContentTagListOptions) (ListPage[ContentTag], error) {
+	tmp := ContentTagListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return CursorLister(func(ctx context.Context, opts CursorPagination) ([]ContentTag, CursorPaginationMeta, error) {
+		tmp.CursorPagination = opts
+		return z.GetContentTags(ctx, &tmp)
+	})(ctx, token)
+}
+
+// GetContentTag fetches the content tag
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/#show-content-tag
+func (z *Client) GetContentTag(ctx context.Context, tagID string) (ContentTag, error) {
+	var result struct {
+		ContentTag ContentTag `json:"content_tag"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/content_tags/%s", tagID))
+	if err != nil {
+		return ContentTag{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ContentTag{}, err
+	}
+	return result.ContentTag, nil
+}
+
+// CreateContentTag creates a new content tag
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/#create-content-tag
+func (z *Client) CreateContentTag(ctx context.Context, tag ContentTag) (ContentTag, error) {
+	var data, result struct {
+		ContentTag ContentTag `json:"content_tag"`
+	}
+	data.ContentTag = tag
+
+	body, err := z.post(ctx, "/guide/content_tags", data)
+	if err != nil {
+		return ContentTag{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ContentTag{}, err
+	}
+	return result.ContentTag, nil
+}
+
+// UpdateContentTag renames the content tag
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/#update-content-tag
+func (z *Client) UpdateContentTag(ctx context.Context, tagID string, tag ContentTag) (ContentTag, error) {
+	var data, result struct {
+		ContentTag ContentTag `json:"content_tag"`
+	}
+	data.ContentTag = tag
+
+	body, err := z.put(ctx, fmt.Sprintf("/guide/content_tags/%s", tagID), data)
+	if err != nil {
+		return ContentTag{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ContentTag{}, err
+	}
+	return result.ContentTag, nil
+}
+
+// DeleteContentTag deletes the content tag and detaches it from articles and posts
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/content_tags/#delete-content-tag
+func (z *Client) DeleteContentTag(ctx context.Context, tagID string) error {
+	return z.delete(ctx, fmt.Sprintf("/guide/content_tags/%s", tagID))
+}
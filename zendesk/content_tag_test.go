@@ -0,0 +1,77 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetContentTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("filter[name_prefix]") != "bill" || q.Get("page[size]") != "2" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/content_tags.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tags, meta, err := client.GetContentTags(ctx, &ContentTagListOptions{
+		CursorPagination: CursorPagination{PageSize: 2},
+		NamePrefix:       "bill",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get content tags: %s", err)
+	}
+	if len(tags) != 2 || tags[1].Name != "billing-faq" {
+		t.Fatalf("unexpected content tags %v", tags)
+	}
+	if !meta.HasMore {
+		t.Fatalf("expected more content tags")
+	}
+}
+
+func TestCreateContentTag(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "content_tag.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tag, err := client.CreateContentTag(ctx, ContentTag{Name: "billing"})
+	if err != nil {
+		t.Fatalf("Failed to create content tag: %s", err)
+	}
+	if tag.ID != "01GFXGBX7YZ9ASWTCVMASTK8ZS" {
+		t.Fatalf("unexpected content tag %v", tag)
+	}
+}
+
+func TestUpdateContentTag(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "content_tag.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tag, err := client.UpdateContentTag(ctx, "01GFXGBX7YZ9ASWTCVMASTK8ZS", ContentTag{Name: "payments"})
+	if err != nil {
+		t.Fatalf("Failed to update content tag: %s", err)
+	}
+	if tag.Name != "payments" {
+		t.Fatalf("unexpected content tag %v", tag)
+	}
+}
+
+func TestDeleteContentTag(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/guide/content_tags/01GFXGBX7YZ9ASWTCVMASTK8ZS" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteContentTag(ctx, "01GFXGBX7YZ9ASWTCVMASTK8ZS")
+	if err != nil {
+		t.Fatalf("Failed to delete content tag: %s", err)
+	}
+}
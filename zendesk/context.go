@@ -0,0 +1,48 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	ifMatchKey       struct{}
+	requestHeaderKey struct{}
+)
+
+// WithIfMatch returns a copy of ctx which makes the client send If-Match header
+// with the given entity tag. Use ETag of ResponseMeta captured on the preceding
+// read so that the update fails with ErrConflict when someone else has modified
+// the resource in the meantime.
+//
+//	var meta zendesk.ResponseMeta
+//	ticket, _ := client.GetTicket(zendesk.WithResponseMeta(ctx, &meta), id)
+//	_, err := client.UpdateTicket(zendesk.WithIfMatch(ctx, meta.ETag()), id, update)
+//	if errors.Is(err, zendesk.ErrConflict) {
+//		// reload and retry
+//	}
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+// withRequestHeader returns a copy of ctx which makes the client send the headers
+func withRequestHeader(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, requestHeaderKey{}, header)
+}
+
+// includeContextHeaders set HTTP headers registered in ctx to *http.Request.
+// They replace the headers of the same names set by the client.
+func includeContextHeaders(ctx context.Context, req *http.Request) {
+	if etag, ok := ctx.Value(ifMatchKey{}).(string); ok && etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+
+	if header, ok := ctx.Value(requestHeaderKey{}).(http.Header); ok {
+		for key, values := range header {
+			req.Header.Del(key)
+			for _, v := range values {
+				req.Header.Add(key, v)
+			}
+		}
+	}
+}
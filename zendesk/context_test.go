@@ -0,0 +1,44 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithIfMatch(t *testing.T) {
+	etag := `W/"5c3e4f2a"`
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("If-Match"); v != etag {
+			t.Fatalf("expected If-Match header %s, but got %s", etag, v)
+		}
+		w.WriteHeader(http.StatusPreconditionFailed)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(WithIfMatch(ctx, etag), 2, Ticket{})
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict, but got %v", err)
+	}
+
+	if _, ok := err.(Error); !ok {
+		t.Fatalf("Did not return a zendesk error %s", err)
+	}
+}
+
+func TestWithoutIfMatch(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.Header["If-Match"]; ok {
+			t.Fatal("If-Match header should not be sent")
+		}
+		w.Write(readFixture("PUT/groups.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateGroup(ctx, 1, Group{}); err != nil {
+		t.Fatalf("Failed to update group: %s", err)
+	}
+}
@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CountResource is the collection which GetResourceCount counts
+type CountResource string
+
+const (
+	// CountTickets counts the tickets
+	CountTickets CountResource = "tickets"
+	// CountUsers counts the users
+	CountUsers CountResource = "users"
+	// CountOrganizations counts the organizations
+	CountOrganizations CountResource = "organizations"
+	// CountTriggers counts the triggers
+	CountTriggers CountResource = "triggers"
+	// CountAutomations counts the automations
+	CountAutomations CountResource = "automations"
+	// CountViews counts the views
+	CountViews CountResource = "views"
+	// CountMacros counts the macros
+	CountMacros CountResource = "macros"
+)
+
+// ResourceCount is the number of records of a collection.
+// Zendesk counts small collections on every request, but caches the counts of
+// large collections and refreshes them in the background, so Value may be
+// outdated by the time since RefreshedAt.
+type ResourceCount struct {
+	Value       int64 `json:"value"`
+	RefreshedAt *Time `json:"refreshed_at"`
+}
+
+// Age returns how long ago the count was refreshed.
+// It returns 0 if RefreshedAt is unknown.
+func (c ResourceCount) Age(now time.Time) time.Duration {
+	if c.RefreshedAt == nil {
+		return 0
+	}
+	return now.Sub(c.RefreshedAt.Time)
+}
+
+// CountAPI an interface containing the methods counting collections
+type CountAPI interface {
+	GetResourceCount(ctx context.Context, resource CountResource) (ResourceCount, error)
+}
+
+// GetResourceCount counts the records of the collection without listing them
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#count-tickets
+func (z *Client) GetResourceCount(ctx context.Context, resource CountResource) (ResourceCount, error) {
+	var result struct {
+		Count ResourceCount `json:"count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/%s/count.json", resource))
+	if err != nil {
+		return ResourceCount{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ResourceCount{}, err
+	}
+	return result.Count, nil
+}
@@ -0,0 +1,31 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetResourceCount(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/count.json" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "count.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetResourceCount(ctx, CountMacros)
+	if err != nil {
+		t.Fatalf("Failed to get count: %s", err)
+	}
+	if count.Value != 102 {
+		t.Fatalf("unexpected count %v", count)
+	}
+	if age := count.Age(time.Date(2020, 4, 6, 2, 20, 17, 0, time.UTC)); age != 2*time.Minute {
+		t.Fatalf("unexpected age %s", age)
+	}
+}
@@ -0,0 +1,169 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// CustomFieldValue returns the raw value of the ticket custom field
+func (t Ticket) CustomFieldValue(id int64) (interface{}, bool) {
+	for _, cf := range t.CustomFields {
+		if cf.ID == id {
+			return cf.Value, cf.Value != nil
+		}
+	}
+	return nil, false
+}
+
+// CustomFieldString returns the value of text, dropdown or date custom field
+func (t Ticket) CustomFieldString(id int64) (string, bool) {
+	v, _ := t.CustomFieldValue(id)
+	return customFieldString(v)
+}
+
+// CustomFieldBool returns the value of checkbox custom field
+func (t Ticket) CustomFieldBool(id int64) (bool, bool) {
+	v, _ := t.CustomFieldValue(id)
+	return customFieldBool(v)
+}
+
+// CustomFieldMulti returns the values of multi-select custom field
+func (t Ticket) CustomFieldMulti(id int64) ([]string, bool) {
+	v, _ := t.CustomFieldValue(id)
+	return customFieldMulti(v)
+}
+
+// CustomFieldInt returns the value of numeric or lookup relationship custom field
+func (t Ticket) CustomFieldInt(id int64) (int64, bool) {
+	v, _ := t.CustomFieldValue(id)
+	return customFieldInt(v)
+}
+
+// SetCustomField sets the value of the ticket custom field, replacing the current one.
+// value should be string for text, dropdown and date fields, bool for checkbox,
+// []string for multi-select and int64 for lookup relationship fields.
+// nil clears the field.
+func (t *Ticket) SetCustomField(id int64, value interface{}) {
+	value = normalizeCustomFieldValue(value)
+	for i := range t.CustomFields {
+		if t.CustomFields[i].ID == id {
+			t.CustomFields[i].Value = value
+			return
+		}
+	}
+	t.CustomFields = append(t.CustomFields, CustomField{ID: id, Value: value})
+}
+
+// String returns the value of text, dropdown or date user field
+func (f UserFields) String(key string) (string, bool) {
+	return customFieldString(f[key])
+}
+
+// Bool returns the value of checkbox user field
+func (f UserFields) Bool(key string) (bool, bool) {
+	return customFieldBool(f[key])
+}
+
+// Multi returns the values of multi-select user field
+func (f UserFields) Multi(key string) ([]string, bool) {
+	return customFieldMulti(f[key])
+}
+
+// Int returns the value of numeric or lookup relationship user field
+func (f UserFields) Int(key string) (int64, bool) {
+	return customFieldInt(f[key])
+}
+
+// Set sets the value of user field. See Ticket.SetCustomField for the types of value.
+func (f UserFields) Set(key string, value interface{}) {
+	f[key] = normalizeCustomFieldValue(value)
+}
+
+// OrganizationFields is a dictionary of custom organization related fields
+type OrganizationFields map[string]interface{}
+
+// String returns the value of text, dropdown or date organization field
+func (f OrganizationFields) String(key string) (string, bool) {
+	return customFieldString(f[key])
+}
+
+// Bool returns the value of checkbox organization field
+func (f OrganizationFields) Bool(key string) (bool, bool) {
+	return customFieldBool(f[key])
+}
+
+// Multi returns the values of multi-select organization field
+func (f OrganizationFields) Multi(key string) ([]string, bool) {
+	return customFieldMulti(f[key])
+}
+
+// Int returns the value of numeric or lookup relationship organization field
+func (f OrganizationFields) Int(key string) (int64, bool) {
+	return customFieldInt(f[key])
+}
+
+// Set sets the value of organization field. See Ticket.SetCustomField for the types of value.
+func (f OrganizationFields) Set(key string, value interface{}) {
+	f[key] = normalizeCustomFieldValue(value)
+}
+
+func customFieldString(v interface{}) (string, bool) {
+	s, ok := v.(string)
+	return s, ok
+}
+
+func customFieldBool(v interface{}) (bool, bool) {
+	b, ok := v.(bool)
+	return b, ok
+}
+
+func customFieldMulti(v interface{}) ([]string, bool) {
+	switch v := v.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// customFieldInt converts numeric value which is returned as number or string
+func customFieldInt(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// normalizeCustomFieldValue makes value marshal as Zendesk accepts,
+// e.g. empty multi-select is [] instead of null.
+func normalizeCustomFieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []string:
+		if v == nil {
+			return []string{}
+		}
+	case int:
+		return int64(v)
+	}
+	return value
+}
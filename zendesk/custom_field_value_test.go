@@ -0,0 +1,95 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTicketCustomFieldAccessors(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_custom_field.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.GetTicket(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if s, ok := ticket.CustomFieldString(360005657120); !ok || s != "Custom field value for testing" {
+		t.Fatalf("unexpected string value %q", s)
+	}
+	if values, ok := ticket.CustomFieldMulti(360005657121); !ok || !reflect.DeepEqual(values, []string{"list", "of", "values"}) {
+		t.Fatalf("unexpected multi-select value %v", values)
+	}
+	if _, ok := ticket.CustomFieldString(360005657122); ok {
+		t.Fatal("null value should not be returned")
+	}
+	if b, ok := ticket.CustomFieldBool(360005657123); !ok || !b {
+		t.Fatal("checkbox value should be true")
+	}
+	if i, ok := ticket.CustomFieldInt(360005657124); !ok || i != 9007199254740993 {
+		t.Fatalf("unexpected lookup value %d", i)
+	}
+	if _, ok := ticket.CustomFieldInt(360005657125); ok {
+		t.Fatal("decimal value should not be returned as int")
+	}
+	if _, ok := ticket.CustomFieldBool(360005657120); ok {
+		t.Fatal("string value should not be returned as bool")
+	}
+}
+
+func TestTicketSetCustomField(t *testing.T) {
+	var ticket Ticket
+	ticket.SetCustomField(1, true)
+	ticket.SetCustomField(2, []string(nil))
+	ticket.SetCustomField(3, 42)
+	ticket.SetCustomField(1, false)
+
+	b, err := json.Marshal(ticket)
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+
+	expected := `{"custom_fields":[{"id":1,"value":false},{"id":2,"value":[]},{"id":3,"value":42}]}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, but got %s", expected, b)
+	}
+
+	if i, ok := ticket.CustomFieldInt(3); !ok || i != 42 {
+		t.Fatalf("unexpected int value %d", i)
+	}
+}
+
+func TestUserFieldsAccessors(t *testing.T) {
+	var user User
+	if err := json.Unmarshal([]byte(`{"user_fields":{"vip":true,"plan":"pro","seats":"12","account":123,"regions":["eu","us"]}}`), &user); err != nil {
+		t.Fatalf("Failed to unmarshal user: %s", err)
+	}
+
+	if b, ok := user.UserFields.Bool("vip"); !ok || !b {
+		t.Fatal("vip should be true")
+	}
+	if s, ok := user.UserFields.String("plan"); !ok || s != "pro" {
+		t.Fatalf("unexpected plan %q", s)
+	}
+	if i, ok := user.UserFields.Int("seats"); !ok || i != 12 {
+		t.Fatalf("unexpected seats %d", i)
+	}
+	if i, ok := user.UserFields.Int("account"); !ok || i != 123 {
+		t.Fatalf("unexpected account %d", i)
+	}
+	if values, ok := user.UserFields.Multi("regions"); !ok || len(values) != 2 {
+		t.Fatalf("unexpected regions %v", values)
+	}
+}
+
+func TestOrganizationFieldsSet(t *testing.T) {
+	org := Organization{OrganizationFields: OrganizationFields{}}
+	org.OrganizationFields.Set("tier", "gold")
+
+	if s, ok := org.OrganizationFields.String("tier"); !ok || s != "gold" {
+		t.Fatalf("unexpected tier %q", s)
+	}
+}
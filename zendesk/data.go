@@ -0,0 +1,62 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GetData sends GET request to the endpoint not yet implemented with Get and
+// decodes the response. If key is not empty, the value of the key in the
+// response object is decoded, e.g. "ticket" for {"ticket": {...}}.
+//
+//	fields, err := zendesk.GetData[[]zendesk.TicketField](ctx, client, "/ticket_fields.json", "ticket_fields")
+func GetData[T any](ctx context.Context, api BaseAPI, path string, key string) (T, error) {
+	body, err := api.Get(ctx, path)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decodeData[T](body, key)
+}
+
+// PostData sends POST request with Post and decodes the response as GetData does
+func PostData[T any](ctx context.Context, api BaseAPI, path string, data interface{}, key string) (T, error) {
+	body, err := api.Post(ctx, path, data)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decodeData[T](body, key)
+}
+
+// PutData sends PUT request with Put and decodes the response as GetData does
+func PutData[T any](ctx context.Context, api BaseAPI, path string, data interface{}, key string) (T, error) {
+	body, err := api.Put(ctx, path, data)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decodeData[T](body, key)
+}
+
+// decodeData decodes body, or the value of key in body if key is not empty
+func decodeData[T any](body []byte, key string) (T, error) {
+	var result T
+	if key == "" {
+		err := json.Unmarshal(body, &result)
+		return result, err
+	}
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return result, err
+	}
+
+	raw, ok := envelope[key]
+	if !ok {
+		return result, fmt.Errorf("response does not have %q", key)
+	}
+	err := json.Unmarshal(raw, &result)
+	return result, err
+}
@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetData(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := GetData[Ticket](ctx, client, "/tickets/2.json", "ticket")
+	if err != nil {
+		t.Fatalf("Failed to get data: %s", err)
+	}
+	if ticket.ID != 2 {
+		t.Fatalf("unexpected ticket %v", ticket)
+	}
+
+	raw, err := GetData[map[string]interface{}](ctx, client, "/tickets/2.json", "")
+	if err != nil {
+		t.Fatalf("Failed to get data: %s", err)
+	}
+	if _, ok := raw["ticket"]; !ok {
+		t.Fatalf("unexpected body %v", raw)
+	}
+
+	if _, err := GetData[Ticket](ctx, client, "/tickets/2.json", "user"); err == nil {
+		t.Fatal("GetData did not return error for missing key")
+	}
+}
+
+func TestPostData(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "groups.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	group, err := PostData[Group](ctx, client, "/groups.json", map[string]Group{"group": {Name: "Support"}}, "group")
+	if err != nil {
+		t.Fatalf("Failed to post data: %s", err)
+	}
+	if group.ID == 0 {
+		t.Fatalf("unexpected group %v", group)
+	}
+}
+
+func TestPutData(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := PutData[Ticket](ctx, client, "/tickets/2.json", map[string]Ticket{"ticket": {Subject: "x"}}, "ticket")
+	if err != nil {
+		t.Fatalf("Failed to put data: %s", err)
+	}
+	if ticket.ID == 0 {
+		t.Fatalf("unexpected ticket %v", ticket)
+	}
+}
@@ -0,0 +1,79 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("mutating request was sent: %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture("GET/group.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var logged int
+	log := &DryRunLog{OnRequest: func(DryRunRequest) { logged++ }}
+	client.SetDryRun(log)
+
+	if _, err := client.GetGroup(ctx, 360002440594); err != nil {
+		t.Fatalf("Failed to get group: %s", err)
+	}
+
+	group, err := client.CreateGroup(ctx, Group{Name: "Support"})
+	if err != nil {
+		t.Fatalf("Failed to create group in dry-run mode: %s", err)
+	}
+	if group.Name != "Support" || group.ID != 0 {
+		t.Fatalf("unexpected group %v", group)
+	}
+	if err := client.DeleteGroup(ctx, 1); err != nil {
+		t.Fatalf("Failed to delete group in dry-run mode: %s", err)
+	}
+
+	requests := log.Requests()
+	if len(requests) != 2 || logged != 2 {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if requests[0].Method != http.MethodPost || requests[0].Path != "/groups.json" ||
+		!strings.HasPrefix(string(requests[0].Body), `{"group":{"name":"Support",`) {
+		t.Fatalf("unexpected request %v", requests[0])
+	}
+	if requests[1].Method != http.MethodDelete || requests[1].Path != "/groups/1.json" || requests[1].Body != nil {
+		t.Fatalf("unexpected request %v", requests[1])
+	}
+}
+
+func TestDryRunUpload(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	log := &DryRunLog{}
+	client.SetDryRun(log)
+
+	w := client.UploadAttachment(ctx, "crash log.txt", "")
+	if _, err := w.Write([]byte("crash")); err != nil {
+		t.Fatalf("Failed to write upload in dry-run mode: %s", err)
+	}
+	upload, err := w.Close()
+	if err != nil {
+		t.Fatalf("Failed to upload in dry-run mode: %s", err)
+	}
+	if upload.Token == "" || upload.Attachment.FileName != "crash log.txt" || upload.Attachment.Size != 5 {
+		t.Fatalf("unexpected upload %v", upload)
+	}
+
+	requests := log.Requests()
+	if len(requests) != 1 || requests[0].Method != http.MethodPost ||
+		requests[0].Path != "/uploads.json?filename=crash+log.txt" || requests[0].Body != nil {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+}
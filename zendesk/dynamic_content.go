@@ -50,12 +50,22 @@ type DynamicContentVariant struct {
 //
 // https://developer.zendesk.com/rest_api/docs/support/dynamic_content#list-items
 func (z *Client) GetDynamicContentItems(ctx context.Context) ([]DynamicContentItem, Page, error) {
+	return z.getDynamicContentItems(ctx, PageOptions{})
+}
+
+// getDynamicContentItems fetches the page of dynamic content items of opts
+func (z *Client) getDynamicContentItems(ctx context.Context, opts PageOptions) ([]DynamicContentItem, Page, error) {
 	var data struct {
 		Items []DynamicContentItem `json:"items"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/dynamic_content/items.json")
+	u, err := addOptions("/dynamic_content/items.json", opts)
+	if err != nil {
+		return []DynamicContentItem{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
 	if err != nil {
 		return []DynamicContentItem{}, Page{}, err
 	}
@@ -67,6 +77,12 @@ func (z *Client) GetDynamicContentItems(ctx context.Context) ([]DynamicContentIt
 	return data.Items, data.Page, nil
 }
 
+// GetDynamicContentItemsPage fetches the page of dynamic content items at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetDynamicContentItemsPage(ctx context.Context, token PageToken) (ListPage[DynamicContentItem], error) {
+	return OffsetLister(z.getDynamicContentItems)(ctx, token)
+}
+
 // CreateDynamicContentItem creates new dynamic content item
 //
 // https://developer.zendesk.com/rest_api/docs/support/dynamic_content#create-item
@@ -0,0 +1,141 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/mail"
+	"strings"
+)
+
+// emailThreadMetadataKey is the key of the custom audit metadata holding EmailThreadHeaders
+const emailThreadMetadataKey = "email_thread"
+
+// EmailThreadHeaders are the headers threading emails, which email bridges
+// keep on tickets so that replies are added to the ticket of the thread.
+// Message IDs are stored without angle brackets.
+type EmailThreadHeaders struct {
+	MessageID  string   `json:"message_id,omitempty"`
+	InReplyTo  string   `json:"in_reply_to,omitempty"`
+	References []string `json:"references,omitempty"`
+}
+
+// EmailThreadHeadersFromMail reads Message-ID, In-Reply-To and References from the email header
+func EmailThreadHeadersFromMail(h mail.Header) EmailThreadHeaders {
+	var headers EmailThreadHeaders
+	if ids := parseMessageIDs(h.Get("Message-ID")); len(ids) > 0 {
+		headers.MessageID = ids[0]
+	}
+	if ids := parseMessageIDs(h.Get("In-Reply-To")); len(ids) > 0 {
+		headers.InReplyTo = ids[0]
+	}
+	headers.References = parseMessageIDs(h.Get("References"))
+	return headers
+}
+
+// parseMessageIDs parses the list of message IDs like "<a@example.com> <b@example.com>".
+// Bare IDs without angle brackets are accepted as well.
+func parseMessageIDs(s string) []string {
+	var ids []string
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == ','
+	}) {
+		if id := normalizeMessageID(f); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func normalizeMessageID(id string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(id), "<"), ">")
+}
+
+// Related returns the message IDs which the email replies to, the most recent first.
+// The ticket of the thread is the one created from any of them.
+func (h EmailThreadHeaders) Related() []string {
+	var ids []string
+	seen := map[string]bool{h.MessageID: true, "": true}
+	add := func(id string) {
+		id = normalizeMessageID(id)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	add(h.InReplyTo)
+	for i := len(h.References) - 1; i >= 0; i-- {
+		add(h.References[i])
+	}
+	return ids
+}
+
+// SetEmailThreadHeaders records the headers on the ticket to create or update.
+// They are saved as the custom metadata of the audit, and MessageID is also
+// set to ExternalID unless it is set, so that FindTicketByEmailThread finds
+// the ticket from replies. Note that the metadata is not saved if the update
+// doesn't change the ticket.
+func (t *Ticket) SetEmailThreadHeaders(h EmailThreadHeaders) {
+	h.MessageID = normalizeMessageID(h.MessageID)
+	h.InReplyTo = normalizeMessageID(h.InReplyTo)
+
+	if t.ExternalID == "" {
+		t.ExternalID = h.MessageID
+	}
+	t.SetAuditMetadata(emailThreadMetadataKey, h)
+}
+
+// EmailThreadHeaders returns the headers recorded by SetEmailThreadHeaders,
+// or the Message-ID of the email which Zendesk received for the audit.
+// It returns false if the audit has neither.
+func (a TicketAudit) EmailThreadHeaders() (EmailThreadHeaders, bool) {
+	var h EmailThreadHeaders
+	if ok, err := a.CustomMetadata(emailThreadMetadataKey, &h); ok && err == nil {
+		return h, true
+	}
+
+	var metadata struct {
+		System struct {
+			MessageID string `json:"message_id"`
+		} `json:"system"`
+	}
+	if a.Metadata == nil {
+		return EmailThreadHeaders{}, false
+	}
+	b, err := json.Marshal(a.Metadata)
+	if err != nil || json.Unmarshal(b, &metadata) != nil {
+		return EmailThreadHeaders{}, false
+	}
+	if id := normalizeMessageID(metadata.System.MessageID); id != "" {
+		return EmailThreadHeaders{MessageID: id}, true
+	}
+	return EmailThreadHeaders{}, false
+}
+
+// EmailThreadAPI an interface containing the methods threading emails on tickets
+type EmailThreadAPI interface {
+	FindTicketByEmailThread(ctx context.Context, h EmailThreadHeaders) (*Ticket, error)
+}
+
+// FindTicketByEmailThread finds the ticket which the email replies to by the
+// external IDs set by SetEmailThreadHeaders. It returns nil if none is found.
+func (z *Client) FindTicketByEmailThread(ctx context.Context, h EmailThreadHeaders) (*Ticket, error) {
+	ids := h.Related()
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	tickets, err := z.GetTicketsByExternalIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		for i := range tickets {
+			if tickets[i].ExternalID == id {
+				return &tickets[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
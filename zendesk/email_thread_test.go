@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"reflect"
+	"testing"
+)
+
+func TestEmailThreadHeadersFromMail(t *testing.T) {
+	h := EmailThreadHeadersFromMail(mail.Header{
+		"Message-Id":  {"<c@example.com>"},
+		"In-Reply-To": {"<b@example.com>"},
+		"References":  {"<a@example.com>\r\n <b@example.com>"},
+	})
+	if h.MessageID != "c@example.com" || h.InReplyTo != "b@example.com" {
+		t.Fatalf("unexpected headers %v", h)
+	}
+	if related := h.Related(); !reflect.DeepEqual(related, []string{"b@example.com", "a@example.com"}) {
+		t.Fatalf("unexpected related IDs %v", related)
+	}
+}
+
+func TestSetEmailThreadHeaders(t *testing.T) {
+	var ticket Ticket
+	ticket.SetEmailThreadHeaders(EmailThreadHeaders{MessageID: "<a@example.com>"})
+	if ticket.ExternalID != "a@example.com" {
+		t.Fatalf("unexpected external ID %s", ticket.ExternalID)
+	}
+
+	audit := TicketAudit{Metadata: map[string]interface{}{"custom": ticket.Metadata}}
+	h, ok := audit.EmailThreadHeaders()
+	if !ok || h.MessageID != "a@example.com" {
+		t.Fatalf("unexpected headers %v", h)
+	}
+
+	audit = TicketAudit{Metadata: map[string]interface{}{"system": map[string]interface{}{"message_id": "<z@example.com>"}}}
+	if h, ok := audit.EmailThreadHeaders(); !ok || h.MessageID != "z@example.com" {
+		t.Fatalf("unexpected headers %v", h)
+	}
+	if _, ok := (TicketAudit{}).EmailThreadHeaders(); ok {
+		t.Fatal("expected no headers")
+	}
+}
+
+func TestFindTicketByEmailThread(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("external_ids"); ids != "b@example.com,a@example.com" {
+			t.Fatalf("unexpected external IDs %s", ids)
+		}
+		w.Write([]byte(`{"tickets": [{"id": 1, "external_id": "a@example.com"}, {"id": 2, "external_id": "b@example.com"}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.FindTicketByEmailThread(ctx, EmailThreadHeaders{
+		MessageID:  "c@example.com",
+		InReplyTo:  "b@example.com",
+		References: []string{"a@example.com", "b@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to find ticket: %s", err)
+	}
+	if ticket == nil || ticket.ID != 2 {
+		t.Fatalf("expected ticket 2, got %v", ticket)
+	}
+
+	ticket, err = client.FindTicketByEmailThread(ctx, EmailThreadHeaders{MessageID: "c@example.com"})
+	if err != nil || ticket != nil {
+		t.Fatalf("expected no ticket without references, got %v %v", ticket, err)
+	}
+}
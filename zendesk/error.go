@@ -2,11 +2,28 @@ package zendesk
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
+// ErrConflict is matched by errors.Is when Zendesk rejects a request because
+// the resource was modified concurrently (409 Conflict or 412 Precondition Failed).
+var ErrConflict = errors.New("zendesk: resource was modified concurrently")
+
+// ErrOffsetPaginationLimit is matched by errors.Is when Zendesk rejects an offset
+// paginated request because it is beyond the maximum page allowed. The listing
+// must be switched to cursor pagination.
+//
+// ref: https://developer.zendesk.com/api-reference/introduction/pagination/
+var ErrOffsetPaginationLimit = errors.New("zendesk: offset pagination limit exceeded, use cursor pagination")
+
+// ErrReadOnly is returned without sending the request when a client in
+// read-only mode is asked to send POST, PUT or DELETE requests.
+var ErrReadOnly = errors.New("zendesk: client is read-only")
+
 // Error an error type containing the http response from zendesk
 type Error struct {
 	body []byte
@@ -48,6 +65,34 @@ func (e Error) Status() int {
 	return e.resp.StatusCode
 }
 
+// Is reports whether the error matches target. It makes Error comparable
+// with sentinel errors of this package by errors.Is.
+func (e Error) Is(target error) bool {
+	if e.resp == nil {
+		return false
+	}
+
+	switch target {
+	case ErrConflict:
+		return e.resp.StatusCode == http.StatusConflict || e.resp.StatusCode == http.StatusPreconditionFailed
+	case ErrOffsetPaginationLimit:
+		return e.isOffsetPaginationLimit()
+	}
+	return false
+}
+
+// isOffsetPaginationLimit checks if the error is caused by deep offset pagination.
+// Zendesk responds 400 with a message that advises to use cursor pagination.
+func (e Error) isOffsetPaginationLimit() bool {
+	if e.resp.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	msg := strings.ToLower(string(e.body))
+	return strings.Contains(msg, "pagination") &&
+		(strings.Contains(msg, "cursor") || strings.Contains(msg, "offset"))
+}
+
 // OptionsError is an error type for invalid option argument.
 type OptionsError struct {
 	opts interface{}
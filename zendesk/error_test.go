@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -73,3 +74,39 @@ func TestError_Status(t *testing.T) {
 		t.Fatal("Status returned from error was not the correct status code")
 	}
 }
+
+func TestError_IsConflict(t *testing.T) {
+	for _, status := range []int{http.StatusConflict, http.StatusPreconditionFailed} {
+		err := Error{
+			resp: &http.Response{StatusCode: status},
+		}
+		if !errors.Is(err, ErrConflict) {
+			t.Fatalf("error with status %d should match ErrConflict", status)
+		}
+	}
+
+	err := Error{
+		resp: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+	}
+	if errors.Is(err, ErrConflict) {
+		t.Fatal("error with status 422 should not match ErrConflict")
+	}
+}
+
+func TestError_IsOffsetPaginationLimit(t *testing.T) {
+	err := Error{
+		body: []byte(`{"error":"invalid_pagination_request","description":"Offset pagination is limited to 100 pages. Use cursor pagination instead."}`),
+		resp: &http.Response{StatusCode: http.StatusBadRequest},
+	}
+	if !errors.Is(err, ErrOffsetPaginationLimit) {
+		t.Fatal("error should match ErrOffsetPaginationLimit")
+	}
+
+	err = Error{
+		body: []byte(`{"error":"RecordInvalid"}`),
+		resp: &http.Response{StatusCode: http.StatusBadRequest},
+	}
+	if errors.Is(err, ErrOffsetPaginationLimit) {
+		t.Fatal("error should not match ErrOffsetPaginationLimit")
+	}
+}
@@ -0,0 +1,66 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// FacebookPage is a Facebook page configured as a channel of the account
+type FacebookPage struct {
+	ID       int64  `json:"id"`
+	PageID   string `json:"page_id"`
+	Name     string `json:"name"`
+	Link     string `json:"link,omitempty"`
+	BrandID  int64  `json:"brand_id,omitempty"`
+	State    string `json:"state,omitempty"`
+	Category string `json:"category,omitempty"`
+	// WallPostsEnabled makes posts to the page wall create tickets
+	WallPostsEnabled bool `json:"wall_posts_enabled"`
+	// PrivateMessagesEnabled makes private messages to the page create tickets
+	PrivateMessagesEnabled bool  `json:"private_messages_enabled"`
+	CreatedAt              *Time `json:"created_at,omitempty"`
+	UpdatedAt              *Time `json:"updated_at,omitempty"`
+}
+
+// FacebookChannelAPI an interface containing all Facebook channel related methods
+type FacebookChannelAPI interface {
+	GetFacebookPages(ctx context.Context) ([]FacebookPage, error)
+	GetFacebookPage(ctx context.Context, pageID int64) (FacebookPage, error)
+}
+
+// GetFacebookPages fetches the Facebook pages configured as channels
+func (z *Client) GetFacebookPages(ctx context.Context) ([]FacebookPage, error) {
+	var result struct {
+		Pages []FacebookPage `json:"facebook_pages"`
+	}
+
+	body, err := z.get(ctx, "/channels/facebook/pages.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Pages, nil
+}
+
+// GetFacebookPage fetches the Facebook page configured as a channel
+func (z *Client) GetFacebookPage(ctx context.Context, pageID int64) (FacebookPage, error) {
+	var result struct {
+		Page FacebookPage `json:"facebook_page"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/facebook/pages/%d.json", pageID))
+	if err != nil {
+		return FacebookPage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return FacebookPage{}, err
+	}
+	return result.Page, nil
+}
@@ -0,0 +1,34 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetFacebookPages(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "facebook_pages.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	pages, err := client.GetFacebookPages(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get facebook pages: %s", err)
+	}
+	if len(pages) != 1 || pages[0].PageID != "1234567890" || !pages[0].WallPostsEnabled {
+		t.Fatalf("unexpected pages %v", pages)
+	}
+}
+
+func TestGetFacebookPage(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "facebook_page.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	page, err := client.GetFacebookPage(ctx, 3)
+	if err != nil {
+		t.Fatalf("Failed to get facebook page: %s", err)
+	}
+	if page.ID != 3 || page.WallPostsEnabled {
+		t.Fatalf("unexpected page %v", page)
+	}
+}
@@ -0,0 +1,138 @@
+package zendesk
+
+import (
+	"context"
+	"sync"
+)
+
+// FetchAll fetches all pages of a listing from start with at most concurrency
+// requests in flight, and returns the items in listing order.
+//
+// Pages are fetched in parallel only when the listing is offset paginated and
+// the first page reports the total count. Cursor paginated listings can't be
+// fanned out, so they are fetched sequentially as ListAll does.
+// Rate limited requests are retried by the client as usual, so keep concurrency
+// small enough for the account's rate limit.
+func FetchAll[T any](ctx context.Context, list ListFunc[T], start PageToken, concurrency int) ([]T, error) {
+	first, err := list(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	if !first.HasMore {
+		return first.Items, nil
+	}
+
+	if first.Next.CursorBased || first.Count == 0 || len(first.Items) == 0 {
+		rest, err := ListAll(ctx, list, first.Next)
+		return append(first.Items, rest...), err
+	}
+
+	perPage := first.Next.PerPage
+	if perPage == 0 {
+		perPage = len(first.Items)
+	}
+	lastPage := int((first.Count + int64(perPage) - 1) / int64(perPage))
+
+	var tokens []PageToken
+	for p := first.Next.Page; p <= lastPage; p++ {
+		tokens = append(tokens, PageToken{Page: p, PerPage: first.Next.PerPage})
+	}
+
+	pages, err := fetchConcurrently(ctx, tokens, concurrency, func(ctx context.Context, token PageToken) ([]T, error) {
+		page, err := list(ctx, token)
+		return page.Items, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	all := first.Items
+	for _, items := range pages {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// FetchInBatches splits keys into batches of batchSize and calls fetch for each
+// batch with at most concurrency calls in flight. It is useful for endpoints
+// such as show_many which accept limited number of IDs per request.
+// The results are returned in the order of batches.
+func FetchInBatches[T any, K any](ctx context.Context, keys []K, batchSize int, concurrency int, fetch func(ctx context.Context, batch []K) ([]T, error)) ([]T, error) {
+	if batchSize <= 0 {
+		batchSize = len(keys)
+	}
+
+	var batches [][]K
+	for i := 0; i < len(keys); i += batchSize {
+		end := i + batchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		batches = append(batches, keys[i:end])
+	}
+
+	results, err := fetchConcurrently(ctx, batches, concurrency, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// fetchConcurrently calls fetch for each input with bounded parallelism.
+// It stops dispatching on the first error and returns it.
+func fetchConcurrently[T any, I any](ctx context.Context, inputs []I, concurrency int, fetch func(ctx context.Context, input I) ([]T, error)) ([][]T, error) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		results  = make([][]T, len(inputs))
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	for i, input := range inputs {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, input I) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			items, err := fetch(ctx, input)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = items
+		}(i, input)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
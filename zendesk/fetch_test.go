@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFetchAll(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+
+		next := "null"
+		if page < 4 {
+			next = fmt.Sprintf(`"http://%s/groups.json?page=%d&per_page=2"`, r.Host, page+1)
+		}
+		fmt.Fprintf(w, `{"groups":[{"id":%d},{"id":%d}],"next_page":%s,"count":8}`, page*2-1, page*2, next)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	list := OffsetLister(func(ctx context.Context, opts PageOptions) ([]Group, Page, error) {
+		return client.GetGroups(ctx, &GroupListOptions{PageOptions: opts})
+	})
+
+	groups, err := FetchAll(ctx, list, PageToken{PerPage: 2}, 3)
+	if err != nil {
+		t.Fatalf("Failed to fetch groups: %s", err)
+	}
+
+	if len(groups) != 8 {
+		t.Fatalf("expected 8 groups, but got %d", len(groups))
+	}
+	for i, g := range groups {
+		if g.ID != int64(i+1) {
+			t.Fatalf("groups are not in listing order: %v", groups)
+		}
+	}
+	if requests != 4 {
+		t.Fatalf("expected 4 requests, but got %d", requests)
+	}
+}
+
+func TestFetchInBatches(t *testing.T) {
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	var calls int32
+	result, err := FetchInBatches(ctx, ids, 100, 2, func(ctx context.Context, batch []int64) ([]int64, error) {
+		atomic.AddInt32(&calls, 1)
+		if len(batch) > 100 {
+			t.Fatalf("batch size exceeded: %d", len(batch))
+		}
+		return batch, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to fetch in batches: %s", err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, but got %d", calls)
+	}
+	for i, id := range result {
+		if id != int64(i+1) {
+			t.Fatalf("results are not in order at %d", i)
+		}
+	}
+}
+
+func TestFetchInBatchesFailure(t *testing.T) {
+	failure := errors.New("failure")
+	_, err := FetchInBatches(ctx, []int{1, 2, 3}, 1, 2, func(ctx context.Context, batch []int) ([]int, error) {
+		if batch[0] == 2 {
+			return nil, failure
+		}
+		return batch, nil
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("expected failure, but got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -34,6 +35,8 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	ListGroupUsers(ctx context.Context, groupID int64, opts *UserListOptions) ([]User, Page, error)
+	ListAssignableGroupAgents(ctx context.Context, groupID int64) ([]User, error)
 }
 
 // GetGroups fetches group list
@@ -66,6 +69,20 @@ func (z *Client) GetGroups(ctx context.Context, opts *GroupListOptions) ([]Group
 	return data.Groups, data.Page, nil
 }
 
+// GetGroupsPage fetches the page of groups at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetGroupsPage(ctx context.Context, token PageToken, opts *GroupListOptions) (ListPage[Group], error) {
+	tmp := GroupListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Group, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetGroups(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateGroup creates new group
 // https://developer.zendesk.com/rest_api/docs/support/groups#create-group
 func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
@@ -140,3 +157,71 @@ func (z *Client) DeleteGroup(ctx context.Context, groupID int64) error {
 
 	return nil
 }
+
+// ListGroupUsers fetches the users of the group, filtered by roles and permission set of opts
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+func (z *Client) ListGroupUsers(ctx context.Context, groupID int64, opts *UserListOptions) ([]User, Page, error) {
+	var data struct {
+		Users []User `json:"users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &UserListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/groups/%d/users.json", groupID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Users, data.Page, nil
+}
+
+// ListGroupUsersPage fetches the page of users in the group at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) ListGroupUsersPage(ctx context.Context, groupID int64, token PageToken, opts *UserListOptions) (ListPage[User], error) {
+	tmp := UserListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]User, Page, error) {
+		tmp.PageOptions = opts
+		return z.ListGroupUsers(ctx, groupID, &tmp)
+	})(ctx, token)
+}
+
+// ListAssignableGroupAgents fetches all the agents and admins of the group
+// who can be assigned tickets, i.e. active and not suspended, sorted by ID
+func (z *Client) ListAssignableGroupAgents(ctx context.Context, groupID int64) ([]User, error) {
+	list := OffsetLister(func(ctx context.Context, opts PageOptions) ([]User, Page, error) {
+		return z.ListGroupUsers(ctx, groupID, &UserListOptions{
+			PageOptions: opts,
+			Roles:       []string{UserRoleText(UserRoleAgent), UserRoleText(UserRoleAdmin)},
+		})
+	})
+	users, err := ListAll(ctx, list, PageToken{})
+	if err != nil {
+		return nil, err
+	}
+
+	var agents []User
+	for _, u := range users {
+		if u.Active && !u.Suspended {
+			agents = append(agents, u)
+		}
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].ID < agents[j].ID })
+	return agents, nil
+}
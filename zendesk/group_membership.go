@@ -63,3 +63,17 @@ func (z *Client) GetGroupMemberships(ctx context.Context, opts *GroupMembershipL
 
 	return result.GroupMemberships, result.Page, nil
 }
+
+// GetGroupMembershipsPage fetches the page of group memberships at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetGroupMembershipsPage(ctx context.Context, token PageToken, opts *GroupMembershipListOptions) (ListPage[GroupMembership], error) {
+	tmp := GroupMembershipListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]GroupMembership, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetGroupMemberships(ctx, &tmp)
+	})(ctx, token)
+}
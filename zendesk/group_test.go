@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +77,45 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestListGroupUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/10/users.json" || r.URL.Query()["role[]"][0] != "agent" || r.URL.Query().Get("permission_set") != "5" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.ListGroupUsers(ctx, 10, &UserListOptions{Roles: []string{"agent"}, PermissionSet: 5})
+	if err != nil {
+		t.Fatalf("Failed to list group users: %s", err)
+	}
+	if len(users) == 0 {
+		t.Fatal("expected users")
+	}
+}
+
+func TestListAssignableGroupAgents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if roles := r.URL.Query()["role[]"]; len(roles) != 2 || roles[0] != "agent" || roles[1] != "admin" {
+			t.Fatalf("unexpected roles %v", roles)
+		}
+		w.Write([]byte(`{"users": [
+			{"id": 2, "role": "agent", "active": true},
+			{"id": 1, "role": "admin", "active": true},
+			{"id": 3, "role": "agent", "active": false}
+		], "next_page": null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agents, err := client.ListAssignableGroupAgents(ctx, 10)
+	if err != nil {
+		t.Fatalf("Failed to list agents: %s", err)
+	}
+	if len(agents) != 2 || agents[0].ID != 1 || agents[1].ID != 2 {
+		t.Fatalf("unexpected agents %v", agents)
+	}
+}
@@ -0,0 +1,122 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrJobNotDone is returned by Job.Results when the job has not finished yet
+var ErrJobNotDone = errors.New("zendesk: job has not finished")
+
+// JobError is returned when a job finished without being completed
+type JobError struct {
+	Job JobStatus
+}
+
+// Error the error string for this error
+func (e *JobError) Error() string {
+	return fmt.Sprintf("job %s is %s: %s", e.Job.ID, e.Job.Status, e.Job.Message)
+}
+
+// Job is a background job started by a bulk endpoint, whose results are
+// decoded into T when it finishes. It is safe for concurrent use.
+//
+//	status, err := client.CreateManyTickets(ctx, tickets)
+//	if err != nil {
+//		return err
+//	}
+//	results, err := zendesk.NewTicketJob(client, status).Wait(ctx)
+type Job[T any] struct {
+	// PollInterval is the interval of polling by Wait. The default is 1 second.
+	PollInterval time.Duration
+
+	api    JobStatusAPI
+	decode func(JobStatus) []T
+
+	mu     sync.Mutex
+	status JobStatus
+}
+
+// NewJob returns the Job of the status, whose results are decoded by decode
+func NewJob[T any](api JobStatusAPI, status JobStatus, decode func(JobStatus) []T) *Job[T] {
+	return &Job[T]{api: api, decode: decode, status: status}
+}
+
+// NewTicketJob returns the Job of ticket bulk endpoints such as CreateManyTickets
+func NewTicketJob(api JobStatusAPI, status JobStatus) *Job[TicketJobResult] {
+	return NewJob(api, status, JobStatus.TicketResults)
+}
+
+// NewUserJob returns the Job of user bulk endpoints such as CreateOrUpdateManyUsers
+func NewUserJob(api JobStatusAPI, status JobStatus) *Job[UserJobResult] {
+	return NewJob(api, status, JobStatus.UserResults)
+}
+
+// ID returns the ID of the job
+func (j *Job[T]) ID() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status.ID
+}
+
+// Status returns the latest status fetched
+func (j *Job[T]) Status() JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// Poll fetches the status of the job unless it has finished
+func (j *Job[T]) Poll(ctx context.Context) (JobStatus, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.status.Done() {
+		return j.status, nil
+	}
+	status, err := j.api.GetJobStatus(ctx, j.status.ID)
+	if err != nil {
+		return j.status, err
+	}
+	j.status = status
+	return status, nil
+}
+
+// Wait polls the job every PollInterval until it finishes and returns the results.
+// If the job failed or was killed, the results processed so far are
+// returned with JobError.
+func (j *Job[T]) Wait(ctx context.Context) ([]T, error) {
+	interval := j.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	for !j.Status().Done() {
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+
+		if _, err := j.Poll(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return j.Results()
+}
+
+// Results returns the results of the finished job without polling.
+// It returns ErrJobNotDone if the job is in progress.
+func (j *Job[T]) Results() ([]T, error) {
+	status := j.Status()
+	if !status.Done() {
+		return nil, ErrJobNotDone
+	}
+
+	results := j.decode(status)
+	if status.Status != JobStatusCompleted {
+		return results, &JobError{Job: status}
+	}
+	return results, nil
+}
@@ -0,0 +1,276 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// JobStatusQueued is the status of job waiting to be started
+	JobStatusQueued = "queued"
+	// JobStatusWorking is the status of job in progress
+	JobStatusWorking = "working"
+	// JobStatusFailed is the status of job which failed
+	JobStatusFailed = "failed"
+	// JobStatusCompleted is the status of job which completed
+	JobStatusCompleted = "completed"
+	// JobStatusKilled is the status of job which was killed
+	JobStatusKilled = "killed"
+)
+
+// JobStatus is the status of a background job such as create_many
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/
+type JobStatus struct {
+	ID       string      `json:"id"`
+	URL      string      `json:"url,omitempty"`
+	JobType  string      `json:"job_type,omitempty"`
+	Total    int         `json:"total"`
+	Progress int         `json:"progress"`
+	Status   string      `json:"status"`
+	Message  string      `json:"message,omitempty"`
+	Results  []JobResult `json:"results,omitempty"`
+}
+
+// Done reports whether the job finished regardless of success
+func (j JobStatus) Done() bool {
+	switch j.Status {
+	case JobStatusCompleted, JobStatusFailed, JobStatusKilled:
+		return true
+	}
+	return false
+}
+
+// JobResult is the result of a record processed by the job.
+// Index is the position of the record in the request payload.
+// Details is the message of the error. Some jobs such as user jobs report
+// the errors per field, which are set to FieldErrors, and Details joins them.
+type JobResult struct {
+	ID          int64                      `json:"id,omitempty"`
+	Index       int                        `json:"index"`
+	Action      string                     `json:"action,omitempty"`
+	Status      string                     `json:"status,omitempty"`
+	Success     bool                       `json:"success"`
+	Error       string                     `json:"error,omitempty"`
+	Details     string                     `json:"details,omitempty"`
+	FieldErrors map[string][]JobFieldError `json:"-"`
+	ExternalID  string                     `json:"external_id,omitempty"`
+	Email       string                     `json:"email,omitempty"`
+}
+
+// JobFieldError is the error of a field of the record
+type JobFieldError struct {
+	Error       string `json:"error,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// UnmarshalJSON accepts details both as a string and as errors per field
+func (r *JobResult) UnmarshalJSON(data []byte) error {
+	type jobResult JobResult
+	var tmp struct {
+		jobResult
+		Details json.RawMessage `json:"details,omitempty"`
+	}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*r = JobResult(tmp.jobResult)
+
+	if len(tmp.Details) == 0 || string(tmp.Details) == "null" {
+		return nil
+	}
+	if tmp.Details[0] == '"' {
+		return json.Unmarshal(tmp.Details, &r.Details)
+	}
+	if err := json.Unmarshal(tmp.Details, &r.FieldErrors); err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(r.FieldErrors))
+	for field := range r.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var messages []string
+	for _, field := range fields {
+		for _, e := range r.FieldErrors[field] {
+			msg := e.Description
+			if msg == "" {
+				msg = e.Error
+			}
+			messages = append(messages, field+": "+msg)
+		}
+	}
+	r.Details = strings.Join(messages, ", ")
+	return nil
+}
+
+// Failed reports whether the record failed. Results of some jobs such as
+// create_many don't have success, so it is determined by error and status.
+func (r JobResult) Failed() bool {
+	return r.Error != "" || strings.EqualFold(r.Status, "failed")
+}
+
+// Failures returns the results of the records which failed
+func (j JobStatus) Failures() []JobResult {
+	var failures []JobResult
+	for _, r := range j.Results {
+		if r.Failed() {
+			failures = append(failures, r)
+		}
+	}
+	return failures
+}
+
+// TicketJobResult is the result of a ticket processed by the ticket jobs
+// such as CreateManyTickets and UpdateManyTickets
+type TicketJobResult struct {
+	Index    int
+	TicketID int64
+	// Action is "create", "update" or "delete"
+	Action  string
+	Failed  bool
+	Error   string
+	Details string
+}
+
+// TicketResults returns the results of the ticket job
+func (j JobStatus) TicketResults() []TicketJobResult {
+	results := make([]TicketJobResult, 0, len(j.Results))
+	for _, r := range j.Results {
+		results = append(results, TicketJobResult{
+			Index:    r.Index,
+			TicketID: r.ID,
+			Action:   r.Action,
+			Failed:   r.Failed(),
+			Error:    r.Error,
+			Details:  r.Details,
+		})
+	}
+	return results
+}
+
+// UserJobResult is the result of a user processed by the user jobs such as
+// CreateOrUpdateManyUsers
+type UserJobResult struct {
+	Index      int
+	UserID     int64
+	ExternalID string
+	Email      string
+	// Created is true if the user was created, and false if updated
+	Created     bool
+	Failed      bool
+	Error       string
+	Details     string
+	FieldErrors map[string][]JobFieldError
+}
+
+// UserResults returns the results of the user job
+func (j JobStatus) UserResults() []UserJobResult {
+	results := make([]UserJobResult, 0, len(j.Results))
+	for _, r := range j.Results {
+		results = append(results, UserJobResult{
+			Index:       r.Index,
+			UserID:      r.ID,
+			ExternalID:  r.ExternalID,
+			Email:       r.Email,
+			Created:     !r.Failed() && (strings.EqualFold(r.Status, "created") || r.Action == "create"),
+			Failed:      r.Failed(),
+			Error:       r.Error,
+			Details:     r.Details,
+			FieldErrors: r.FieldErrors,
+		})
+	}
+	return results
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, jobID string) (JobStatus, error)
+	CreateManyTickets(ctx context.Context, tickets []Ticket) (JobStatus, error)
+	UpdateManyTickets(ctx context.Context, tickets []Ticket) (JobStatus, error)
+	CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+}
+
+// GetJobStatus fetches the status of the job
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, jobID string) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", jobID))
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// CreateManyTickets starts a job creating up to 100 tickets.
+// Use SubmitInBatches for more tickets.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#create-many-tickets
+func (z *Client) CreateManyTickets(ctx context.Context, tickets []Ticket) (JobStatus, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+	data.Tickets = tickets
+	return z.postJob(ctx, "/tickets/create_many.json", data)
+}
+
+// UpdateManyTickets starts a job updating up to 100 tickets with their IDs.
+// Use SubmitInBatches for more tickets.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) UpdateManyTickets(ctx context.Context, tickets []Ticket) (JobStatus, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+	data.Tickets = tickets
+	return z.putJob(ctx, "/tickets/update_many.json", data)
+}
+
+// CreateOrUpdateManyUsers starts a job creating or updating up to 100 users.
+// Use SubmitInBatches for more users.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-many-users
+func (z *Client) CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+	return z.postJob(ctx, "/users/create_or_update_many.json", data)
+}
+
+func (z *Client) postJob(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return decodeJobStatus(body)
+}
+
+func (z *Client) putJob(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return decodeJobStatus(body)
+}
+
+func decodeJobStatus(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
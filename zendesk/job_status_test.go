@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.GetJobStatus(ctx, "8b726e606741012ffc2d782bcb7848fe")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+	if !job.Done() || len(job.Results) != 2 || job.Results[1].Error != "RecordInvalid" {
+		t.Fatalf("unexpected job status %v", job)
+	}
+}
+
+func TestCreateManyTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.CreateManyTickets(ctx, []Ticket{{Subject: "a"}, {Subject: "b"}})
+	if err != nil {
+		t.Fatalf("Failed to create many tickets: %s", err)
+	}
+	if job.Status != JobStatusQueued || job.Done() {
+		t.Fatalf("unexpected job status %v", job)
+	}
+}
+
+func TestUpdateManyTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.UpdateManyTickets(ctx, []Ticket{{ID: 1, Status: TicketStatusSolved}})
+	if err != nil {
+		t.Fatalf("Failed to update many tickets: %s", err)
+	}
+	if job.ID == "" {
+		t.Fatalf("unexpected job status %v", job)
+	}
+}
+
+func TestJobStatusTicketResults(t *testing.T) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "job_status.json")), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	results := result.JobStatus.TicketResults()
+	if len(results) != 2 || results[0].TicketID != 380 || results[0].Failed {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if !results[1].Failed || results[1].Details != "Requester: Email is invalid" {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if failures := result.JobStatus.Failures(); len(failures) != 1 || failures[0].Index != 1 {
+		t.Fatalf("unexpected failures %v", failures)
+	}
+}
+
+func TestJobStatusUserResults(t *testing.T) {
+	var job JobStatus
+	err := json.Unmarshal([]byte(`{
+		"id": "a",
+		"status": "completed",
+		"results": [
+			{"id": 1, "index": 0, "action": "create", "status": "Created", "email": "a@example.com"},
+			{"id": 2, "index": 1, "action": "update", "status": "Updated", "external_id": "x"},
+			{"index": 2, "error": "EmailNotUnique", "details": {
+				"email": [{"error": "DuplicateValue", "description": "Email: b@example.com is already being used"}],
+				"name": [{"error": "BlankValue"}]
+			}}
+		]
+	}`), &job)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := job.UserResults()
+	if len(results) != 3 || !results[0].Created || results[0].Email != "a@example.com" {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if results[1].Created || results[1].Failed || results[1].ExternalID != "x" {
+		t.Fatalf("unexpected results %v", results)
+	}
+	r := results[2]
+	if !r.Failed || r.Created || len(r.FieldErrors["email"]) != 1 || r.FieldErrors["email"][0].Error != "DuplicateValue" {
+		t.Fatalf("unexpected results %v", r)
+	}
+	if r.Details != "email: Email: b@example.com is already being used, name: BlankValue" {
+		t.Fatalf("unexpected details %q", r.Details)
+	}
+}
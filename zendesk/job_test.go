@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestJobWait(t *testing.T) {
+	polls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			w.Write([]byte(`{"job_status": {"id": "a", "status": "working"}}`))
+			return
+		}
+		w.Write(readFixture("GET/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job := NewTicketJob(client, JobStatus{ID: "a", Status: JobStatusQueued})
+	job.PollInterval = time.Millisecond
+
+	if _, err := job.Results(); !errors.Is(err, ErrJobNotDone) {
+		t.Fatalf("expected ErrJobNotDone, got %v", err)
+	}
+
+	results, err := job.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Failed to wait job: %s", err)
+	}
+	if polls != 2 || len(results) != 2 || results[0].TicketID != 380 || !results[1].Failed {
+		t.Fatalf("unexpected results %v after %d polls", results, polls)
+	}
+
+	if status, err := job.Poll(ctx); err != nil || polls != 2 || !status.Done() {
+		t.Fatalf("finished job should not be polled, got %v %v", status, err)
+	}
+}
+
+func TestJobFailed(t *testing.T) {
+	job := NewUserJob(nil, JobStatus{
+		ID:      "a",
+		Status:  JobStatusFailed,
+		Message: "boom",
+		Results: []JobResult{{ID: 1, Index: 0, Status: "Created"}},
+	})
+
+	results, err := job.Results()
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) || jobErr.Job.Message != "boom" {
+		t.Fatalf("expected JobError, got %v", err)
+	}
+	if len(results) != 1 || !results[0].Created {
+		t.Fatalf("unexpected results %v", results)
+	}
+}
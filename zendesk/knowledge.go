@@ -0,0 +1,321 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// KnowledgeSearchOptions is options for SearchKnowledge
+type KnowledgeSearchOptions struct {
+	CursorPagination
+
+	Query string `url:"query"`
+	// Locales filters the results by locales, e.g. "en-us". Required by Zendesk.
+	Locales  []string `url:"filter[locales],comma,omitempty"`
+	BrandIDs []int64  `url:"filter[brand_ids],comma,omitempty"`
+	// ContentTypes filters the results by types, e.g. "ARTICLE", "POST" and "EXTERNAL_RECORD"
+	ContentTypes      []string `url:"filter[content_types],comma,omitempty"`
+	ExternalSourceIDs []string `url:"filter[external_source_ids],comma,omitempty"`
+}
+
+// KnowledgeSearchResult is a result of unified knowledge search,
+// which is a Help Center article, a community post or an external content record
+type KnowledgeSearchResult struct {
+	Title     string `json:"title"`
+	Snippet   string `json:"snippet"`
+	URL       string `json:"url"`
+	Locale    string `json:"locale"`
+	Type      string `json:"type"`
+	UpdatedAt Time   `json:"updated_at"`
+}
+
+// ExternalContentSource is a source of external content records, e.g. a wiki
+// https://developer.zendesk.com/api-reference/help_center/federated-search/sources/
+type ExternalContentSource struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name"`
+	CreatedAt *Time  `json:"created_at,omitempty"`
+	UpdatedAt *Time  `json:"updated_at,omitempty"`
+}
+
+// ExternalContentRecord is an external document indexed into help center search
+// https://developer.zendesk.com/api-reference/help_center/federated-search/records/
+type ExternalContentRecord struct {
+	ID             string  `json:"id,omitempty"`
+	ExternalID     string  `json:"external_id,omitempty"`
+	Title          string  `json:"title"`
+	Body           string  `json:"body"`
+	Locale         string  `json:"locale"`
+	URL            string  `json:"url"`
+	SourceID       string  `json:"source_id"`
+	TypeID         string  `json:"type_id"`
+	UserSegmentID  *int64  `json:"user_segment_id"`
+	UserSegmentIDs []int64 `json:"user_segment_ids,omitempty"`
+	CreatedAt      *Time   `json:"created_at,omitempty"`
+	UpdatedAt      *Time   `json:"updated_at,omitempty"`
+}
+
+// KnowledgeAPI an interface containing all knowledge search and external content related methods
+type KnowledgeAPI interface {
+	SearchKnowledge(ctx context.Context, opts *KnowledgeSearchOptions) ([]KnowledgeSearchResult, CursorPaginationMeta, error)
+	GetExternalContentSources(ctx context.Context, opts *CursorPagination) ([]ExternalContentSource, CursorPaginationMeta, error)
+	GetExternalContentSource(ctx context.Context, sourceID string) (ExternalContentSource, error)
+	CreateExternalContentSource(ctx context.Context, source ExternalContentSource) (ExternalContentSource, error)
+	UpdateExternalContentSource(ctx context.Context, sourceID string, source ExternalContentSource) (ExternalContentSource, error)
+	DeleteExternalContentSource(ctx context.Context, sourceID string) error
+	GetExternalContentRecords(ctx context.Context, opts *CursorPagination) ([]ExternalContentRecord, CursorPaginationMeta, error)
+	GetExternalContentRecord(ctx context.Context, recordID string) (ExternalContentRecord, error)
+	CreateExternalContentRecord(ctx context.Context, record ExternalContentRecord) (ExternalContentRecord, error)
+	UpdateExternalContentRecord(ctx context.Context, recordID string, record ExternalContentRecord) (ExternalContentRecord, error)
+	DeleteExternalContentRecord(ctx context.Context, recordID string) error
+}
+
+// SearchKnowledge searches help center articles, community posts and external content records
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/unified_search/
+func (z *Client) SearchKnowledge(ctx context.Context, opts *KnowledgeSearchOptions) ([]KnowledgeSearchResult, CursorPaginationMeta, error) {
+	var result struct {
+		Results []KnowledgeSearchResult `json:"results"`
+		Meta    CursorPaginationMeta    `json:"meta"`
+	}
+
+	if opts == nil {
+		return nil, CursorPaginationMeta{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/guide/search", opts)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Results, result.Meta, nil
+}
+
+// SearchKnowledgePage fetches the page of knowledge search results at token as ListPage.
+// The zero PageToken is the first page, and the cursor options of opts are
+// replaced with token.
+func (z *Client) SearchKnowledgePage(ctx context.Context, token PageToken, opts *KnowledgeSearchOptions) (ListPage[KnowledgeSearchResult], error) {
+	tmp := KnowledgeSearchOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return CursorLister(func(ctx context.Context, opts CursorPagination) ([]KnowledgeSearchResult, CursorPaginationMeta, error) {
+		tmp.CursorPagination = opts
+		return z.SearchKnowledge(ctx, &tmp)
+	})(ctx, token)
+}
+
+// GetExternalContentSources fetches the external content sources
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/sources/#list-external-content-sources
+func (z *Client) GetExternalContentSources(ctx context.Context, opts *CursorPagination) ([]ExternalContentSource, CursorPaginationMeta, error) {
+	var result struct {
+		Sources []ExternalContentSource `json:"sources"`
+		Meta    CursorPaginationMeta    `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CursorPagination{}
+	}
+
+	u, err := addOptions("/guide/external_content/sources", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Sources, result.Meta, nil
+}
+
+// GetExternalContentSourcesPage fetches the page of external content sources at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetExternalContentSourcesPage(ctx context.Context, token PageToken) (ListPage[ExternalContentSource], error) {
+	return CursorLister(func(ctx context.Context, opts CursorPagination) ([]ExternalContentSource, CursorPaginationMeta, error) {
+		return z.GetExternalContentSources(ctx, &opts)
+	})(ctx, token)
+}
+
+// GetExternalContentSource fetches the external content source
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/sources/#show-external-content-source
+func (z *Client) GetExternalContentSource(ctx context.Context, sourceID string) (ExternalContentSource, error) {
+	var result struct {
+		Source ExternalContentSource `json:"source"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/external_content/sources/%s", sourceID))
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+	return result.Source, nil
+}
+
+// CreateExternalContentSource creates a new external content source
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/sources/#create-external-content-source
+func (z *Client) CreateExternalContentSource(ctx context.Context, source ExternalContentSource) (ExternalContentSource, error) {
+	var data, result struct {
+		Source ExternalContentSource `json:"source"`
+	}
+	data.Source = source
+
+	body, err := z.post(ctx, "/guide/external_content/sources", data)
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+	return result.Source, nil
+}
+
+// UpdateExternalContentSource updates the external content source
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/sources/#update-external-content-source
+func (z *Client) UpdateExternalContentSource(ctx context.Context, sourceID string, source ExternalContentSource) (ExternalContentSource, error) {
+	var data, result struct {
+		Source ExternalContentSource `json:"source"`
+	}
+	data.Source = source
+
+	body, err := z.put(ctx, fmt.Sprintf("/guide/external_content/sources/%s", sourceID), data)
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentSource{}, err
+	}
+	return result.Source, nil
+}
+
+// DeleteExternalContentSource deletes the external content source and its records
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/sources/#delete-external-content-source
+func (z *Client) DeleteExternalContentSource(ctx context.Context, sourceID string) error {
+	return z.delete(ctx, fmt.Sprintf("/guide/external_content/sources/%s", sourceID))
+}
+
+// GetExternalContentRecords fetches the external content records
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/records/#list-external-content-records
+func (z *Client) GetExternalContentRecords(ctx context.Context, opts *CursorPagination) ([]ExternalContentRecord, CursorPaginationMeta, error) {
+	var result struct {
+		Records []ExternalContentRecord `json:"records"`
+		Meta    CursorPaginationMeta    `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CursorPagination{}
+	}
+
+	u, err := addOptions("/guide/external_content/records", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Records, result.Meta, nil
+}
+
+// GetExternalContentRecordsPage fetches the page of external content records at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetExternalContentRecordsPage(ctx context.Context, token PageToken) (ListPage[ExternalContentRecord], error) {
+	return CursorLister(func(ctx context.Context, opts CursorPagination) ([]ExternalContentRecord, CursorPaginationMeta, error) {
+		return z.GetExternalContentRecords(ctx, &opts)
+	})(ctx, token)
+}
+
+// GetExternalContentRecord fetches the external content record
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/records/#show-external-content-record
+func (z *Client) GetExternalContentRecord(ctx context.Context, recordID string) (ExternalContentRecord, error) {
+	var result struct {
+		Record ExternalContentRecord `json:"record"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/external_content/records/%s", recordID))
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+	return result.Record, nil
+}
+
+// CreateExternalContentRecord indexes a new external content record
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/records/#create-external-content-record
+func (z *Client) CreateExternalContentRecord(ctx context.Context, record ExternalContentRecord) (ExternalContentRecord, error) {
+	var data, result struct {
+		Record ExternalContentRecord `json:"record"`
+	}
+	data.Record = record
+
+	body, err := z.post(ctx, "/guide/external_content/records", data)
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+	return result.Record, nil
+}
+
+// UpdateExternalContentRecord updates the external content record
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/records/#update-external-content-record
+func (z *Client) UpdateExternalContentRecord(ctx context.Context, recordID string, record ExternalContentRecord) (ExternalContentRecord, error) {
+	var data, result struct {
+		Record ExternalContentRecord `json:"record"`
+	}
+	data.Record = record
+
+	body, err := z.put(ctx, fmt.Sprintf("/guide/external_content/records/%s", recordID), data)
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ExternalContentRecord{}, err
+	}
+	return result.Record, nil
+}
+
+// DeleteExternalContentRecord removes the external content record from the search index
+// ref: https://developer.zendesk.com/api-reference/help_center/federated-search/records/#delete-external-content-record
+func (z *Client) DeleteExternalContentRecord(ctx context.Context, recordID string) error {
+	return z.delete(ctx, fmt.Sprintf("/guide/external_content/records/%s", recordID))
+}
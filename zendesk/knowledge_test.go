@@ -0,0 +1,119 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchKnowledge(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/guide/search" || q.Get("query") != "password" || q.Get("filter[locales]") != "en-us,ja" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture("GET/knowledge_search.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, meta, err := client.SearchKnowledge(ctx, &KnowledgeSearchOptions{
+		Query:   "password",
+		Locales: []string{"en-us", "ja"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to search knowledge: %s", err)
+	}
+	if len(results) != 2 || results[1].Type != "EXTERNAL_RECORD" {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if !meta.HasMore || meta.AfterCursor != "xOTMxNTU5Mg" {
+		t.Fatalf("unexpected meta %v", meta)
+	}
+}
+
+func TestSearchKnowledgeWithNil(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "knowledge_search.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.SearchKnowledge(ctx, nil)
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+}
+
+func TestGetExternalContentSources(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "external_content_sources.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sources, meta, err := client.GetExternalContentSources(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get external content sources: %s", err)
+	}
+	if len(sources) != 1 || sources[0].Name != "Company wiki" || meta.HasMore {
+		t.Fatalf("unexpected sources %v %v", sources, meta)
+	}
+}
+
+func TestCreateExternalContentSource(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "external_content_source.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	source, err := client.CreateExternalContentSource(ctx, ExternalContentSource{Name: "Company wiki"})
+	if err != nil {
+		t.Fatalf("Failed to create external content source: %s", err)
+	}
+	if source.ID != "01EC05A5T6J9Y0ZA3WZCC2XKHP" {
+		t.Fatalf("unexpected source %v", source)
+	}
+}
+
+func TestGetExternalContentRecords(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "external_content_records.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	records, meta, err := client.GetExternalContentRecords(ctx, &CursorPagination{PageSize: 1})
+	if err != nil {
+		t.Fatalf("Failed to get external content records: %s", err)
+	}
+	if len(records) != 1 || records[0].ExternalID != "wiki-1234" || records[0].UserSegmentID != nil {
+		t.Fatalf("unexpected records %v", records)
+	}
+	if !meta.HasMore {
+		t.Fatalf("expected more records")
+	}
+}
+
+func TestUpdateExternalContentRecord(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "external_content_record.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	record, err := client.UpdateExternalContentRecord(ctx, "01EC05A5T6J9Y0ZA3WZCC2XKHQ", ExternalContentRecord{Title: "Password policy (updated)"})
+	if err != nil {
+		t.Fatalf("Failed to update external content record: %s", err)
+	}
+	if record.UserSegmentID == nil || *record.UserSegmentID != 12 {
+		t.Fatalf("unexpected record %v", record)
+	}
+}
+
+func TestDeleteExternalContentRecord(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/guide/external_content/records/01EC05A5T6J9Y0ZA3WZCC2XKHQ" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteExternalContentRecord(ctx, "01EC05A5T6J9Y0ZA3WZCC2XKHQ")
+	if err != nil {
+		t.Fatalf("Failed to delete external content record: %s", err)
+	}
+}
@@ -4,20 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"io"
 )
 
 // Macro is information about zendesk macro
 type Macro struct {
 	Actions     []MacroAction `json:"actions"`
 	Active      bool          `json:"active"`
-	CreatedAt   time.Time     `json:"created_at,omitempty"`
+	CreatedAt   *Time         `json:"created_at,omitempty"`
 	Description interface{}   `json:"description"`
 	ID          int64         `json:"id,omitempty"`
 	Position    int           `json:"position,omitempty"`
 	Restriction interface{}   `json:"restriction"`
 	Title       string        `json:"title"`
-	UpdatedAt   time.Time     `json:"updated_at,omitempty"`
+	UpdatedAt   *Time         `json:"updated_at,omitempty"`
 	URL         string        `json:"url,omitempty"`
 }
 
@@ -40,12 +40,61 @@ type MacroListOptions struct {
 
 	PageOptions
 
-	// SortBy can take "created_at", "updated_at", "usage_1h", "usage_24h",
-	// "usage_7d", "usage_30d", "alphabetical"
-	SortBy string `url:"sort_by,omitempty"`
+	SortBy    RuleSortField `url:"sort_by,omitempty"`
+	SortOrder SortOrder     `url:"sort_order,omitempty"`
+}
+
+// Validate checks the sort field and order
+func (o MacroListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		RuleSortByAlphabetical, RuleSortByCreatedAt, RuleSortByUpdatedAt,
+		RuleSortByUsage1h, RuleSortByUsage24h, RuleSortByUsage7d, RuleSortByUsage30d); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
+}
+
+// MacroAttachment is a file attached to the comment of macro
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-unassociated-macro-attachment
+type MacroAttachment struct {
+	ID          int64  `json:"id"`
+	ContentType string `json:"content_type"`
+	ContentURL  string `json:"content_url"`
+	Filename    string `json:"filename"`
+	Size        int64  `json:"size"`
+	CreatedAt   *Time  `json:"created_at,omitempty"`
+}
 
-	// SortOrder can take "asc" or "desc"
-	SortOrder string `url:"sort_order,omitempty"`
+// MacroActionDefinition describes an action available to macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+type MacroActionDefinition struct {
+	Group   string `json:"group"`
+	Output  string `json:"output"`
+	Subject string `json:"subject"`
+	Title   string `json:"title"`
+	Values  *struct {
+		Type string `json:"type"`
+		List []struct {
+			Title   string      `json:"title"`
+			Value   interface{} `json:"value"`
+			Enabled bool        `json:"enabled"`
+		} `json:"list"`
+	} `json:"values,omitempty"`
+}
+
+// MacroResult is the changes a macro makes to a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+type MacroResult struct {
+	Ticket  Ticket `json:"ticket"`
+	Comment struct {
+		Body       string     `json:"body"`
+		HTMLBody   string     `json:"html_body"`
+		ScopedBody [][]string `json:"scoped_body,omitempty"`
+		Public     bool       `json:"public"`
+	} `json:"comment"`
 }
 
 // MacroAPI an interface containing all macro related methods
@@ -55,6 +104,13 @@ type MacroAPI interface {
 	CreateMacro(ctx context.Context, macro Macro) (Macro, error)
 	UpdateMacro(ctx context.Context, macroID int64, macro Macro) (Macro, error)
 	DeleteMacro(ctx context.Context, macroID int64) error
+	UploadMacroAttachment(ctx context.Context, filename string, content io.Reader) (MacroAttachment, error)
+	CreateMacroAttachment(ctx context.Context, macroID int64, filename string, content io.Reader) (MacroAttachment, error)
+	GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error)
+	GetMacroCategories(ctx context.Context) ([]string, error)
+	GetMacroActions(ctx context.Context) ([]MacroActionDefinition, error)
+	GetMacroReplica(ctx context.Context, macroID int64, ticketID int64) (Macro, error)
+	GetMacroChanges(ctx context.Context, macroID int64, ticketID int64) (MacroResult, error)
 }
 
 // GetMacros get macro list
@@ -88,6 +144,20 @@ func (z *Client) GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro
 	return data.Macros, data.Page, nil
 }
 
+// GetMacrosPage fetches the page of macros at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetMacrosPage(ctx context.Context, token PageToken, opts *MacroListOptions) (ListPage[Macro], error) {
+	tmp := MacroListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Macro, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetMacros(ctx, &tmp)
+	})(ctx, token)
+}
+
 // GetMacro gets a specified macro
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/macros#show-macro
@@ -163,3 +233,154 @@ func (z *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 
 	return nil
 }
+
+// UploadMacroAttachment uploads a file which can be associated with a macro later
+// by the ID in its attachments
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-unassociated-macro-attachment
+func (z *Client) UploadMacroAttachment(ctx context.Context, filename string, content io.Reader) (MacroAttachment, error) {
+	return z.createMacroAttachment(ctx, "/macros/attachments.json", filename, content)
+}
+
+// CreateMacroAttachment uploads a file and associates it with the macro
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-macro-attachment
+func (z *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, content io.Reader) (MacroAttachment, error) {
+	return z.createMacroAttachment(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID), filename, content)
+}
+
+func (z *Client) createMacroAttachment(ctx context.Context, path string, filename string, content io.Reader) (MacroAttachment, error) {
+	var result struct {
+		MacroAttachment MacroAttachment `json:"macro_attachment"`
+	}
+
+	body, err := z.postMultipart(ctx, path, "attachment", filename, content)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+	return result.MacroAttachment, nil
+}
+
+// GetMacroAttachments gets the attachments of the macro
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-attachments
+func (z *Client) GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error) {
+	var result struct {
+		MacroAttachments []MacroAttachment `json:"macro_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.MacroAttachments, nil
+}
+
+// GetMacroCategories gets the categories of the macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-categories
+func (z *Client) GetMacroCategories(ctx context.Context) ([]string, error) {
+	var result struct {
+		Categories []string `json:"categories"`
+	}
+
+	body, err := z.get(ctx, "/macros/categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Categories, nil
+}
+
+// GetMacroActions gets the actions available to macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-supported-actions-for-macros
+func (z *Client) GetMacroActions(ctx context.Context) ([]MacroActionDefinition, error) {
+	var result struct {
+		Actions []MacroActionDefinition `json:"actions"`
+	}
+
+	body, err := z.get(ctx, "/macros/actions.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Actions, nil
+}
+
+// GetMacroReplica gets an unpersisted macro which replicates the macro,
+// or the ticket if macroID is zero
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-macro-replica
+func (z *Client) GetMacroReplica(ctx context.Context, macroID int64, ticketID int64) (Macro, error) {
+	var result struct {
+		Macro Macro `json:"macro"`
+	}
+
+	var opts struct {
+		MacroID  int64 `url:"macro_id,omitempty"`
+		TicketID int64 `url:"ticket_id,omitempty"`
+	}
+	opts.MacroID = macroID
+	opts.TicketID = ticketID
+
+	u, err := addOptions("/macros/new.json", opts)
+	if err != nil {
+		return Macro{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return Macro{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Macro{}, err
+	}
+	return result.Macro, nil
+}
+
+// GetMacroChanges gets the changes the macro would make to a ticket.
+// If ticketID is not zero, the result is the ticket after the changes.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+func (z *Client) GetMacroChanges(ctx context.Context, macroID int64, ticketID int64) (MacroResult, error) {
+	var result struct {
+		Result MacroResult `json:"result"`
+	}
+
+	path := fmt.Sprintf("/macros/%d/apply.json", macroID)
+	if ticketID != 0 {
+		path = fmt.Sprintf("/tickets/%d/macros/%d/apply.json", ticketID, macroID)
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return MacroResult{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroResult{}, err
+	}
+	return result.Result, nil
+}
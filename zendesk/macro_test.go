@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -16,7 +19,7 @@ func TestGetMacros(t *testing.T) {
 			Page:    1,
 			PerPage: 10,
 		},
-		SortBy:    "id",
+		SortBy:    RuleSortByCreatedAt,
 		SortOrder: "asc",
 	})
 	if err != nil {
@@ -106,3 +109,112 @@ func TestDeleteMacro(t *testing.T) {
 		t.Fatalf("Failed to delete macro field: %s", err)
 	}
 }
+
+func TestCreateMacroAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/437/attachments.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		file, header, err := r.FormFile("attachment")
+		if err != nil {
+			t.Fatalf("Failed to read multipart form: %s", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "note.txt" || string(content) != "hello" {
+			t.Fatalf("unexpected file %s: %s", header.Filename, content)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "macro_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.CreateMacroAttachment(ctx, 437, "note.txt", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("Failed to create macro attachment: %s", err)
+	}
+	if attachment.ID != 100 || attachment.Filename != "note.txt" {
+		t.Fatalf("unexpected attachment %v", attachment)
+	}
+}
+
+func TestGetMacroAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.GetMacroAttachments(ctx, 437)
+	if err != nil {
+		t.Fatalf("Failed to get macro attachments: %s", err)
+	}
+	if len(attachments) != 1 || attachments[0].Size != 2532 {
+		t.Fatalf("unexpected attachments %v", attachments)
+	}
+	if created := attachments[0].CreatedAt; created == nil || created.Unix() != 1471277046 {
+		t.Fatalf("unexpected created_at %v", created)
+	}
+}
+
+func TestGetMacroCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.GetMacroCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro categories: %s", err)
+	}
+	if len(categories) != 2 || categories[0] != "FAQ" {
+		t.Fatalf("unexpected categories %v", categories)
+	}
+}
+
+func TestGetMacroActions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_actions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	actions, err := client.GetMacroActions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro actions: %s", err)
+	}
+	if len(actions) != 1 || actions[0].Subject != "status" || len(actions[0].Values.List) != 2 {
+		t.Fatalf("unexpected actions %v", actions)
+	}
+}
+
+func TestGetMacroReplica(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/new.json" || r.URL.Query().Get("ticket_id") != "2" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetMacroReplica(ctx, 0, 2); err != nil {
+		t.Fatalf("Failed to get macro replica: %s", err)
+	}
+}
+
+func TestGetMacroChanges(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/35436/macros/437/apply.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro_apply.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetMacroChanges(ctx, 437, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get macro changes: %s", err)
+	}
+	if result.Ticket.Status != TicketStatusSolved || result.Comment.Body != "Assigned to Agent Uno." {
+		t.Fatalf("unexpected result %v", result)
+	}
+}
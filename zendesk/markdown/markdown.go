@@ -0,0 +1,244 @@
+// Package markdown converts Markdown into the subset of HTML which Zendesk
+// accepts in html_body of ticket comments, so that bots can compose replies
+// in Markdown.
+//
+//	comment := zendesk.TicketComment{HTMLBody: markdown.ToHTML("**Solved** in `v1.2`")}
+//
+// It supports the common syntax: paragraphs, headings, emphasis, inline and
+// fenced code, links, block quotes, nested lists and horizontal rules. Raw HTML
+// in the source is escaped, and links other than http, https and mailto are
+// rendered as text.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	headingRegexp     = regexp.MustCompile(`^(#{1,6})[ \t]+(.*?)(?:[ \t]+#+)?[ \t]*$`)
+	ruleRegexp        = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fenceRegexp       = regexp.MustCompile("^ {0,3}(```+|~~~+)")
+	quoteRegexp       = regexp.MustCompile(`^ {0,3}>[ ]?(.*)$`)
+	bulletRegexp      = regexp.MustCompile(`^ {0,3}[-*+][ \t]+(.*)$`)
+	orderedRegexp     = regexp.MustCompile(`^ {0,3}(\d{1,9})[.)][ \t]+(.*)$`)
+	indentRegexp      = regexp.MustCompile(`^(?: {2,}|\t)`)
+	codeSpanRegexp    = regexp.MustCompile("(`+)(.+?)(`+)")
+	autolinkRegexp    = regexp.MustCompile(`<((?:https?://|mailto:)[^\s<>]+)>`)
+	linkRegexp        = regexp.MustCompile(`\[([^\[\]]+)\]\(([^()\s]+)(?:\s+"([^"]*)")?\)`)
+	escapeRegexp      = regexp.MustCompile("\\\\([!-/:-@\\[-`{-~])")
+	strongRegexp      = regexp.MustCompile(`\*\*([^\s*](?:[^*]*[^\s*])?)\*\*|__([^\s_](?:[^_]*[^\s_])?)__`)
+	emphasisRegexp    = regexp.MustCompile(`\*([^\s*](?:[^*]*[^\s*])?)\*|(^|[^\w])_([^\s_](?:[^_]*[^\s_])?)_($|[^\w])`)
+	placeholderRegexp = regexp.MustCompile("\x00(\\d+)\x00")
+	safeURLRegexp     = regexp.MustCompile(`^(?i)(https?:|mailto:|[^:]*$)`)
+)
+
+// ToHTML converts the Markdown source into HTML
+func ToHTML(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\x00", "")
+	return renderBlocks(strings.Split(src, "\n"))
+}
+
+// renderBlocks renders the lines as block elements
+func renderBlocks(lines []string) string {
+	var b strings.Builder
+	var paragraph []string
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			b.WriteString("<p>" + renderInline(strings.Join(paragraph, "\n")) + "</p>")
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case fenceRegexp.MatchString(line):
+			flush()
+			fence := fenceRegexp.FindStringSubmatch(line)[1]
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimLeft(lines[i], " "), fence); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>")
+		case headingRegexp.MatchString(line):
+			flush()
+			m := headingRegexp.FindStringSubmatch(line)
+			b.WriteString(fmt.Sprintf("<h%d>%s</h%d>", len(m[1]), renderInline(m[2]), len(m[1])))
+		case ruleRegexp.MatchString(line):
+			flush()
+			b.WriteString("<hr>")
+		case quoteRegexp.MatchString(line):
+			flush()
+			var quoted []string
+			for ; i < len(lines) && quoteRegexp.MatchString(lines[i]); i++ {
+				quoted = append(quoted, quoteRegexp.FindStringSubmatch(lines[i])[1])
+			}
+			i--
+			b.WriteString("<blockquote>" + renderBlocks(quoted) + "</blockquote>")
+		case bulletRegexp.MatchString(line) || orderedRegexp.MatchString(line):
+			flush()
+			var list string
+			list, i = renderList(lines, i)
+			b.WriteString(list)
+		default:
+			paragraph = append(paragraph, strings.TrimLeft(line, " \t"))
+		}
+	}
+	flush()
+	return b.String()
+}
+
+// renderList renders the list starting at lines[start], and returns the
+// index of its last line
+func renderList(lines []string, start int) (string, int) {
+	ordered := !bulletRegexp.MatchString(lines[start])
+
+	var items [][]string
+	i := start
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		last := len(items) - 1
+		if m := matchListItem(line, ordered); m != nil && (last < 0 || !indentRegexp.MatchString(line)) {
+			items = append(items, []string{m[len(m)-1]})
+			continue
+		}
+
+		switch {
+		case indentRegexp.MatchString(line):
+			items[last] = append(items[last], indentRegexp.ReplaceAllString(line, ""))
+		case strings.TrimSpace(line) == "":
+			// a blank line continues the list only if the next line belongs to it
+			if i+1 < len(lines) && (indentRegexp.MatchString(lines[i+1]) || matchListItem(lines[i+1], ordered) != nil) {
+				items[last] = append(items[last], "")
+				continue
+			}
+			return closeList(items, ordered, lines[start]), i
+		case isBlockStart(line):
+			return closeList(items, ordered, lines[start]), i - 1
+		default:
+			// lazy continuation of the paragraph
+			items[last] = append(items[last], line)
+		}
+	}
+	return closeList(items, ordered, lines[start]), i - 1
+}
+
+func matchListItem(line string, ordered bool) []string {
+	if ordered {
+		return orderedRegexp.FindStringSubmatch(line)
+	}
+	return bulletRegexp.FindStringSubmatch(line)
+}
+
+func isBlockStart(line string) bool {
+	return fenceRegexp.MatchString(line) || headingRegexp.MatchString(line) || ruleRegexp.MatchString(line) ||
+		quoteRegexp.MatchString(line) || bulletRegexp.MatchString(line) || orderedRegexp.MatchString(line)
+}
+
+func closeList(items [][]string, ordered bool, first string) string {
+	var b strings.Builder
+	if ordered {
+		n, _ := strconv.Atoi(orderedRegexp.FindStringSubmatch(first)[1])
+		if n != 1 {
+			b.WriteString(fmt.Sprintf(`<ol start="%d">`, n))
+		} else {
+			b.WriteString("<ol>")
+		}
+	} else {
+		b.WriteString("<ul>")
+	}
+
+	for _, item := range items {
+		content := renderBlocks(item)
+		// tight items are rendered without paragraphs
+		if strings.HasPrefix(content, "<p>") && strings.Count(content, "<p>") == 1 {
+			end := strings.Index(content, "</p>")
+			content = content[len("<p>"):end] + content[end+len("</p>"):]
+		}
+		b.WriteString("<li>" + content + "</li>")
+	}
+
+	if ordered {
+		b.WriteString("</ol>")
+	} else {
+		b.WriteString("</ul>")
+	}
+	return b.String()
+}
+
+// renderInline renders the text of a block with emphasis, code spans and links
+func renderInline(text string) string {
+	var held []string
+	hold := func(s string) string {
+		held = append(held, s)
+		return fmt.Sprintf("\x00%d\x00", len(held)-1)
+	}
+
+	text = codeSpanRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		m := codeSpanRegexp.FindStringSubmatch(s)
+		if m[1] != m[3] {
+			return s
+		}
+		return hold("<code>" + html.EscapeString(strings.TrimSpace(m[2])) + "</code>")
+	})
+	text = escapeRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		return hold(html.EscapeString(s[1:]))
+	})
+	text = autolinkRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		u := autolinkRegexp.FindStringSubmatch(s)[1]
+		return hold(`<a href="` + html.EscapeString(u) + `">` + html.EscapeString(u) + "</a>")
+	})
+	text = linkRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		m := linkRegexp.FindStringSubmatch(s)
+		label := renderEmphasis(html.EscapeString(m[1]))
+		if !safeURLRegexp.MatchString(m[2]) {
+			return hold(label)
+		}
+		a := `<a href="` + html.EscapeString(m[2]) + `"`
+		if m[3] != "" {
+			a += ` title="` + html.EscapeString(m[3]) + `"`
+		}
+		return hold(a + ">" + label + "</a>")
+	})
+
+	text = renderEmphasis(html.EscapeString(text))
+
+	// hard line breaks by two trailing spaces or a backslash
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines)-1; i++ {
+		if strings.HasSuffix(lines[i], "  ") || strings.HasSuffix(lines[i], "\\") {
+			lines[i] = strings.TrimRight(strings.TrimSuffix(lines[i], "\\"), " ") + "<br>"
+		}
+	}
+	text = strings.Join(lines, "\n")
+
+	// held texts can contain the others, e.g. code spans in links
+	for placeholderRegexp.MatchString(text) {
+		text = placeholderRegexp.ReplaceAllStringFunc(text, func(s string) string {
+			n, _ := strconv.Atoi(placeholderRegexp.FindStringSubmatch(s)[1])
+			return held[n]
+		})
+	}
+	return text
+}
+
+// renderEmphasis renders strong and emphasis in the escaped text
+func renderEmphasis(text string) string {
+	text = strongRegexp.ReplaceAllString(text, "<strong>$1$2</strong>")
+	return emphasisRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		m := emphasisRegexp.FindStringSubmatch(s)
+		if m[1] != "" {
+			return "<em>" + m[1] + "</em>"
+		}
+		return m[2] + "<em>" + m[3] + "</em>" + m[4]
+	})
+}
@@ -0,0 +1,54 @@
+package markdown
+
+import (
+	"testing"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			"paragraphs",
+			"Hello **world**, *this* is _Markdown_.\nSame paragraph  \nafter break\n\nNext <b>para</b> & more",
+			"<p>Hello <strong>world</strong>, <em>this</em> is <em>Markdown</em>.\nSame paragraph<br>\nafter break</p><p>Next &lt;b&gt;para&lt;/b&gt; &amp; more</p>",
+		},
+		{
+			"snake case",
+			"keep snake_case_name and 2 * 3 * 4",
+			"<p>keep snake_case_name and 2 * 3 * 4</p>",
+		},
+		{
+			"headings and rules",
+			"# Title\n## Sub ##\n---\ntext",
+			"<h1>Title</h1><h2>Sub</h2><hr><p>text</p>",
+		},
+		{
+			"code",
+			"Run `go test <pkg>` now\n\n```go\nif a < b {\n\treturn\n}\n```",
+			"<p>Run <code>go test &lt;pkg&gt;</code> now</p><pre><code>if a &lt; b {\n\treturn\n}</code></pre>",
+		},
+		{
+			"links",
+			`See [the **guide**](https://example.com/a?b=1&c=2 "Guide"), <https://example.com> and [bad](javascript:alert(1)) \*literal\*`,
+			`<p>See <a href="https://example.com/a?b=1&amp;c=2" title="Guide">the <strong>guide</strong></a>, <a href="https://example.com">https://example.com</a> and [bad](javascript:alert(1)) *literal*</p>`,
+		},
+		{
+			"lists",
+			"- one\n- two\n  - nested\n- three\n\n3. third\n4. fourth",
+			"<ul><li>one</li><li>two<ul><li>nested</li></ul></li><li>three</li></ul><ol start=\"3\"><li>third</li><li>fourth</li></ol>",
+		},
+		{
+			"block quote",
+			"> quoted **text**\n> - item\n\nafter",
+			"<blockquote><p>quoted <strong>text</strong></p><ul><li>item</li></ul></blockquote><p>after</p>",
+		},
+	}
+	for _, test := range tests {
+		if actual := ToHTML(test.input); actual != test.expected {
+			t.Errorf("%s: unexpected html\n got: %q\nwant: %q", test.name, actual, test.expected)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// MobileSDKAuthenticationAnonymous identifies end users of the app anonymously
+	MobileSDKAuthenticationAnonymous = "anonymous"
+	// MobileSDKAuthenticationJWT identifies end users of the app with JWT
+	MobileSDKAuthenticationJWT = "jwt"
+)
+
+// MobileSDKApp is a mobile app integrated with Zendesk with Support SDK.
+// Identifier and ClientIdentifier are used to initialize the SDK in the app.
+type MobileSDKApp struct {
+	ID               int64                 `json:"id,omitempty"`
+	Title            string                `json:"title"`
+	Identifier       string                `json:"identifier,omitempty"`
+	ClientIdentifier string                `json:"client_identifier,omitempty"`
+	BrandID          int64                 `json:"brand_id,omitempty"`
+	Settings         *MobileSDKAppSettings `json:"settings,omitempty"`
+	CreatedAt        *Time                 `json:"created_at,omitempty"`
+	UpdatedAt        *Time                 `json:"updated_at,omitempty"`
+}
+
+// MobileSDKAppSettings is the configuration of the SDK fetched by the app
+type MobileSDKAppSettings struct {
+	// AuthenticationType is MobileSDKAuthenticationAnonymous or MobileSDKAuthenticationJWT
+	AuthenticationType string `json:"authentication_type,omitempty"`
+	// JWTURL is the endpoint of the app backend issuing JWT
+	JWTURL string `json:"jwt_url,omitempty"`
+
+	ConversationsEnabled bool     `json:"conversations_enabled"`
+	ContactUsTags        []string `json:"contact_us_tags,omitempty"`
+
+	HelpCenterEnabled              bool `json:"help_center_enabled"`
+	HelpCenterArticleVotingEnabled bool `json:"help_center_article_voting_enabled"`
+
+	RateMyAppEnabled bool `json:"rate_my_app_enabled"`
+
+	// PushNotificationsType is "webhook" or "urban_airship"
+	PushNotificationsType     string `json:"push_notifications_type,omitempty"`
+	PushNotificationsCallback string `json:"push_notifications_callback,omitempty"`
+}
+
+// MobileSDKAppAPI an interface containing all mobile SDK app related methods
+type MobileSDKAppAPI interface {
+	GetMobileSDKApps(ctx context.Context) ([]MobileSDKApp, error)
+	GetMobileSDKApp(ctx context.Context, appID int64) (MobileSDKApp, error)
+	CreateMobileSDKApp(ctx context.Context, app MobileSDKApp) (MobileSDKApp, error)
+	UpdateMobileSDKApp(ctx context.Context, appID int64, app MobileSDKApp) (MobileSDKApp, error)
+	DeleteMobileSDKApp(ctx context.Context, appID int64) error
+}
+
+// GetMobileSDKApps fetches the mobile SDK apps
+func (z *Client) GetMobileSDKApps(ctx context.Context) ([]MobileSDKApp, error) {
+	var result struct {
+		MobileSDKApps []MobileSDKApp `json:"mobile_sdk_apps"`
+	}
+
+	body, err := z.get(ctx, "/mobile_sdk_apps.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.MobileSDKApps, nil
+}
+
+// GetMobileSDKApp fetches the mobile SDK app with its settings
+func (z *Client) GetMobileSDKApp(ctx context.Context, appID int64) (MobileSDKApp, error) {
+	var result struct {
+		MobileSDKApp MobileSDKApp `json:"mobile_sdk_app"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/mobile_sdk_apps/%d.json", appID))
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+	return result.MobileSDKApp, nil
+}
+
+// CreateMobileSDKApp creates a new mobile SDK app. Identifier and
+// ClientIdentifier of the result are generated by Zendesk.
+func (z *Client) CreateMobileSDKApp(ctx context.Context, app MobileSDKApp) (MobileSDKApp, error) {
+	var data, result struct {
+		MobileSDKApp MobileSDKApp `json:"mobile_sdk_app"`
+	}
+	data.MobileSDKApp = app
+
+	body, err := z.post(ctx, "/mobile_sdk_apps.json", data)
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+	return result.MobileSDKApp, nil
+}
+
+// UpdateMobileSDKApp updates the mobile SDK app. Settings are left as they are if nil.
+func (z *Client) UpdateMobileSDKApp(ctx context.Context, appID int64, app MobileSDKApp) (MobileSDKApp, error) {
+	var data, result struct {
+		MobileSDKApp MobileSDKApp `json:"mobile_sdk_app"`
+	}
+	data.MobileSDKApp = app
+
+	body, err := z.put(ctx, fmt.Sprintf("/mobile_sdk_apps/%d.json", appID), data)
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MobileSDKApp{}, err
+	}
+	return result.MobileSDKApp, nil
+}
+
+// DeleteMobileSDKApp deletes the mobile SDK app
+func (z *Client) DeleteMobileSDKApp(ctx context.Context, appID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/mobile_sdk_apps/%d.json", appID))
+}
@@ -0,0 +1,42 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetMobileSDKApps(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "mobile_sdk_apps.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	apps, err := client.GetMobileSDKApps(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get mobile SDK apps: %s", err)
+	}
+	if len(apps) != 1 || apps[0].Settings == nil || apps[0].Settings.AuthenticationType != MobileSDKAuthenticationJWT {
+		t.Fatalf("unexpected mobile SDK apps %v", apps)
+	}
+}
+
+func TestUpdateMobileSDKApp(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "mobile_sdk_app.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	app, err := client.UpdateMobileSDKApp(ctx, 21, MobileSDKApp{
+		Title: "Example iOS",
+		Settings: &MobileSDKAppSettings{
+			AuthenticationType:             MobileSDKAuthenticationAnonymous,
+			ConversationsEnabled:           true,
+			HelpCenterEnabled:              true,
+			HelpCenterArticleVotingEnabled: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update mobile SDK app: %s", err)
+	}
+	if !app.Settings.HelpCenterArticleVotingEnabled || app.Settings.JWTURL != "" {
+		t.Fatalf("unexpected mobile SDK app %v", app)
+	}
+}
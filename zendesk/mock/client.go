@@ -6,7 +6,9 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	zendesk "github.com/nukosuke/go-zendesk/zendesk"
@@ -65,6 +67,26 @@ func (mr *ClientMockRecorder) AddTicketTags(arg0, arg1, arg2 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketTags", reflect.TypeOf((*Client)(nil).AddTicketTags), arg0, arg1, arg2)
 }
 
+// AddTicketWatchers mocks base method.
+func (m *Client) AddTicketWatchers(arg0 context.Context, arg1 int64, arg2 ...zendesk.Watcher) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddTicketWatchers", varargs...)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTicketWatchers indicates an expected call of AddTicketWatchers.
+func (mr *ClientMockRecorder) AddTicketWatchers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketWatchers", reflect.TypeOf((*Client)(nil).AddTicketWatchers), varargs...)
+}
+
 // AddUserTags mocks base method.
 func (m *Client) AddUserTags(arg0 context.Context, arg1 int64, arg2 []zendesk.Tag) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +102,36 @@ func (mr *ClientMockRecorder) AddUserTags(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*Client)(nil).AddUserTags), arg0, arg1, arg2)
 }
 
+// Capabilities mocks base method.
+func (m *Client) Capabilities(arg0 context.Context) (zendesk.Capabilities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capabilities", arg0)
+	ret0, _ := ret[0].(zendesk.Capabilities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capabilities indicates an expected call of Capabilities.
+func (mr *ClientMockRecorder) Capabilities(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capabilities", reflect.TypeOf((*Client)(nil).Capabilities), arg0)
+}
+
+// CreateArticleLabel mocks base method.
+func (m *Client) CreateArticleLabel(arg0 context.Context, arg1 int64, arg2 string) (zendesk.ArticleLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArticleLabel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ArticleLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArticleLabel indicates an expected call of CreateArticleLabel.
+func (mr *ClientMockRecorder) CreateArticleLabel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleLabel", reflect.TypeOf((*Client)(nil).CreateArticleLabel), arg0, arg1, arg2)
+}
+
 // CreateAutomation mocks base method.
 func (m *Client) CreateAutomation(arg0 context.Context, arg1 zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +162,21 @@ func (mr *ClientMockRecorder) CreateBrand(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrand", reflect.TypeOf((*Client)(nil).CreateBrand), arg0, arg1)
 }
 
+// CreateContentTag mocks base method.
+func (m *Client) CreateContentTag(arg0 context.Context, arg1 zendesk.ContentTag) (zendesk.ContentTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContentTag", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ContentTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContentTag indicates an expected call of CreateContentTag.
+func (mr *ClientMockRecorder) CreateContentTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContentTag", reflect.TypeOf((*Client)(nil).CreateContentTag), arg0, arg1)
+}
+
 // CreateDynamicContentItem mocks base method.
 func (m *Client) CreateDynamicContentItem(arg0 context.Context, arg1 zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +192,36 @@ func (mr *ClientMockRecorder) CreateDynamicContentItem(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDynamicContentItem", reflect.TypeOf((*Client)(nil).CreateDynamicContentItem), arg0, arg1)
 }
 
+// CreateExternalContentRecord mocks base method.
+func (m *Client) CreateExternalContentRecord(arg0 context.Context, arg1 zendesk.ExternalContentRecord) (zendesk.ExternalContentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalContentRecord", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ExternalContentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalContentRecord indicates an expected call of CreateExternalContentRecord.
+func (mr *ClientMockRecorder) CreateExternalContentRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalContentRecord", reflect.TypeOf((*Client)(nil).CreateExternalContentRecord), arg0, arg1)
+}
+
+// CreateExternalContentSource mocks base method.
+func (m *Client) CreateExternalContentSource(arg0 context.Context, arg1 zendesk.ExternalContentSource) (zendesk.ExternalContentSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalContentSource", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ExternalContentSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalContentSource indicates an expected call of CreateExternalContentSource.
+func (mr *ClientMockRecorder) CreateExternalContentSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalContentSource", reflect.TypeOf((*Client)(nil).CreateExternalContentSource), arg0, arg1)
+}
+
 // CreateGroup mocks base method.
 func (m *Client) CreateGroup(arg0 context.Context, arg1 zendesk.Group) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +252,81 @@ func (mr *ClientMockRecorder) CreateMacro(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), arg0, arg1)
 }
 
+// CreateMacroAttachment mocks base method.
+func (m *Client) CreateMacroAttachment(arg0 context.Context, arg1 int64, arg2 string, arg3 io.Reader) (zendesk.MacroAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMacroAttachment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMacroAttachment indicates an expected call of CreateMacroAttachment.
+func (mr *ClientMockRecorder) CreateMacroAttachment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacroAttachment", reflect.TypeOf((*Client)(nil).CreateMacroAttachment), arg0, arg1, arg2, arg3)
+}
+
+// CreateManyTickets mocks base method.
+func (m *Client) CreateManyTickets(arg0 context.Context, arg1 []zendesk.Ticket) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManyTickets", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManyTickets indicates an expected call of CreateManyTickets.
+func (mr *ClientMockRecorder) CreateManyTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyTickets", reflect.TypeOf((*Client)(nil).CreateManyTickets), arg0, arg1)
+}
+
+// CreateMobileSDKApp mocks base method.
+func (m *Client) CreateMobileSDKApp(arg0 context.Context, arg1 zendesk.MobileSDKApp) (zendesk.MobileSDKApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMobileSDKApp", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.MobileSDKApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMobileSDKApp indicates an expected call of CreateMobileSDKApp.
+func (mr *ClientMockRecorder) CreateMobileSDKApp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMobileSDKApp", reflect.TypeOf((*Client)(nil).CreateMobileSDKApp), arg0, arg1)
+}
+
+// CreateOrUpdateManyUsers mocks base method.
+func (m *Client) CreateOrUpdateManyUsers(arg0 context.Context, arg1 []zendesk.User) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyUsers", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateManyUsers indicates an expected call of CreateOrUpdateManyUsers.
+func (mr *ClientMockRecorder) CreateOrUpdateManyUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyUsers", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyUsers), arg0, arg1)
+}
+
+// CreateOrUpdateOrganization mocks base method.
+func (m *Client) CreateOrUpdateOrganization(arg0 context.Context, arg1 zendesk.Organization) (zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateOrganization", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdateOrganization indicates an expected call of CreateOrUpdateOrganization.
+func (mr *ClientMockRecorder) CreateOrUpdateOrganization(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateOrganization", reflect.TypeOf((*Client)(nil).CreateOrUpdateOrganization), arg0, arg1)
+}
+
 // CreateOrUpdateUser mocks base method.
 func (m *Client) CreateOrUpdateUser(arg0 context.Context, arg1 zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +342,22 @@ func (mr *ClientMockRecorder) CreateOrUpdateUser(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUser", reflect.TypeOf((*Client)(nil).CreateOrUpdateUser), arg0, arg1)
 }
 
+// CreateOrUpdateUserByEmail mocks base method.
+func (m *Client) CreateOrUpdateUserByEmail(arg0 context.Context, arg1 zendesk.User) (zendesk.User, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateOrUpdateUserByEmail indicates an expected call of CreateOrUpdateUserByEmail.
+func (mr *ClientMockRecorder) CreateOrUpdateUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUserByEmail", reflect.TypeOf((*Client)(nil).CreateOrUpdateUserByEmail), arg0, arg1)
+}
+
 // CreateOrganization mocks base method.
 func (m *Client) CreateOrganization(arg0 context.Context, arg1 zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +388,36 @@ func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
 }
 
+// CreatePermissionGroup mocks base method.
+func (m *Client) CreatePermissionGroup(arg0 context.Context, arg1 zendesk.PermissionGroup) (zendesk.PermissionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermissionGroup", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePermissionGroup indicates an expected call of CreatePermissionGroup.
+func (mr *ClientMockRecorder) CreatePermissionGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermissionGroup", reflect.TypeOf((*Client)(nil).CreatePermissionGroup), arg0, arg1)
+}
+
+// CreateRecipientAddress mocks base method.
+func (m *Client) CreateRecipientAddress(arg0 context.Context, arg1 zendesk.RecipientAddress) (zendesk.RecipientAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecipientAddress", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.RecipientAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecipientAddress indicates an expected call of CreateRecipientAddress.
+func (mr *ClientMockRecorder) CreateRecipientAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecipientAddress", reflect.TypeOf((*Client)(nil).CreateRecipientAddress), arg0, arg1)
+}
+
 // CreateSLAPolicy mocks base method.
 func (m *Client) CreateSLAPolicy(arg0 context.Context, arg1 zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +433,21 @@ func (mr *ClientMockRecorder) CreateSLAPolicy(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), arg0, arg1)
 }
 
+// CreateSharingAgreement mocks base method.
+func (m *Client) CreateSharingAgreement(arg0 context.Context, arg1 zendesk.SharingAgreement) (zendesk.SharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSharingAgreement", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.SharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSharingAgreement indicates an expected call of CreateSharingAgreement.
+func (mr *ClientMockRecorder) CreateSharingAgreement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharingAgreement", reflect.TypeOf((*Client)(nil).CreateSharingAgreement), arg0, arg1)
+}
+
 // CreateTarget mocks base method.
 func (m *Client) CreateTarget(arg0 context.Context, arg1 zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +523,37 @@ func (mr *ClientMockRecorder) CreateTicketForm(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketForm", reflect.TypeOf((*Client)(nil).CreateTicketForm), arg0, arg1)
 }
 
+// CreateTicketFromTweet mocks base method.
+func (m *Client) CreateTicketFromTweet(arg0 context.Context, arg1, arg2 int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketFromTweet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketFromTweet indicates an expected call of CreateTicketFromTweet.
+func (mr *ClientMockRecorder) CreateTicketFromTweet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketFromTweet", reflect.TypeOf((*Client)(nil).CreateTicketFromTweet), arg0, arg1, arg2)
+}
+
+// CreateTicketIfNotExists mocks base method.
+func (m *Client) CreateTicketIfNotExists(arg0 context.Context, arg1 zendesk.Ticket, arg2 zendesk.TicketMatcher) (zendesk.Ticket, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketIfNotExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTicketIfNotExists indicates an expected call of CreateTicketIfNotExists.
+func (mr *ClientMockRecorder) CreateTicketIfNotExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketIfNotExists", reflect.TypeOf((*Client)(nil).CreateTicketIfNotExists), arg0, arg1, arg2)
+}
+
 // CreateTrigger mocks base method.
 func (m *Client) CreateTrigger(arg0 context.Context, arg1 zendesk.Trigger) (zendesk.Trigger, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +584,36 @@ func (mr *ClientMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Client)(nil).CreateUser), arg0, arg1)
 }
 
+// CreateUserSegment mocks base method.
+func (m *Client) CreateUserSegment(arg0 context.Context, arg1 zendesk.UserSegment) (zendesk.UserSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserSegment", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.UserSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserSegment indicates an expected call of CreateUserSegment.
+func (mr *ClientMockRecorder) CreateUserSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserSegment", reflect.TypeOf((*Client)(nil).CreateUserSegment), arg0, arg1)
+}
+
+// CreateVoiceTicket mocks base method.
+func (m *Client) CreateVoiceTicket(arg0 context.Context, arg1 zendesk.Ticket) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVoiceTicket", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVoiceTicket indicates an expected call of CreateVoiceTicket.
+func (mr *ClientMockRecorder) CreateVoiceTicket(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVoiceTicket", reflect.TypeOf((*Client)(nil).CreateVoiceTicket), arg0, arg1)
+}
+
 // CreateWebhook mocks base method.
 func (m *Client) CreateWebhook(arg0 context.Context, arg1 *zendesk.Webhook) (*zendesk.Webhook, error) {
 	m.ctrl.T.Helper()
@@ -349,6 +643,20 @@ func (mr *ClientMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Client)(nil).Delete), arg0, arg1)
 }
 
+// DeleteArticleLabel mocks base method.
+func (m *Client) DeleteArticleLabel(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleLabel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleLabel indicates an expected call of DeleteArticleLabel.
+func (mr *ClientMockRecorder) DeleteArticleLabel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleLabel", reflect.TypeOf((*Client)(nil).DeleteArticleLabel), arg0, arg1, arg2)
+}
+
 // DeleteAutomation mocks base method.
 func (m *Client) DeleteAutomation(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -377,6 +685,20 @@ func (mr *ClientMockRecorder) DeleteBrand(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), arg0, arg1)
 }
 
+// DeleteContentTag mocks base method.
+func (m *Client) DeleteContentTag(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContentTag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContentTag indicates an expected call of DeleteContentTag.
+func (mr *ClientMockRecorder) DeleteContentTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContentTag", reflect.TypeOf((*Client)(nil).DeleteContentTag), arg0, arg1)
+}
+
 // DeleteDynamicContentItem mocks base method.
 func (m *Client) DeleteDynamicContentItem(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -391,6 +713,34 @@ func (mr *ClientMockRecorder) DeleteDynamicContentItem(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicContentItem", reflect.TypeOf((*Client)(nil).DeleteDynamicContentItem), arg0, arg1)
 }
 
+// DeleteExternalContentRecord mocks base method.
+func (m *Client) DeleteExternalContentRecord(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalContentRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExternalContentRecord indicates an expected call of DeleteExternalContentRecord.
+func (mr *ClientMockRecorder) DeleteExternalContentRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalContentRecord", reflect.TypeOf((*Client)(nil).DeleteExternalContentRecord), arg0, arg1)
+}
+
+// DeleteExternalContentSource mocks base method.
+func (m *Client) DeleteExternalContentSource(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExternalContentSource", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExternalContentSource indicates an expected call of DeleteExternalContentSource.
+func (mr *ClientMockRecorder) DeleteExternalContentSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExternalContentSource", reflect.TypeOf((*Client)(nil).DeleteExternalContentSource), arg0, arg1)
+}
+
 // DeleteGroup mocks base method.
 func (m *Client) DeleteGroup(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -419,6 +769,20 @@ func (mr *ClientMockRecorder) DeleteMacro(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMacro", reflect.TypeOf((*Client)(nil).DeleteMacro), arg0, arg1)
 }
 
+// DeleteMobileSDKApp mocks base method.
+func (m *Client) DeleteMobileSDKApp(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMobileSDKApp", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMobileSDKApp indicates an expected call of DeleteMobileSDKApp.
+func (mr *ClientMockRecorder) DeleteMobileSDKApp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMobileSDKApp", reflect.TypeOf((*Client)(nil).DeleteMobileSDKApp), arg0, arg1)
+}
+
 // DeleteOrganization mocks base method.
 func (m *Client) DeleteOrganization(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -433,6 +797,48 @@ func (mr *ClientMockRecorder) DeleteOrganization(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), arg0, arg1)
 }
 
+// DeletePermissionGroup mocks base method.
+func (m *Client) DeletePermissionGroup(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermissionGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePermissionGroup indicates an expected call of DeletePermissionGroup.
+func (mr *ClientMockRecorder) DeletePermissionGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermissionGroup", reflect.TypeOf((*Client)(nil).DeletePermissionGroup), arg0, arg1)
+}
+
+// DeletePushNotificationDevices mocks base method.
+func (m *Client) DeletePushNotificationDevices(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePushNotificationDevices", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePushNotificationDevices indicates an expected call of DeletePushNotificationDevices.
+func (mr *ClientMockRecorder) DeletePushNotificationDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePushNotificationDevices", reflect.TypeOf((*Client)(nil).DeletePushNotificationDevices), arg0, arg1)
+}
+
+// DeleteRecipientAddress mocks base method.
+func (m *Client) DeleteRecipientAddress(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecipientAddress", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecipientAddress indicates an expected call of DeleteRecipientAddress.
+func (mr *ClientMockRecorder) DeleteRecipientAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipientAddress", reflect.TypeOf((*Client)(nil).DeleteRecipientAddress), arg0, arg1)
+}
+
 // DeleteSLAPolicy mocks base method.
 func (m *Client) DeleteSLAPolicy(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -447,6 +853,20 @@ func (mr *ClientMockRecorder) DeleteSLAPolicy(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), arg0, arg1)
 }
 
+// DeleteSharingAgreement mocks base method.
+func (m *Client) DeleteSharingAgreement(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSharingAgreement", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSharingAgreement indicates an expected call of DeleteSharingAgreement.
+func (mr *ClientMockRecorder) DeleteSharingAgreement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSharingAgreement", reflect.TypeOf((*Client)(nil).DeleteSharingAgreement), arg0, arg1)
+}
+
 // DeleteTarget mocks base method.
 func (m *Client) DeleteTarget(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -531,6 +951,35 @@ func (mr *ClientMockRecorder) DeleteUpload(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), arg0, arg1)
 }
 
+// DeleteUser mocks base method.
+func (m *Client) DeleteUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *ClientMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Client)(nil).DeleteUser), arg0, arg1)
+}
+
+// DeleteUserSegment mocks base method.
+func (m *Client) DeleteUserSegment(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSegment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSegment indicates an expected call of DeleteUserSegment.
+func (mr *ClientMockRecorder) DeleteUserSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSegment", reflect.TypeOf((*Client)(nil).DeleteUserSegment), arg0, arg1)
+}
+
 // DeleteWebhook mocks base method.
 func (m *Client) DeleteWebhook(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -545,23 +994,172 @@ func (mr *ClientMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), arg0, arg1)
 }
 
-// Get mocks base method.
-func (m *Client) Get(arg0 context.Context, arg1 string) ([]byte, error) {
+// DownloadAttachment mocks base method.
+func (m *Client) DownloadAttachment(arg0 context.Context, arg1 zendesk.Attachment, arg2 io.Writer) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "DownloadAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *ClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *ClientMockRecorder) DownloadAttachment(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*Client)(nil).DownloadAttachment), arg0, arg1, arg2)
 }
 
-// GetAllTicketAudits mocks base method.
-func (m *Client) GetAllTicketAudits(arg0 context.Context, arg1 zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
+// EraseUser mocks base method.
+func (m *Client) EraseUser(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EraseUser indicates an expected call of EraseUser.
+func (mr *ClientMockRecorder) EraseUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseUser", reflect.TypeOf((*Client)(nil).EraseUser), arg0, arg1)
+}
+
+// ExportTicket mocks base method.
+func (m *Client) ExportTicket(arg0 context.Context, arg1 int64, arg2 io.Writer) (zendesk.TicketBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.TicketBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportTicket indicates an expected call of ExportTicket.
+func (mr *ClientMockRecorder) ExportTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTicket", reflect.TypeOf((*Client)(nil).ExportTicket), arg0, arg1, arg2)
+}
+
+// FindDuplicateTicket mocks base method.
+func (m *Client) FindDuplicateTicket(arg0 context.Context, arg1 zendesk.Ticket, arg2 zendesk.TicketMatcher) (*zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateTicket indicates an expected call of FindDuplicateTicket.
+func (mr *ClientMockRecorder) FindDuplicateTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateTicket", reflect.TypeOf((*Client)(nil).FindDuplicateTicket), arg0, arg1, arg2)
+}
+
+// FindTicketByEmailThread mocks base method.
+func (m *Client) FindTicketByEmailThread(arg0 context.Context, arg1 zendesk.EmailThreadHeaders) (*zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTicketByEmailThread", arg0, arg1)
+	ret0, _ := ret[0].(*zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTicketByEmailThread indicates an expected call of FindTicketByEmailThread.
+func (mr *ClientMockRecorder) FindTicketByEmailThread(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTicketByEmailThread", reflect.TypeOf((*Client)(nil).FindTicketByEmailThread), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *Client) Get(arg0 context.Context, arg1 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *ClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *Client) GetAccount(arg0 context.Context) (zendesk.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0)
+	ret0, _ := ret[0].(zendesk.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *ClientMockRecorder) GetAccount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*Client)(nil).GetAccount), arg0)
+}
+
+// GetAccountSettings mocks base method.
+func (m *Client) GetAccountSettings(arg0 context.Context) (zendesk.AccountSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSettings", arg0)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSettings indicates an expected call of GetAccountSettings.
+func (mr *ClientMockRecorder) GetAccountSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettings", reflect.TypeOf((*Client)(nil).GetAccountSettings), arg0)
+}
+
+// GetAccountSubscription mocks base method.
+func (m *Client) GetAccountSubscription(arg0 context.Context) (zendesk.AccountSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSubscription", arg0)
+	ret0, _ := ret[0].(zendesk.AccountSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSubscription indicates an expected call of GetAccountSubscription.
+func (mr *ClientMockRecorder) GetAccountSubscription(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSubscription", reflect.TypeOf((*Client)(nil).GetAccountSubscription), arg0)
+}
+
+// GetActiveFeatures mocks base method.
+func (m *Client) GetActiveFeatures(arg0 context.Context) (zendesk.ActiveFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveFeatures", arg0)
+	ret0, _ := ret[0].(zendesk.ActiveFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveFeatures indicates an expected call of GetActiveFeatures.
+func (mr *ClientMockRecorder) GetActiveFeatures(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveFeatures", reflect.TypeOf((*Client)(nil).GetActiveFeatures), arg0)
+}
+
+// GetAgentAvailability mocks base method.
+func (m *Client) GetAgentAvailability(arg0 context.Context, arg1 int64) (zendesk.AgentAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailability", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.AgentAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentAvailability indicates an expected call of GetAgentAvailability.
+func (mr *ClientMockRecorder) GetAgentAvailability(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailability", reflect.TypeOf((*Client)(nil).GetAgentAvailability), arg0, arg1)
+}
+
+// GetAllTicketAudits mocks base method.
+func (m *Client) GetAllTicketAudits(arg0 context.Context, arg1 zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllTicketAudits", arg0, arg1)
 	ret0, _ := ret[0].([]zendesk.TicketAudit)
@@ -576,6 +1174,114 @@ func (mr *ClientMockRecorder) GetAllTicketAudits(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), arg0, arg1)
 }
 
+// GetAnswerBotArticleSuggestions mocks base method.
+func (m *Client) GetAnswerBotArticleSuggestions(arg0 context.Context, arg1 zendesk.AnswerBotQuery) (zendesk.AnswerBotDeflection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnswerBotArticleSuggestions", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.AnswerBotDeflection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnswerBotArticleSuggestions indicates an expected call of GetAnswerBotArticleSuggestions.
+func (mr *ClientMockRecorder) GetAnswerBotArticleSuggestions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnswerBotArticleSuggestions", reflect.TypeOf((*Client)(nil).GetAnswerBotArticleSuggestions), arg0, arg1)
+}
+
+// GetArticle mocks base method.
+func (m *Client) GetArticle(arg0 context.Context, arg1 int64) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticle", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticle indicates an expected call of GetArticle.
+func (mr *ClientMockRecorder) GetArticle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticle", reflect.TypeOf((*Client)(nil).GetArticle), arg0, arg1)
+}
+
+// GetArticleLabels mocks base method.
+func (m *Client) GetArticleLabels(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.ArticleLabel, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabels", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ArticleLabel)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticleLabels indicates an expected call of GetArticleLabels.
+func (mr *ClientMockRecorder) GetArticleLabels(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabels", reflect.TypeOf((*Client)(nil).GetArticleLabels), arg0, arg1)
+}
+
+// GetArticleLabelsByArticle mocks base method.
+func (m *Client) GetArticleLabelsByArticle(arg0 context.Context, arg1 int64) ([]zendesk.ArticleLabel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleLabelsByArticle", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ArticleLabel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleLabelsByArticle indicates an expected call of GetArticleLabelsByArticle.
+func (mr *ClientMockRecorder) GetArticleLabelsByArticle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleLabelsByArticle", reflect.TypeOf((*Client)(nil).GetArticleLabelsByArticle), arg0, arg1)
+}
+
+// GetArticleRevision mocks base method.
+func (m *Client) GetArticleRevision(arg0 context.Context, arg1, arg2 int64) (zendesk.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleRevision", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleRevision indicates an expected call of GetArticleRevision.
+func (mr *ClientMockRecorder) GetArticleRevision(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleRevision", reflect.TypeOf((*Client)(nil).GetArticleRevision), arg0, arg1, arg2)
+}
+
+// GetArticleRevisions mocks base method.
+func (m *Client) GetArticleRevisions(arg0 context.Context, arg1 int64, arg2 *zendesk.ArticleRevisionListOptions) ([]zendesk.ArticleRevision, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.ArticleRevision)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticleRevisions indicates an expected call of GetArticleRevisions.
+func (mr *ClientMockRecorder) GetArticleRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleRevisions", reflect.TypeOf((*Client)(nil).GetArticleRevisions), arg0, arg1, arg2)
+}
+
+// GetArticles mocks base method.
+func (m *Client) GetArticles(arg0 context.Context, arg1 *zendesk.ArticleListOptions) ([]zendesk.Article, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticles", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetArticles indicates an expected call of GetArticles.
+func (mr *ClientMockRecorder) GetArticles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticles", reflect.TypeOf((*Client)(nil).GetArticles), arg0, arg1)
+}
+
 // GetAttachment mocks base method.
 func (m *Client) GetAttachment(arg0 context.Context, arg1 int64) (zendesk.Attachment, error) {
 	m.ctrl.T.Helper()
@@ -637,6 +1343,113 @@ func (mr *ClientMockRecorder) GetBrand(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), arg0, arg1)
 }
 
+// GetBrands mocks base method.
+func (m *Client) GetBrands(arg0 context.Context, arg1 *zendesk.BrandListOptions) ([]zendesk.Brand, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrands", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Brand)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBrands indicates an expected call of GetBrands.
+func (mr *ClientMockRecorder) GetBrands(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrands", reflect.TypeOf((*Client)(nil).GetBrands), arg0, arg1)
+}
+
+// GetCCsFollowersSettings mocks base method.
+func (m *Client) GetCCsFollowersSettings(arg0 context.Context) (zendesk.CCsFollowersSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCCsFollowersSettings", arg0)
+	ret0, _ := ret[0].(zendesk.CCsFollowersSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCCsFollowersSettings indicates an expected call of GetCCsFollowersSettings.
+func (mr *ClientMockRecorder) GetCCsFollowersSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCCsFollowersSettings", reflect.TypeOf((*Client)(nil).GetCCsFollowersSettings), arg0)
+}
+
+// GetChannelIntegration mocks base method.
+func (m *Client) GetChannelIntegration(arg0 context.Context, arg1 string) (zendesk.ChannelIntegration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelIntegration", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ChannelIntegration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelIntegration indicates an expected call of GetChannelIntegration.
+func (mr *ClientMockRecorder) GetChannelIntegration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelIntegration", reflect.TypeOf((*Client)(nil).GetChannelIntegration), arg0, arg1)
+}
+
+// GetChannelIntegrations mocks base method.
+func (m *Client) GetChannelIntegrations(arg0 context.Context, arg1 *zendesk.ChannelIntegrationListOptions) ([]zendesk.ChannelIntegration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelIntegrations", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ChannelIntegration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelIntegrations indicates an expected call of GetChannelIntegrations.
+func (mr *ClientMockRecorder) GetChannelIntegrations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelIntegrations", reflect.TypeOf((*Client)(nil).GetChannelIntegrations), arg0, arg1)
+}
+
+// GetComplianceDeletionStatuses mocks base method.
+func (m *Client) GetComplianceDeletionStatuses(arg0 context.Context, arg1 int64, arg2 string) ([]zendesk.ComplianceDeletionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDeletionStatuses", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.ComplianceDeletionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDeletionStatuses indicates an expected call of GetComplianceDeletionStatuses.
+func (mr *ClientMockRecorder) GetComplianceDeletionStatuses(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDeletionStatuses", reflect.TypeOf((*Client)(nil).GetComplianceDeletionStatuses), arg0, arg1, arg2)
+}
+
+// GetContentTag mocks base method.
+func (m *Client) GetContentTag(arg0 context.Context, arg1 string) (zendesk.ContentTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentTag", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ContentTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentTag indicates an expected call of GetContentTag.
+func (mr *ClientMockRecorder) GetContentTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentTag", reflect.TypeOf((*Client)(nil).GetContentTag), arg0, arg1)
+}
+
+// GetContentTags mocks base method.
+func (m *Client) GetContentTags(arg0 context.Context, arg1 *zendesk.ContentTagListOptions) ([]zendesk.ContentTag, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentTags", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ContentTag)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetContentTags indicates an expected call of GetContentTags.
+func (mr *ClientMockRecorder) GetContentTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentTags", reflect.TypeOf((*Client)(nil).GetContentTags), arg0, arg1)
+}
+
 // GetCustomRoles mocks base method.
 func (m *Client) GetCustomRoles(arg0 context.Context) ([]zendesk.CustomRole, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +1496,113 @@ func (mr *ClientMockRecorder) GetDynamicContentItems(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), arg0)
 }
 
+// GetExternalContentRecord mocks base method.
+func (m *Client) GetExternalContentRecord(arg0 context.Context, arg1 string) (zendesk.ExternalContentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalContentRecord", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ExternalContentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalContentRecord indicates an expected call of GetExternalContentRecord.
+func (mr *ClientMockRecorder) GetExternalContentRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalContentRecord", reflect.TypeOf((*Client)(nil).GetExternalContentRecord), arg0, arg1)
+}
+
+// GetExternalContentRecords mocks base method.
+func (m *Client) GetExternalContentRecords(arg0 context.Context, arg1 *zendesk.CursorPagination) ([]zendesk.ExternalContentRecord, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalContentRecords", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ExternalContentRecord)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExternalContentRecords indicates an expected call of GetExternalContentRecords.
+func (mr *ClientMockRecorder) GetExternalContentRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalContentRecords", reflect.TypeOf((*Client)(nil).GetExternalContentRecords), arg0, arg1)
+}
+
+// GetExternalContentSource mocks base method.
+func (m *Client) GetExternalContentSource(arg0 context.Context, arg1 string) (zendesk.ExternalContentSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalContentSource", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ExternalContentSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalContentSource indicates an expected call of GetExternalContentSource.
+func (mr *ClientMockRecorder) GetExternalContentSource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalContentSource", reflect.TypeOf((*Client)(nil).GetExternalContentSource), arg0, arg1)
+}
+
+// GetExternalContentSources mocks base method.
+func (m *Client) GetExternalContentSources(arg0 context.Context, arg1 *zendesk.CursorPagination) ([]zendesk.ExternalContentSource, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalContentSources", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.ExternalContentSource)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExternalContentSources indicates an expected call of GetExternalContentSources.
+func (mr *ClientMockRecorder) GetExternalContentSources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalContentSources", reflect.TypeOf((*Client)(nil).GetExternalContentSources), arg0, arg1)
+}
+
+// GetFacebookPage mocks base method.
+func (m *Client) GetFacebookPage(arg0 context.Context, arg1 int64) (zendesk.FacebookPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacebookPage", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.FacebookPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacebookPage indicates an expected call of GetFacebookPage.
+func (mr *ClientMockRecorder) GetFacebookPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacebookPage", reflect.TypeOf((*Client)(nil).GetFacebookPage), arg0, arg1)
+}
+
+// GetFacebookPages mocks base method.
+func (m *Client) GetFacebookPages(arg0 context.Context) ([]zendesk.FacebookPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFacebookPages", arg0)
+	ret0, _ := ret[0].([]zendesk.FacebookPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFacebookPages indicates an expected call of GetFacebookPages.
+func (mr *ClientMockRecorder) GetFacebookPages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFacebookPages", reflect.TypeOf((*Client)(nil).GetFacebookPages), arg0)
+}
+
+// GetFlaggedTicketAudits mocks base method.
+func (m *Client) GetFlaggedTicketAudits(arg0 context.Context, arg1 int64) ([]zendesk.TicketAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlaggedTicketAudits", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlaggedTicketAudits indicates an expected call of GetFlaggedTicketAudits.
+func (mr *ClientMockRecorder) GetFlaggedTicketAudits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlaggedTicketAudits", reflect.TypeOf((*Client)(nil).GetFlaggedTicketAudits), arg0, arg1)
+}
+
 // GetGroup mocks base method.
 func (m *Client) GetGroup(arg0 context.Context, arg1 int64) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
@@ -730,6 +1650,53 @@ func (mr *ClientMockRecorder) GetGroups(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), arg0, arg1)
 }
 
+// GetIncrementalTicketEvents mocks base method.
+func (m *Client) GetIncrementalTicketEvents(arg0 context.Context, arg1 *zendesk.IncrementalTicketEventsOptions) ([]zendesk.IncrementalTicketEvent, zendesk.IncrementalExportPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketEvents", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.IncrementalTicketEvent)
+	ret1, _ := ret[1].(zendesk.IncrementalExportPage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIncrementalTicketEvents indicates an expected call of GetIncrementalTicketEvents.
+func (mr *ClientMockRecorder) GetIncrementalTicketEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketEvents", reflect.TypeOf((*Client)(nil).GetIncrementalTicketEvents), arg0, arg1)
+}
+
+// GetIncrementalTicketMetricEvents mocks base method.
+func (m *Client) GetIncrementalTicketMetricEvents(arg0 context.Context, arg1 *zendesk.IncrementalTicketMetricEventsOptions) ([]zendesk.TicketMetricEvent, zendesk.IncrementalExportPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketMetricEvents", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.TicketMetricEvent)
+	ret1, _ := ret[1].(zendesk.IncrementalExportPage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIncrementalTicketMetricEvents indicates an expected call of GetIncrementalTicketMetricEvents.
+func (mr *ClientMockRecorder) GetIncrementalTicketMetricEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketMetricEvents", reflect.TypeOf((*Client)(nil).GetIncrementalTicketMetricEvents), arg0, arg1)
+}
+
+// GetJobStatus mocks base method.
+func (m *Client) GetJobStatus(arg0 context.Context, arg1 string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *ClientMockRecorder) GetJobStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*Client)(nil).GetJobStatus), arg0, arg1)
+}
+
 // GetLocales mocks base method.
 func (m *Client) GetLocales(arg0 context.Context) ([]zendesk.Locale, error) {
 	m.ctrl.T.Helper()
@@ -760,6 +1727,81 @@ func (mr *ClientMockRecorder) GetMacro(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), arg0, arg1)
 }
 
+// GetMacroActions mocks base method.
+func (m *Client) GetMacroActions(arg0 context.Context) ([]zendesk.MacroActionDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroActions", arg0)
+	ret0, _ := ret[0].([]zendesk.MacroActionDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroActions indicates an expected call of GetMacroActions.
+func (mr *ClientMockRecorder) GetMacroActions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroActions", reflect.TypeOf((*Client)(nil).GetMacroActions), arg0)
+}
+
+// GetMacroAttachments mocks base method.
+func (m *Client) GetMacroAttachments(arg0 context.Context, arg1 int64) ([]zendesk.MacroAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroAttachments", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroAttachments indicates an expected call of GetMacroAttachments.
+func (mr *ClientMockRecorder) GetMacroAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroAttachments", reflect.TypeOf((*Client)(nil).GetMacroAttachments), arg0, arg1)
+}
+
+// GetMacroCategories mocks base method.
+func (m *Client) GetMacroCategories(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroCategories", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroCategories indicates an expected call of GetMacroCategories.
+func (mr *ClientMockRecorder) GetMacroCategories(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroCategories", reflect.TypeOf((*Client)(nil).GetMacroCategories), arg0)
+}
+
+// GetMacroChanges mocks base method.
+func (m *Client) GetMacroChanges(arg0 context.Context, arg1, arg2 int64) (zendesk.MacroResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroChanges", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.MacroResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroChanges indicates an expected call of GetMacroChanges.
+func (mr *ClientMockRecorder) GetMacroChanges(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroChanges", reflect.TypeOf((*Client)(nil).GetMacroChanges), arg0, arg1, arg2)
+}
+
+// GetMacroReplica mocks base method.
+func (m *Client) GetMacroReplica(arg0 context.Context, arg1, arg2 int64) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacroReplica", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacroReplica indicates an expected call of GetMacroReplica.
+func (mr *ClientMockRecorder) GetMacroReplica(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacroReplica", reflect.TypeOf((*Client)(nil).GetMacroReplica), arg0, arg1, arg2)
+}
+
 // GetMacros mocks base method.
 func (m *Client) GetMacros(arg0 context.Context, arg1 *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -792,6 +1834,66 @@ func (mr *ClientMockRecorder) GetManyUsers(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), arg0, arg1)
 }
 
+// GetMobileSDKApp mocks base method.
+func (m *Client) GetMobileSDKApp(arg0 context.Context, arg1 int64) (zendesk.MobileSDKApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMobileSDKApp", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.MobileSDKApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMobileSDKApp indicates an expected call of GetMobileSDKApp.
+func (mr *ClientMockRecorder) GetMobileSDKApp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMobileSDKApp", reflect.TypeOf((*Client)(nil).GetMobileSDKApp), arg0, arg1)
+}
+
+// GetMobileSDKApps mocks base method.
+func (m *Client) GetMobileSDKApps(arg0 context.Context) ([]zendesk.MobileSDKApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMobileSDKApps", arg0)
+	ret0, _ := ret[0].([]zendesk.MobileSDKApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMobileSDKApps indicates an expected call of GetMobileSDKApps.
+func (mr *ClientMockRecorder) GetMobileSDKApps(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMobileSDKApps", reflect.TypeOf((*Client)(nil).GetMobileSDKApps), arg0)
+}
+
+// GetMonitoredTwitterHandle mocks base method.
+func (m *Client) GetMonitoredTwitterHandle(arg0 context.Context, arg1 int64) (zendesk.MonitoredTwitterHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitoredTwitterHandle", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.MonitoredTwitterHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitoredTwitterHandle indicates an expected call of GetMonitoredTwitterHandle.
+func (mr *ClientMockRecorder) GetMonitoredTwitterHandle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoredTwitterHandle", reflect.TypeOf((*Client)(nil).GetMonitoredTwitterHandle), arg0, arg1)
+}
+
+// GetMonitoredTwitterHandles mocks base method.
+func (m *Client) GetMonitoredTwitterHandles(arg0 context.Context) ([]zendesk.MonitoredTwitterHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitoredTwitterHandles", arg0)
+	ret0, _ := ret[0].([]zendesk.MonitoredTwitterHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitoredTwitterHandles indicates an expected call of GetMonitoredTwitterHandles.
+func (mr *ClientMockRecorder) GetMonitoredTwitterHandles(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoredTwitterHandles", reflect.TypeOf((*Client)(nil).GetMonitoredTwitterHandles), arg0)
+}
+
 // GetMultipleTickets mocks base method.
 func (m *Client) GetMultipleTickets(arg0 context.Context, arg1 []int64) ([]zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -819,70 +1921,222 @@ func (m *Client) GetOrganization(arg0 context.Context, arg1 int64) (zendesk.Orga
 // GetOrganization indicates an expected call of GetOrganization.
 func (mr *ClientMockRecorder) GetOrganization(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), arg0, arg1)
+}
+
+// GetOrganizationByExternalID mocks base method.
+func (m *Client) GetOrganizationByExternalID(arg0 context.Context, arg1 string) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
+func (mr *ClientMockRecorder) GetOrganizationByExternalID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), arg0, arg1)
+}
+
+// GetOrganizationMemberships mocks base method.
+func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
+func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+}
+
+// GetOrganizationMerge mocks base method.
+func (m *Client) GetOrganizationMerge(arg0 context.Context, arg1 string) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerge", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerge indicates an expected call of GetOrganizationMerge.
+func (mr *ClientMockRecorder) GetOrganizationMerge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerge", reflect.TypeOf((*Client)(nil).GetOrganizationMerge), arg0, arg1)
+}
+
+// GetOrganizationMerges mocks base method.
+func (m *Client) GetOrganizationMerges(arg0 context.Context, arg1 int64) ([]zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerges", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerges indicates an expected call of GetOrganizationMerges.
+func (mr *ClientMockRecorder) GetOrganizationMerges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerges", reflect.TypeOf((*Client)(nil).GetOrganizationMerges), arg0, arg1)
+}
+
+// GetOrganizationRelated mocks base method.
+func (m *Client) GetOrganizationRelated(arg0 context.Context, arg1 int64) (zendesk.OrganizationRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRelated", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationRelated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRelated indicates an expected call of GetOrganizationRelated.
+func (mr *ClientMockRecorder) GetOrganizationRelated(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRelated", reflect.TypeOf((*Client)(nil).GetOrganizationRelated), arg0, arg1)
+}
+
+// GetOrganizationTags mocks base method.
+func (m *Client) GetOrganizationTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTags", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationTags indicates an expected call of GetOrganizationTags.
+func (mr *ClientMockRecorder) GetOrganizationTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), arg0, arg1)
+}
+
+// GetOrganizations mocks base method.
+func (m *Client) GetOrganizations(arg0 context.Context, arg1 *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizations", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizations indicates an expected call of GetOrganizations.
+func (mr *ClientMockRecorder) GetOrganizations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), arg0, arg1)
+}
+
+// GetOrganizationsByExternalIDs mocks base method.
+func (m *Client) GetOrganizationsByExternalIDs(arg0 context.Context, arg1 []string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsByExternalIDs", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationsByExternalIDs indicates an expected call of GetOrganizationsByExternalIDs.
+func (mr *ClientMockRecorder) GetOrganizationsByExternalIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsByExternalIDs", reflect.TypeOf((*Client)(nil).GetOrganizationsByExternalIDs), arg0, arg1)
+}
+
+// GetOrganizationsByIDs mocks base method.
+func (m *Client) GetOrganizationsByIDs(arg0 context.Context, arg1 []int64, arg2 int) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsByIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationsByIDs indicates an expected call of GetOrganizationsByIDs.
+func (mr *ClientMockRecorder) GetOrganizationsByIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsByIDs", reflect.TypeOf((*Client)(nil).GetOrganizationsByIDs), arg0, arg1, arg2)
 }
 
-// GetOrganizationByExternalID mocks base method.
-func (m *Client) GetOrganizationByExternalID(arg0 context.Context, arg1 string) ([]zendesk.Organization, zendesk.Page, error) {
+// GetPermissionGroup mocks base method.
+func (m *Client) GetPermissionGroup(arg0 context.Context, arg1 int64) (zendesk.PermissionGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetPermissionGroup", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
-func (mr *ClientMockRecorder) GetOrganizationByExternalID(arg0, arg1 interface{}) *gomock.Call {
+// GetPermissionGroup indicates an expected call of GetPermissionGroup.
+func (mr *ClientMockRecorder) GetPermissionGroup(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionGroup", reflect.TypeOf((*Client)(nil).GetPermissionGroup), arg0, arg1)
 }
 
-// GetOrganizationMemberships mocks base method.
-func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// GetPermissionGroups mocks base method.
+func (m *Client) GetPermissionGroups(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.PermissionGroup, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "GetPermissionGroups", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.PermissionGroup)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
-func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 interface{}) *gomock.Call {
+// GetPermissionGroups indicates an expected call of GetPermissionGroups.
+func (mr *ClientMockRecorder) GetPermissionGroups(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionGroups", reflect.TypeOf((*Client)(nil).GetPermissionGroups), arg0, arg1)
 }
 
-// GetOrganizationTags mocks base method.
-func (m *Client) GetOrganizationTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
+// GetRecipientAddress mocks base method.
+func (m *Client) GetRecipientAddress(arg0 context.Context, arg1 int64) (zendesk.RecipientAddress, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTags", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.Tag)
+	ret := m.ctrl.Call(m, "GetRecipientAddress", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.RecipientAddress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrganizationTags indicates an expected call of GetOrganizationTags.
-func (mr *ClientMockRecorder) GetOrganizationTags(arg0, arg1 interface{}) *gomock.Call {
+// GetRecipientAddress indicates an expected call of GetRecipientAddress.
+func (mr *ClientMockRecorder) GetRecipientAddress(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipientAddress", reflect.TypeOf((*Client)(nil).GetRecipientAddress), arg0, arg1)
 }
 
-// GetOrganizations mocks base method.
-func (m *Client) GetOrganizations(arg0 context.Context, arg1 *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// GetRecipientAddresses mocks base method.
+func (m *Client) GetRecipientAddresses(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.RecipientAddress, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizations", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.Organization)
+	ret := m.ctrl.Call(m, "GetRecipientAddresses", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.RecipientAddress)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizations indicates an expected call of GetOrganizations.
-func (mr *ClientMockRecorder) GetOrganizations(arg0, arg1 interface{}) *gomock.Call {
+// GetRecipientAddresses indicates an expected call of GetRecipientAddresses.
+func (mr *ClientMockRecorder) GetRecipientAddresses(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipientAddresses", reflect.TypeOf((*Client)(nil).GetRecipientAddresses), arg0, arg1)
+}
+
+// GetResourceCount mocks base method.
+func (m *Client) GetResourceCount(arg0 context.Context, arg1 zendesk.CountResource) (zendesk.ResourceCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceCount", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ResourceCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceCount indicates an expected call of GetResourceCount.
+func (mr *ClientMockRecorder) GetResourceCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceCount", reflect.TypeOf((*Client)(nil).GetResourceCount), arg0, arg1)
 }
 
 // GetSLAPolicies mocks base method.
@@ -916,6 +2170,81 @@ func (mr *ClientMockRecorder) GetSLAPolicy(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), arg0, arg1)
 }
 
+// GetSchedule mocks base method.
+func (m *Client) GetSchedule(arg0 context.Context, arg1 int64) (zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *ClientMockRecorder) GetSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*Client)(nil).GetSchedule), arg0, arg1)
+}
+
+// GetSchedules mocks base method.
+func (m *Client) GetSchedules(arg0 context.Context) ([]zendesk.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", arg0)
+	ret0, _ := ret[0].([]zendesk.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *ClientMockRecorder) GetSchedules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*Client)(nil).GetSchedules), arg0)
+}
+
+// GetSecuritySettings mocks base method.
+func (m *Client) GetSecuritySettings(arg0 context.Context) (zendesk.SecuritySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecuritySettings", arg0)
+	ret0, _ := ret[0].(zendesk.SecuritySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecuritySettings indicates an expected call of GetSecuritySettings.
+func (mr *ClientMockRecorder) GetSecuritySettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecuritySettings", reflect.TypeOf((*Client)(nil).GetSecuritySettings), arg0)
+}
+
+// GetSharingAgreement mocks base method.
+func (m *Client) GetSharingAgreement(arg0 context.Context, arg1 int64) (zendesk.SharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharingAgreement", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.SharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharingAgreement indicates an expected call of GetSharingAgreement.
+func (mr *ClientMockRecorder) GetSharingAgreement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharingAgreement", reflect.TypeOf((*Client)(nil).GetSharingAgreement), arg0, arg1)
+}
+
+// GetSharingAgreements mocks base method.
+func (m *Client) GetSharingAgreements(arg0 context.Context) ([]zendesk.SharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharingAgreements", arg0)
+	ret0, _ := ret[0].([]zendesk.SharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharingAgreements indicates an expected call of GetSharingAgreements.
+func (mr *ClientMockRecorder) GetSharingAgreements(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharingAgreements", reflect.TypeOf((*Client)(nil).GetSharingAgreements), arg0)
+}
+
 // GetTarget mocks base method.
 func (m *Client) GetTarget(arg0 context.Context, arg1 int64) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -993,6 +2322,21 @@ func (mr *ClientMockRecorder) GetTicketAudits(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudits", reflect.TypeOf((*Client)(nil).GetTicketAudits), arg0, arg1, arg2)
 }
 
+// GetTicketBundle mocks base method.
+func (m *Client) GetTicketBundle(arg0 context.Context, arg1 int64) (zendesk.TicketBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketBundle", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.TicketBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketBundle indicates an expected call of GetTicketBundle.
+func (mr *ClientMockRecorder) GetTicketBundle(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketBundle", reflect.TypeOf((*Client)(nil).GetTicketBundle), arg0, arg1)
+}
+
 // GetTicketField mocks base method.
 func (m *Client) GetTicketField(arg0 context.Context, arg1 int64) (zendesk.TicketField, error) {
 	m.ctrl.T.Helper()
@@ -1024,6 +2368,22 @@ func (mr *ClientMockRecorder) GetTicketFields(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFields", reflect.TypeOf((*Client)(nil).GetTicketFields), arg0)
 }
 
+// GetTicketFieldsWithOptions mocks base method.
+func (m *Client) GetTicketFieldsWithOptions(arg0 context.Context, arg1 *zendesk.TicketFieldListOptions) ([]zendesk.TicketField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldsWithOptions", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldsWithOptions indicates an expected call of GetTicketFieldsWithOptions.
+func (mr *ClientMockRecorder) GetTicketFieldsWithOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsWithOptions", reflect.TypeOf((*Client)(nil).GetTicketFieldsWithOptions), arg0, arg1)
+}
+
 // GetTicketForm mocks base method.
 func (m *Client) GetTicketForm(arg0 context.Context, arg1 int64) (zendesk.TicketForm, error) {
 	m.ctrl.T.Helper()
@@ -1055,6 +2415,22 @@ func (mr *ClientMockRecorder) GetTicketForms(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), arg0, arg1)
 }
 
+// GetTicketIncidents mocks base method.
+func (m *Client) GetTicketIncidents(arg0 context.Context, arg1 int64, arg2 *zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketIncidents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketIncidents indicates an expected call of GetTicketIncidents.
+func (mr *ClientMockRecorder) GetTicketIncidents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketIncidents", reflect.TypeOf((*Client)(nil).GetTicketIncidents), arg0, arg1, arg2)
+}
+
 // GetTicketTags mocks base method.
 func (m *Client) GetTicketTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -1086,6 +2462,52 @@ func (mr *ClientMockRecorder) GetTickets(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*Client)(nil).GetTickets), arg0, arg1)
 }
 
+// GetTicketsByExternalIDs mocks base method.
+func (m *Client) GetTicketsByExternalIDs(arg0 context.Context, arg1 []string) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByExternalIDs", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByExternalIDs indicates an expected call of GetTicketsByExternalIDs.
+func (mr *ClientMockRecorder) GetTicketsByExternalIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByExternalIDs", reflect.TypeOf((*Client)(nil).GetTicketsByExternalIDs), arg0, arg1)
+}
+
+// GetTicketsByIDs mocks base method.
+func (m *Client) GetTicketsByIDs(arg0 context.Context, arg1 []int64, arg2 int) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByIDs indicates an expected call of GetTicketsByIDs.
+func (mr *ClientMockRecorder) GetTicketsByIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByIDs", reflect.TypeOf((*Client)(nil).GetTicketsByIDs), arg0, arg1, arg2)
+}
+
+// GetTicketsCursor mocks base method.
+func (m *Client) GetTicketsCursor(arg0 context.Context, arg1 *zendesk.CursorPagination) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsCursor", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsCursor indicates an expected call of GetTicketsCursor.
+func (mr *ClientMockRecorder) GetTicketsCursor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsCursor", reflect.TypeOf((*Client)(nil).GetTicketsCursor), arg0, arg1)
+}
+
 // GetTicketsFromView mocks base method.
 func (m *Client) GetTicketsFromView(arg0 context.Context, arg1 int64) ([]zendesk.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -1132,6 +2554,21 @@ func (mr *ClientMockRecorder) GetTriggers(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggers", reflect.TypeOf((*Client)(nil).GetTriggers), arg0, arg1)
 }
 
+// GetTwitterStatuses mocks base method.
+func (m *Client) GetTwitterStatuses(arg0 context.Context, arg1 int64, arg2 []int64) ([]zendesk.TwitterStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwitterStatuses", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.TwitterStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwitterStatuses indicates an expected call of GetTwitterStatuses.
+func (mr *ClientMockRecorder) GetTwitterStatuses(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitterStatuses", reflect.TypeOf((*Client)(nil).GetTwitterStatuses), arg0, arg1, arg2)
+}
+
 // GetUser mocks base method.
 func (m *Client) GetUser(arg0 context.Context, arg1 int64) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -1178,6 +2615,37 @@ func (mr *ClientMockRecorder) GetUserRelated(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), arg0, arg1)
 }
 
+// GetUserSegment mocks base method.
+func (m *Client) GetUserSegment(arg0 context.Context, arg1 int64) (zendesk.UserSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSegment", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.UserSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSegment indicates an expected call of GetUserSegment.
+func (mr *ClientMockRecorder) GetUserSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSegment", reflect.TypeOf((*Client)(nil).GetUserSegment), arg0, arg1)
+}
+
+// GetUserSegments mocks base method.
+func (m *Client) GetUserSegments(arg0 context.Context, arg1 *zendesk.PageOptions) ([]zendesk.UserSegment, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSegments", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.UserSegment)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserSegments indicates an expected call of GetUserSegments.
+func (mr *ClientMockRecorder) GetUserSegments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSegments", reflect.TypeOf((*Client)(nil).GetUserSegments), arg0, arg1)
+}
+
 // GetUserTags mocks base method.
 func (m *Client) GetUserTags(arg0 context.Context, arg1 int64) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
@@ -1193,6 +2661,21 @@ func (mr *ClientMockRecorder) GetUserTags(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTags", reflect.TypeOf((*Client)(nil).GetUserTags), arg0, arg1)
 }
 
+// GetUserWorkload mocks base method.
+func (m *Client) GetUserWorkload(arg0 context.Context, arg1 int64) (zendesk.UserWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWorkload", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.UserWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWorkload indicates an expected call of GetUserWorkload.
+func (mr *ClientMockRecorder) GetUserWorkload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWorkload", reflect.TypeOf((*Client)(nil).GetUserWorkload), arg0, arg1)
+}
+
 // GetUsers mocks base method.
 func (m *Client) GetUsers(arg0 context.Context, arg1 *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1209,6 +2692,37 @@ func (mr *ClientMockRecorder) GetUsers(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*Client)(nil).GetUsers), arg0, arg1)
 }
 
+// GetUsersByIDs mocks base method.
+func (m *Client) GetUsersByIDs(arg0 context.Context, arg1 []int64, arg2 int) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *ClientMockRecorder) GetUsersByIDs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*Client)(nil).GetUsersByIDs), arg0, arg1, arg2)
+}
+
+// GetUsersCursor mocks base method.
+func (m *Client) GetUsersCursor(arg0 context.Context, arg1 *zendesk.CursorPagination) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersCursor", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsersCursor indicates an expected call of GetUsersCursor.
+func (mr *ClientMockRecorder) GetUsersCursor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersCursor", reflect.TypeOf((*Client)(nil).GetUsersCursor), arg0, arg1)
+}
+
 // GetView mocks base method.
 func (m *Client) GetView(arg0 context.Context, arg1 int64) (zendesk.View, error) {
 	m.ctrl.T.Helper()
@@ -1224,6 +2738,36 @@ func (mr *ClientMockRecorder) GetView(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*Client)(nil).GetView), arg0, arg1)
 }
 
+// GetViewCount mocks base method.
+func (m *Client) GetViewCount(arg0 context.Context, arg1 int64) (zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewCount", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewCount indicates an expected call of GetViewCount.
+func (mr *ClientMockRecorder) GetViewCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewCount", reflect.TypeOf((*Client)(nil).GetViewCount), arg0, arg1)
+}
+
+// GetViewCounts mocks base method.
+func (m *Client) GetViewCounts(arg0 context.Context, arg1 []int64, arg2 *zendesk.ViewCountOptions) ([]zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewCounts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewCounts indicates an expected call of GetViewCounts.
+func (mr *ClientMockRecorder) GetViewCounts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewCounts", reflect.TypeOf((*Client)(nil).GetViewCounts), arg0, arg1, arg2)
+}
+
 // GetViews mocks base method.
 func (m *Client) GetViews(arg0 context.Context) ([]zendesk.View, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1234,40 +2778,117 @@ func (m *Client) GetViews(arg0 context.Context) ([]zendesk.View, zendesk.Page, e
 	return ret0, ret1, ret2
 }
 
-// GetViews indicates an expected call of GetViews.
-func (mr *ClientMockRecorder) GetViews(arg0 interface{}) *gomock.Call {
+// GetViews indicates an expected call of GetViews.
+func (mr *ClientMockRecorder) GetViews(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViews", reflect.TypeOf((*Client)(nil).GetViews), arg0)
+}
+
+// GetViewsWithOptions mocks base method.
+func (m *Client) GetViewsWithOptions(arg0 context.Context, arg1 *zendesk.ViewListOptions) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewsWithOptions", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetViewsWithOptions indicates an expected call of GetViewsWithOptions.
+func (mr *ClientMockRecorder) GetViewsWithOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsWithOptions", reflect.TypeOf((*Client)(nil).GetViewsWithOptions), arg0, arg1)
+}
+
+// GetWebhook mocks base method.
+func (m *Client) GetWebhook(arg0 context.Context, arg1 string) (*zendesk.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", arg0, arg1)
+	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *ClientMockRecorder) GetWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), arg0, arg1)
+}
+
+// GetWebhookSigningSecret mocks base method.
+func (m *Client) GetWebhookSigningSecret(arg0 context.Context, arg1 string) (*zendesk.WebhookSigningSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSigningSecret", arg0, arg1)
+	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSigningSecret indicates an expected call of GetWebhookSigningSecret.
+func (mr *ClientMockRecorder) GetWebhookSigningSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), arg0, arg1)
+}
+
+// ImportTicket mocks base method.
+func (m *Client) ImportTicket(arg0 context.Context, arg1 *zendesk.TicketArchive, arg2 *zendesk.TicketImportOptions) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTicket indicates an expected call of ImportTicket.
+func (mr *ClientMockRecorder) ImportTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViews", reflect.TypeOf((*Client)(nil).GetViews), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTicket", reflect.TypeOf((*Client)(nil).ImportTicket), arg0, arg1, arg2)
 }
 
-// GetWebhook mocks base method.
-func (m *Client) GetWebhook(arg0 context.Context, arg1 string) (*zendesk.Webhook, error) {
+// LinkIncidents mocks base method.
+func (m *Client) LinkIncidents(arg0 context.Context, arg1 int64, arg2 []int64, arg3 *zendesk.BatchOptions) (zendesk.BatchResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWebhook", arg0, arg1)
-	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret := m.ctrl.Call(m, "LinkIncidents", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.BatchResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWebhook indicates an expected call of GetWebhook.
-func (mr *ClientMockRecorder) GetWebhook(arg0, arg1 interface{}) *gomock.Call {
+// LinkIncidents indicates an expected call of LinkIncidents.
+func (mr *ClientMockRecorder) LinkIncidents(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkIncidents", reflect.TypeOf((*Client)(nil).LinkIncidents), arg0, arg1, arg2, arg3)
 }
 
-// GetWebhookSigningSecret mocks base method.
-func (m *Client) GetWebhookSigningSecret(arg0 context.Context, arg1 string) (*zendesk.WebhookSigningSecret, error) {
+// ListAssignableGroupAgents mocks base method.
+func (m *Client) ListAssignableGroupAgents(arg0 context.Context, arg1 int64) ([]zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWebhookSigningSecret", arg0, arg1)
-	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret := m.ctrl.Call(m, "ListAssignableGroupAgents", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWebhookSigningSecret indicates an expected call of GetWebhookSigningSecret.
-func (mr *ClientMockRecorder) GetWebhookSigningSecret(arg0, arg1 interface{}) *gomock.Call {
+// ListAssignableGroupAgents indicates an expected call of ListAssignableGroupAgents.
+func (mr *ClientMockRecorder) ListAssignableGroupAgents(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignableGroupAgents", reflect.TypeOf((*Client)(nil).ListAssignableGroupAgents), arg0, arg1)
+}
+
+// ListGroupUsers mocks base method.
+func (m *Client) ListGroupUsers(arg0 context.Context, arg1 int64, arg2 *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupUsers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGroupUsers indicates an expected call of ListGroupUsers.
+func (mr *ClientMockRecorder) ListGroupUsers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupUsers", reflect.TypeOf((*Client)(nil).ListGroupUsers), arg0, arg1, arg2)
 }
 
 // ListInstallations mocks base method.
@@ -1314,6 +2935,35 @@ func (mr *ClientMockRecorder) MakeCommentPrivate(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), arg0, arg1, arg2)
 }
 
+// MergeOrganization mocks base method.
+func (m *Client) MergeOrganization(arg0 context.Context, arg1, arg2 int64) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeOrganization", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeOrganization indicates an expected call of MergeOrganization.
+func (mr *ClientMockRecorder) MergeOrganization(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOrganization", reflect.TypeOf((*Client)(nil).MergeOrganization), arg0, arg1, arg2)
+}
+
+// PermanentlyDeleteUser mocks base method.
+func (m *Client) PermanentlyDeleteUser(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentlyDeleteUser indicates an expected call of PermanentlyDeleteUser.
+func (mr *ClientMockRecorder) PermanentlyDeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteUser", reflect.TypeOf((*Client)(nil).PermanentlyDeleteUser), arg0, arg1)
+}
+
 // Post mocks base method.
 func (m *Client) Post(arg0 context.Context, arg1 string, arg2 interface{}) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -1344,6 +2994,20 @@ func (mr *ClientMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), arg0, arg1, arg2)
 }
 
+// RedactCommentAttachment mocks base method.
+func (m *Client) RedactCommentAttachment(arg0 context.Context, arg1, arg2, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactCommentAttachment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedactCommentAttachment indicates an expected call of RedactCommentAttachment.
+func (mr *ClientMockRecorder) RedactCommentAttachment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactCommentAttachment", reflect.TypeOf((*Client)(nil).RedactCommentAttachment), arg0, arg1, arg2, arg3)
+}
+
 // RedactTicketComment mocks base method.
 func (m *Client) RedactTicketComment(arg0 context.Context, arg1 int64, arg2 zendesk.RedactTicketCommentRequest) (*zendesk.TicketComment, error) {
 	m.ctrl.T.Helper()
@@ -1359,6 +3023,64 @@ func (mr *ClientMockRecorder) RedactTicketComment(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactTicketComment", reflect.TypeOf((*Client)(nil).RedactTicketComment), arg0, arg1, arg2)
 }
 
+// RedactTicketCommentString mocks base method.
+func (m *Client) RedactTicketCommentString(arg0 context.Context, arg1, arg2 int64, arg3 string) (*zendesk.TicketComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactTicketCommentString", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*zendesk.TicketComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedactTicketCommentString indicates an expected call of RedactTicketCommentString.
+func (mr *ClientMockRecorder) RedactTicketCommentString(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactTicketCommentString", reflect.TypeOf((*Client)(nil).RedactTicketCommentString), arg0, arg1, arg2, arg3)
+}
+
+// RejectAnswerBotArticle mocks base method.
+func (m *Client) RejectAnswerBotArticle(arg0 context.Context, arg1 zendesk.AnswerBotDeflection, arg2 int64, arg3 zendesk.AnswerBotRejectionReason) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectAnswerBotArticle", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectAnswerBotArticle indicates an expected call of RejectAnswerBotArticle.
+func (mr *ClientMockRecorder) RejectAnswerBotArticle(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectAnswerBotArticle", reflect.TypeOf((*Client)(nil).RejectAnswerBotArticle), arg0, arg1, arg2, arg3)
+}
+
+// ResolveAnswerBotDeflection mocks base method.
+func (m *Client) ResolveAnswerBotDeflection(arg0 context.Context, arg1 zendesk.AnswerBotDeflection, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAnswerBotDeflection", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveAnswerBotDeflection indicates an expected call of ResolveAnswerBotDeflection.
+func (mr *ClientMockRecorder) ResolveAnswerBotDeflection(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAnswerBotDeflection", reflect.TypeOf((*Client)(nil).ResolveAnswerBotDeflection), arg0, arg1, arg2)
+}
+
+// ScheduleArticle mocks base method.
+func (m *Client) ScheduleArticle(arg0 context.Context, arg1 int64, arg2, arg3 *time.Time) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleArticle", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleArticle indicates an expected call of ScheduleArticle.
+func (mr *ClientMockRecorder) ScheduleArticle(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleArticle", reflect.TypeOf((*Client)(nil).ScheduleArticle), arg0, arg1, arg2, arg3)
+}
+
 // Search mocks base method.
 func (m *Client) Search(arg0 context.Context, arg1 *zendesk.SearchOptions) (zendesk.SearchResults, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1390,6 +3112,22 @@ func (mr *ClientMockRecorder) SearchCount(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCount", reflect.TypeOf((*Client)(nil).SearchCount), arg0, arg1)
 }
 
+// SearchKnowledge mocks base method.
+func (m *Client) SearchKnowledge(arg0 context.Context, arg1 *zendesk.KnowledgeSearchOptions) ([]zendesk.KnowledgeSearchResult, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchKnowledge", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.KnowledgeSearchResult)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchKnowledge indicates an expected call of SearchKnowledge.
+func (mr *ClientMockRecorder) SearchKnowledge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchKnowledge", reflect.TypeOf((*Client)(nil).SearchKnowledge), arg0, arg1)
+}
+
 // SearchUsers mocks base method.
 func (m *Client) SearchUsers(arg0 context.Context, arg1 *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -1406,6 +3144,21 @@ func (mr *ClientMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), arg0, arg1)
 }
 
+// SetBrandLogo mocks base method.
+func (m *Client) SetBrandLogo(arg0 context.Context, arg1 int64, arg2 string, arg3 io.Reader) (zendesk.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBrandLogo", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBrandLogo indicates an expected call of SetBrandLogo.
+func (mr *ClientMockRecorder) SetBrandLogo(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBrandLogo", reflect.TypeOf((*Client)(nil).SetBrandLogo), arg0, arg1, arg2, arg3)
+}
+
 // SetDefaultOrganization mocks base method.
 func (m *Client) SetDefaultOrganization(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
 	m.ctrl.T.Helper()
@@ -1421,6 +3174,81 @@ func (mr *ClientMockRecorder) SetDefaultOrganization(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganization", reflect.TypeOf((*Client)(nil).SetDefaultOrganization), arg0, arg1)
 }
 
+// SetUserPhoto mocks base method.
+func (m *Client) SetUserPhoto(arg0 context.Context, arg1 int64, arg2 string, arg3 io.Reader) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserPhoto", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserPhoto indicates an expected call of SetUserPhoto.
+func (mr *ClientMockRecorder) SetUserPhoto(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserPhoto", reflect.TypeOf((*Client)(nil).SetUserPhoto), arg0, arg1, arg2, arg3)
+}
+
+// SolveIncidents mocks base method.
+func (m *Client) SolveIncidents(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketComment, arg3 *zendesk.BatchOptions) (zendesk.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SolveIncidents", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(zendesk.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SolveIncidents indicates an expected call of SolveIncidents.
+func (mr *ClientMockRecorder) SolveIncidents(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SolveIncidents", reflect.TypeOf((*Client)(nil).SolveIncidents), arg0, arg1, arg2, arg3)
+}
+
+// UpdateAccountSettings mocks base method.
+func (m *Client) UpdateAccountSettings(arg0 context.Context, arg1 zendesk.AccountSettings) (zendesk.AccountSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountSettings", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountSettings indicates an expected call of UpdateAccountSettings.
+func (mr *ClientMockRecorder) UpdateAccountSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountSettings", reflect.TypeOf((*Client)(nil).UpdateAccountSettings), arg0, arg1)
+}
+
+// UpdateArticle mocks base method.
+func (m *Client) UpdateArticle(arg0 context.Context, arg1 int64, arg2 zendesk.Article) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticle", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArticle indicates an expected call of UpdateArticle.
+func (mr *ClientMockRecorder) UpdateArticle(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*Client)(nil).UpdateArticle), arg0, arg1, arg2)
+}
+
+// UpdateAttachmentMalwareAccessOverride mocks base method.
+func (m *Client) UpdateAttachmentMalwareAccessOverride(arg0 context.Context, arg1 int64, arg2 bool) (zendesk.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttachmentMalwareAccessOverride", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttachmentMalwareAccessOverride indicates an expected call of UpdateAttachmentMalwareAccessOverride.
+func (mr *ClientMockRecorder) UpdateAttachmentMalwareAccessOverride(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttachmentMalwareAccessOverride", reflect.TypeOf((*Client)(nil).UpdateAttachmentMalwareAccessOverride), arg0, arg1, arg2)
+}
+
 // UpdateAutomation mocks base method.
 func (m *Client) UpdateAutomation(arg0 context.Context, arg1 int64, arg2 zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
@@ -1451,6 +3279,36 @@ func (mr *ClientMockRecorder) UpdateBrand(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBrand", reflect.TypeOf((*Client)(nil).UpdateBrand), arg0, arg1, arg2)
 }
 
+// UpdateCCsFollowersSettings mocks base method.
+func (m *Client) UpdateCCsFollowersSettings(arg0 context.Context, arg1 zendesk.CCsFollowersSettings) (zendesk.CCsFollowersSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCCsFollowersSettings", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.CCsFollowersSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCCsFollowersSettings indicates an expected call of UpdateCCsFollowersSettings.
+func (mr *ClientMockRecorder) UpdateCCsFollowersSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCCsFollowersSettings", reflect.TypeOf((*Client)(nil).UpdateCCsFollowersSettings), arg0, arg1)
+}
+
+// UpdateContentTag mocks base method.
+func (m *Client) UpdateContentTag(arg0 context.Context, arg1 string, arg2 zendesk.ContentTag) (zendesk.ContentTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContentTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ContentTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContentTag indicates an expected call of UpdateContentTag.
+func (mr *ClientMockRecorder) UpdateContentTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContentTag", reflect.TypeOf((*Client)(nil).UpdateContentTag), arg0, arg1, arg2)
+}
+
 // UpdateDynamicContentItem mocks base method.
 func (m *Client) UpdateDynamicContentItem(arg0 context.Context, arg1 int64, arg2 zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
@@ -1466,6 +3324,36 @@ func (mr *ClientMockRecorder) UpdateDynamicContentItem(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicContentItem", reflect.TypeOf((*Client)(nil).UpdateDynamicContentItem), arg0, arg1, arg2)
 }
 
+// UpdateExternalContentRecord mocks base method.
+func (m *Client) UpdateExternalContentRecord(arg0 context.Context, arg1 string, arg2 zendesk.ExternalContentRecord) (zendesk.ExternalContentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalContentRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ExternalContentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExternalContentRecord indicates an expected call of UpdateExternalContentRecord.
+func (mr *ClientMockRecorder) UpdateExternalContentRecord(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalContentRecord", reflect.TypeOf((*Client)(nil).UpdateExternalContentRecord), arg0, arg1, arg2)
+}
+
+// UpdateExternalContentSource mocks base method.
+func (m *Client) UpdateExternalContentSource(arg0 context.Context, arg1 string, arg2 zendesk.ExternalContentSource) (zendesk.ExternalContentSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalContentSource", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.ExternalContentSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExternalContentSource indicates an expected call of UpdateExternalContentSource.
+func (mr *ClientMockRecorder) UpdateExternalContentSource(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalContentSource", reflect.TypeOf((*Client)(nil).UpdateExternalContentSource), arg0, arg1, arg2)
+}
+
 // UpdateGroup mocks base method.
 func (m *Client) UpdateGroup(arg0 context.Context, arg1 int64, arg2 zendesk.Group) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +3384,36 @@ func (mr *ClientMockRecorder) UpdateMacro(arg0, arg1, arg2 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), arg0, arg1, arg2)
 }
 
+// UpdateManyTickets mocks base method.
+func (m *Client) UpdateManyTickets(arg0 context.Context, arg1 []zendesk.Ticket) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateManyTickets", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateManyTickets indicates an expected call of UpdateManyTickets.
+func (mr *ClientMockRecorder) UpdateManyTickets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyTickets", reflect.TypeOf((*Client)(nil).UpdateManyTickets), arg0, arg1)
+}
+
+// UpdateMobileSDKApp mocks base method.
+func (m *Client) UpdateMobileSDKApp(arg0 context.Context, arg1 int64, arg2 zendesk.MobileSDKApp) (zendesk.MobileSDKApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMobileSDKApp", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.MobileSDKApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMobileSDKApp indicates an expected call of UpdateMobileSDKApp.
+func (mr *ClientMockRecorder) UpdateMobileSDKApp(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMobileSDKApp", reflect.TypeOf((*Client)(nil).UpdateMobileSDKApp), arg0, arg1, arg2)
+}
+
 // UpdateOrganization mocks base method.
 func (m *Client) UpdateOrganization(arg0 context.Context, arg1 int64, arg2 zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
@@ -1511,6 +3429,36 @@ func (mr *ClientMockRecorder) UpdateOrganization(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*Client)(nil).UpdateOrganization), arg0, arg1, arg2)
 }
 
+// UpdatePermissionGroup mocks base method.
+func (m *Client) UpdatePermissionGroup(arg0 context.Context, arg1 int64, arg2 zendesk.PermissionGroup) (zendesk.PermissionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePermissionGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePermissionGroup indicates an expected call of UpdatePermissionGroup.
+func (mr *ClientMockRecorder) UpdatePermissionGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePermissionGroup", reflect.TypeOf((*Client)(nil).UpdatePermissionGroup), arg0, arg1, arg2)
+}
+
+// UpdateRecipientAddress mocks base method.
+func (m *Client) UpdateRecipientAddress(arg0 context.Context, arg1 int64, arg2 zendesk.RecipientAddress) (zendesk.RecipientAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecipientAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.RecipientAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecipientAddress indicates an expected call of UpdateRecipientAddress.
+func (mr *ClientMockRecorder) UpdateRecipientAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecipientAddress", reflect.TypeOf((*Client)(nil).UpdateRecipientAddress), arg0, arg1, arg2)
+}
+
 // UpdateSLAPolicy mocks base method.
 func (m *Client) UpdateSLAPolicy(arg0 context.Context, arg1 int64, arg2 zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
@@ -1526,6 +3474,36 @@ func (mr *ClientMockRecorder) UpdateSLAPolicy(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSLAPolicy", reflect.TypeOf((*Client)(nil).UpdateSLAPolicy), arg0, arg1, arg2)
 }
 
+// UpdateSecuritySettings mocks base method.
+func (m *Client) UpdateSecuritySettings(arg0 context.Context, arg1 zendesk.SecuritySettings) (zendesk.SecuritySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecuritySettings", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.SecuritySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecuritySettings indicates an expected call of UpdateSecuritySettings.
+func (mr *ClientMockRecorder) UpdateSecuritySettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecuritySettings", reflect.TypeOf((*Client)(nil).UpdateSecuritySettings), arg0, arg1)
+}
+
+// UpdateSharingAgreement mocks base method.
+func (m *Client) UpdateSharingAgreement(arg0 context.Context, arg1 int64, arg2 zendesk.SharingAgreement) (zendesk.SharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharingAgreement", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.SharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSharingAgreement indicates an expected call of UpdateSharingAgreement.
+func (mr *ClientMockRecorder) UpdateSharingAgreement(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharingAgreement", reflect.TypeOf((*Client)(nil).UpdateSharingAgreement), arg0, arg1, arg2)
+}
+
 // UpdateTarget mocks base method.
 func (m *Client) UpdateTarget(arg0 context.Context, arg1 int64, arg2 zendesk.Target) (zendesk.Target, error) {
 	m.ctrl.T.Helper()
@@ -1616,6 +3594,21 @@ func (mr *ClientMockRecorder) UpdateUser(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*Client)(nil).UpdateUser), arg0, arg1, arg2)
 }
 
+// UpdateUserSegment mocks base method.
+func (m *Client) UpdateUserSegment(arg0 context.Context, arg1 int64, arg2 zendesk.UserSegment) (zendesk.UserSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserSegment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.UserSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserSegment indicates an expected call of UpdateUserSegment.
+func (mr *ClientMockRecorder) UpdateUserSegment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserSegment", reflect.TypeOf((*Client)(nil).UpdateUserSegment), arg0, arg1, arg2)
+}
+
 // UpdateWebhook mocks base method.
 func (m *Client) UpdateWebhook(arg0 context.Context, arg1 string, arg2 *zendesk.Webhook) error {
 	m.ctrl.T.Helper()
@@ -1643,3 +3636,47 @@ func (mr *ClientMockRecorder) UploadAttachment(arg0, arg1, arg2 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*Client)(nil).UploadAttachment), arg0, arg1, arg2)
 }
+
+// UploadMacroAttachment mocks base method.
+func (m *Client) UploadMacroAttachment(arg0 context.Context, arg1 string, arg2 io.Reader) (zendesk.MacroAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadMacroAttachment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadMacroAttachment indicates an expected call of UploadMacroAttachment.
+func (mr *ClientMockRecorder) UploadMacroAttachment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadMacroAttachment", reflect.TypeOf((*Client)(nil).UploadMacroAttachment), arg0, arg1, arg2)
+}
+
+// VerifyRecipientAddress mocks base method.
+func (m *Client) VerifyRecipientAddress(arg0 context.Context, arg1 int64, arg2 zendesk.RecipientAddressCheck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRecipientAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyRecipientAddress indicates an expected call of VerifyRecipientAddress.
+func (mr *ClientMockRecorder) VerifyRecipientAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRecipientAddress", reflect.TypeOf((*Client)(nil).VerifyRecipientAddress), arg0, arg1, arg2)
+}
+
+// WaitRecipientAddressVerified mocks base method.
+func (m *Client) WaitRecipientAddressVerified(arg0 context.Context, arg1 int64, arg2 *zendesk.RecipientAddressVerifyOptions) (zendesk.RecipientAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitRecipientAddressVerified", arg0, arg1, arg2)
+	ret0, _ := ret[0].(zendesk.RecipientAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitRecipientAddressVerified indicates an expected call of WaitRecipientAddressVerified.
+func (mr *ClientMockRecorder) WaitRecipientAddressVerified(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitRecipientAddressVerified", reflect.TypeOf((*Client)(nil).WaitRecipientAddressVerified), arg0, arg1, arg2)
+}
@@ -0,0 +1,55 @@
+package mock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+// countOpenTickets is an example of code under test which depends on zendesk.API
+func countOpenTickets(ctx context.Context, api zendesk.TicketAPI, ids []int64) (int, error) {
+	tickets, err := api.GetMultipleTickets(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, ticket := range tickets {
+		if ticket.Status == "open" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func TestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mock.NewClient(ctrl)
+	client.EXPECT().
+		GetMultipleTickets(gomock.Any(), []int64{1, 2}).
+		Return([]zendesk.Ticket{{ID: 1, Status: "open"}, {ID: 2, Status: "solved"}}, nil)
+
+	count, err := countOpenTickets(context.Background(), client, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to count tickets: %s", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 open ticket, but got %d", count)
+	}
+}
+
+func ExampleNewClient() {
+	ctrl := gomock.NewController(nil)
+	client := mock.NewClient(ctrl)
+	client.EXPECT().GetTicket(gomock.Any(), int64(1)).Return(zendesk.Ticket{ID: 1, Subject: "Help"}, nil)
+
+	ticket, _ := client.GetTicket(context.Background(), 1)
+	fmt.Println(ticket.Subject)
+	// Output: Help
+}
@@ -0,0 +1,22 @@
+package zendesk
+
+import "encoding/json"
+
+// marshalWithNullFields marshals v and sets the fields named in nullFields to
+// null. Since most fields are tagged omitempty, this is the only way to clear
+// a value, e.g. to unassign a ticket.
+func marshalWithNullFields(v interface{}, nullFields []string) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(nullFields) == 0 {
+		return b, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range nullFields {
+		fields[name] = json.RawMessage("null")
+	}
+	return json.Marshal(fields)
+}
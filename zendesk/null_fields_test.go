@@ -0,0 +1,36 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalWithNullFields(t *testing.T) {
+	b, err := json.Marshal(User{Name: "Alice", OrganizationID: 1, NullFields: []string{"organization_id", "phone"}})
+	if err != nil {
+		t.Fatalf("Failed to marshal user: %s", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal user: %s", err)
+	}
+	for _, name := range []string{"organization_id", "phone"} {
+		if string(fields[name]) != "null" {
+			t.Fatalf("%s should be null, but got %s", name, fields[name])
+		}
+	}
+	if string(fields["name"]) != `"Alice"` {
+		t.Fatalf("name should be kept, but got %s", fields["name"])
+	}
+}
+
+func TestMarshalWithoutNullFields(t *testing.T) {
+	b, err := json.Marshal(Ticket{Subject: "Help"})
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+	if string(b) != `{"subject":"Help"}` {
+		t.Fatalf("unexpected json %s", b)
+	}
+}
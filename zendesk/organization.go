@@ -10,20 +10,20 @@ import (
 // Organization is struct for organization payload
 // https://developer.zendesk.com/rest_api/docs/support/organizations
 type Organization struct {
-	ID                 int64                  `json:"id,omitempty"`
-	ExternalID         string                 `json:"external_id,omitempty"`
-	URL                string                 `json:"url,omitempty"`
-	Name               string                 `json:"name"`
-	Details            string                 `json:"details,omitempty"`
-	DomainNames        []string               `json:"domain_names"`
-	GroupID            int64                  `json:"group_id"`
-	SharedTickets      bool                   `json:"shared_tickets"`
-	SharedComments     bool                   `json:"shared_comments"`
-	Tags               []string               `json:"tags"`
-	Notes              string                 `json:"notes,omitempty"`
-	CreatedAt          time.Time              `json:"created_at,omitempty"`
-	UpdatedAt          time.Time              `json:"updated_at,omitempty"`
-	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
+	ID                 int64              `json:"id,omitempty"`
+	ExternalID         string             `json:"external_id,omitempty"`
+	URL                string             `json:"url,omitempty"`
+	Name               string             `json:"name"`
+	Details            string             `json:"details,omitempty"`
+	DomainNames        []string           `json:"domain_names"`
+	GroupID            int64              `json:"group_id"`
+	SharedTickets      bool               `json:"shared_tickets"`
+	SharedComments     bool               `json:"shared_comments"`
+	Tags               []string           `json:"tags"`
+	Notes              string             `json:"notes,omitempty"`
+	CreatedAt          time.Time          `json:"created_at,omitempty"`
+	UpdatedAt          time.Time          `json:"updated_at,omitempty"`
+	OrganizationFields OrganizationFields `json:"organization_fields,omitempty"`
 }
 
 // OrganizationListOptions is options for GetOrganizations
@@ -33,12 +33,24 @@ type OrganizationListOptions struct {
 	PageOptions
 }
 
+// OrganizationRelated is the numbers of tickets and users of the organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+type OrganizationRelated struct {
+	TicketsCount int64 `json:"tickets_count"`
+	UsersCount   int64 `json:"users_count"`
+}
+
 // OrganizationAPI an interface containing all methods associated with zendesk organizations
 type OrganizationAPI interface {
 	GetOrganizations(ctx context.Context, opts *OrganizationListOptions) ([]Organization, Page, error)
 	CreateOrganization(ctx context.Context, org Organization) (Organization, error)
 	GetOrganization(ctx context.Context, orgID int64) (Organization, error)
+	GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error)
+	GetOrganizationsByIDs(ctx context.Context, ids []int64, concurrency int) ([]Organization, error)
 	GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error)
+	GetOrganizationsByExternalIDs(ctx context.Context, externalIDs []string) ([]Organization, error)
+	CreateOrUpdateOrganization(ctx context.Context, org Organization) (Organization, error)
 	UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error)
 	DeleteOrganization(ctx context.Context, orgID int64) error
 }
@@ -74,6 +86,20 @@ func (z *Client) GetOrganizations(ctx context.Context, opts *OrganizationListOpt
 	return data.Organizations, data.Page, nil
 }
 
+// GetOrganizationsPage fetches the page of organizations at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetOrganizationsPage(ctx context.Context, token PageToken, opts *OrganizationListOptions) (ListPage[Organization], error) {
+	tmp := OrganizationListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Organization, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetOrganizations(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateOrganization creates new organization
 // https://developer.zendesk.com/rest_api/docs/support/organizations#create-organization
 func (z *Client) CreateOrganization(ctx context.Context, org Organization) (Organization, error) {
@@ -116,6 +142,25 @@ func (z *Client) GetOrganization(ctx context.Context, orgID int64) (Organization
 	return result.Organization, err
 }
 
+// GetOrganizationRelated fetches the numbers of tickets and users of the organization
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+func (z *Client) GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error) {
+	var result struct {
+		OrganizationRelated OrganizationRelated `json:"organization_related"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/related.json", orgID))
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+	return result.OrganizationRelated, nil
+}
+
 // GetOrganizationByExternalID gets a specified organization by external ID
 // ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations-by-external-id
 func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error) {
@@ -137,6 +182,36 @@ func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID str
 	return result.Organizations, result.Page, err
 }
 
+// GetOrganizationsByExternalIDs gets the organizations of any number of external IDs,
+// splitting them into chunks of show_many endpoint
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+func (z *Client) GetOrganizationsByExternalIDs(ctx context.Context, externalIDs []string) ([]Organization, error) {
+	return getByExternalIDs[Organization](ctx, z, "/organizations/show_many.json", "organizations", externalIDs)
+}
+
+// CreateOrUpdateOrganization creates new organization or updates the organization
+// matching the ID or the external ID
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-or-update-organization
+func (z *Client) CreateOrUpdateOrganization(ctx context.Context, org Organization) (Organization, error) {
+	var data, result struct {
+		Organization Organization `json:"organization"`
+	}
+
+	data.Organization = org
+
+	body, err := z.post(ctx, "/organizations/create_or_update.json", data)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	return result.Organization, nil
+}
+
 // UpdateOrganization updates a organization with the specified organization
 // ref: https://developer.zendesk.com/rest_api/docs/support/organizations#update-organization
 func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error) {
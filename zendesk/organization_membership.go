@@ -74,6 +74,20 @@ func (z *Client) GetOrganizationMemberships(ctx context.Context, opts *Organizat
 	return result.OrganizationMemberships, result.Page, nil
 }
 
+// GetOrganizationMembershipsPage fetches the page of organization memberships at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetOrganizationMembershipsPage(ctx context.Context, token PageToken, opts *OrganizationMembershipListOptions) (ListPage[OrganizationMembership], error) {
+	tmp := OrganizationMembershipListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]OrganizationMembership, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetOrganizationMemberships(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateOrganizationMembership creates an organization membership for an existing user and org
 // https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/#create-membership
 func (z *Client) CreateOrganizationMembership(ctx context.Context, opts OrganizationMembershipOptions) (OrganizationMembership, error) {
@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// OrganizationMerge is the status of a job merging an organization into another
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+type OrganizationMerge struct {
+	ID       string `json:"id"`
+	URL      string `json:"url,omitempty"`
+	LoserID  int64  `json:"loser_id"`
+	WinnerID int64  `json:"winner_id"`
+	// Status is one of "new", "in_progress", "error" and "complete"
+	Status string `json:"status"`
+}
+
+// OrganizationMergeAPI an interface containing all organization merge related methods
+type OrganizationMergeAPI interface {
+	MergeOrganization(ctx context.Context, loserID int64, winnerID int64) (OrganizationMerge, error)
+	GetOrganizationMerges(ctx context.Context, orgID int64) ([]OrganizationMerge, error)
+	GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error)
+}
+
+// MergeOrganization starts a job merging the organization of loserID into winnerID.
+// The users, tickets and domain names of the loser are moved to the winner,
+// and the loser is deleted when the job completes.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#merge-organization-with-another-organization
+func (z *Client) MergeOrganization(ctx context.Context, loserID int64, winnerID int64) (OrganizationMerge, error) {
+	var data struct {
+		OrganizationMerge struct {
+			WinnerID int64 `json:"winner_id"`
+		} `json:"organization_merge"`
+	}
+	var result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+	data.OrganizationMerge.WinnerID = winnerID
+
+	body, err := z.post(ctx, fmt.Sprintf("/organizations/%d/merge.json", loserID), data)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+	return result.OrganizationMerge, nil
+}
+
+// GetOrganizationMerges lists the merges the organization is involved in as winner or loser
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#list-organization-merges
+func (z *Client) GetOrganizationMerges(ctx context.Context, orgID int64) ([]OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerges []OrganizationMerge `json:"organization_merges"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/merges.json", orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.OrganizationMerges, nil
+}
+
+// GetOrganizationMerge gets the status of the merge
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organization-merge
+func (z *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_merges/%s.json", mergeID))
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+	return result.OrganizationMerge, nil
+}
@@ -0,0 +1,67 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestMergeOrganization(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/merge.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var data struct {
+			OrganizationMerge map[string]interface{} `json:"organization_merge"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.OrganizationMerge) != 1 || data.OrganizationMerge["winner_id"] != float64(456) {
+			t.Fatalf("unexpected request %v", data.OrganizationMerge)
+		}
+
+		w.Write(readFixture(filepath.Join(http.MethodPost, "organization_merge.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.MergeOrganization(ctx, 123, 456)
+	if err != nil {
+		t.Fatalf("Failed to merge organization: %s", err)
+	}
+	if merge.ID != "01HPZM6206BF4G63783E5349AD" || merge.Status != "new" {
+		t.Fatalf("unexpected merge %v", merge)
+	}
+}
+
+func TestGetOrganizationMerges(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merges.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merges, err := client.GetOrganizationMerges(ctx, 456)
+	if err != nil {
+		t.Fatalf("Failed to get organization merges: %s", err)
+	}
+	if len(merges) != 1 || merges[0].WinnerID != 456 {
+		t.Fatalf("unexpected merges %v", merges)
+	}
+}
+
+func TestGetOrganizationMerge(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merge.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.GetOrganizationMerge(ctx, "01HPZM6206BF4G63783E5349AD")
+	if err != nil {
+		t.Fatalf("Failed to get organization merge: %s", err)
+	}
+	if merge.Status != "complete" {
+		t.Fatalf("unexpected status %s", merge.Status)
+	}
+}
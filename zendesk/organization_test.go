@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -33,6 +36,20 @@ func TestGetOrganization(t *testing.T) {
 	}
 }
 
+func TestGetOrganizationRelated(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_related.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	related, err := client.GetOrganizationRelated(ctx, 361898904439)
+	if err != nil {
+		t.Fatalf("Failed to get organization related: %s", err)
+	}
+	if related.TicketsCount != 12 || related.UsersCount != 4 {
+		t.Fatalf("unexpected organization related %v", related)
+	}
+}
+
 func TestGetOrganizations(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "organizations.json")
 	client := newTestClient(mockAPI)
@@ -76,3 +93,45 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestCreateOrUpdateOrganization(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organizations/create_or_update.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "organization.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	org, err := client.CreateOrUpdateOrganization(ctx, Organization{ExternalID: "crm-1", Name: "Acme"})
+	if err != nil {
+		t.Fatalf("Failed to create or update organization: %s", err)
+	}
+	if org.ID == 0 {
+		t.Fatal("Failed to create or update organization")
+	}
+}
+
+func TestGetOrganizationsByExternalIDs(t *testing.T) {
+	var requested []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Query().Get("external_ids"))
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	externalIDs := make([]string, 150)
+	for i := range externalIDs {
+		externalIDs[i] = fmt.Sprintf("crm-%d", i)
+	}
+
+	_, err := client.GetOrganizationsByExternalIDs(ctx, externalIDs)
+	if err != nil {
+		t.Fatalf("Failed to get organizations: %s", err)
+	}
+	if len(requested) != 2 || !strings.HasPrefix(requested[1], "crm-100,crm-101,") {
+		t.Fatalf("unexpected chunks %v", requested)
+	}
+}
@@ -0,0 +1,265 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// PageToken is a position in a paginated listing. It is either an offset
+// position (Page and PerPage) or a cursor position (After and PageSize).
+// PageToken can be serialized with String and restored with ParsePageToken,
+// so that checkpointed sync jobs can resume listing where they stopped.
+type PageToken struct {
+	CursorBased bool   `json:"cursor_based,omitempty"`
+	Page        int    `json:"page,omitempty"`
+	PerPage     int    `json:"per_page,omitempty"`
+	After       string `json:"after,omitempty"`
+	PageSize    int    `json:"page_size,omitempty"`
+}
+
+// PageOptions returns offset pagination options pointing to the token
+func (t PageToken) PageOptions() PageOptions {
+	return PageOptions{
+		Page:    t.Page,
+		PerPage: t.PerPage,
+	}
+}
+
+// CursorPagination returns cursor pagination options pointing to the token
+func (t PageToken) CursorPagination() CursorPagination {
+	return CursorPagination{
+		PageSize:  t.PageSize,
+		PageAfter: t.After,
+	}
+}
+
+// String serializes the token into an opaque URL-safe string
+func (t PageToken) String() string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// ParsePageToken restores PageToken serialized by PageToken.String
+func ParsePageToken(s string) (PageToken, error) {
+	var t PageToken
+	if s == "" {
+		return t, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PageToken{}, fmt.Errorf("invalid page token: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &t); err != nil {
+		return PageToken{}, fmt.Errorf("invalid page token: %w", err)
+	}
+	return t, nil
+}
+
+// ListPage is a page of list results, unified over offset pagination
+// and cursor pagination. Next points to the following page if HasMore is true.
+// Count is the total number of records in the listing if Zendesk returned it.
+//
+// The list methods with Page suffix in ListPageAPI return ListPage. The
+// original list methods return the results in the pagination style of the
+// endpoint for compatibility.
+type ListPage[T any] struct {
+	Items   []T
+	HasMore bool
+	Next    PageToken
+	Count   int64
+}
+
+// NewOffsetListPage creates ListPage from the result of offset paginated list method.
+// opts is the options used to fetch the page.
+func NewOffsetListPage[T any](items []T, page Page, opts PageOptions) ListPage[T] {
+	result := ListPage[T]{
+		Items:   items,
+		HasMore: page.HasNext(),
+		Count:   page.Count,
+	}
+	if !result.HasMore {
+		return result
+	}
+
+	current := opts.Page
+	if current == 0 {
+		current = 1
+	}
+	result.Next = PageToken{
+		Page:    current + 1,
+		PerPage: opts.PerPage,
+	}
+
+	// next_page URL is authoritative if it contains page number
+	if u, err := url.Parse(*page.NextPage); err == nil {
+		q := u.Query()
+		if p, err := strconv.Atoi(q.Get("page")); err == nil {
+			result.Next.Page = p
+		}
+		if p, err := strconv.Atoi(q.Get("per_page")); err == nil {
+			result.Next.PerPage = p
+		}
+	}
+	return result
+}
+
+// NewCursorListPage creates ListPage from the result of cursor paginated list method.
+// opts is the options used to fetch the page.
+func NewCursorListPage[T any](items []T, meta CursorPaginationMeta, opts CursorPagination) ListPage[T] {
+	result := ListPage[T]{
+		Items:   items,
+		HasMore: meta.HasMore,
+	}
+	if result.HasMore {
+		result.Next = PageToken{
+			CursorBased: true,
+			After:       meta.AfterCursor,
+			PageSize:    opts.PageSize,
+		}
+	}
+	return result
+}
+
+// ListPageAPI an interface containing the list methods returning ListPage.
+// It is not embedded in API since mockgen cannot generate the mock of
+// generic types.
+type ListPageAPI interface {
+	GetArticleLabelsPage(ctx context.Context, token PageToken) (ListPage[ArticleLabel], error)
+	GetArticleRevisionsPage(ctx context.Context, articleID int64, token PageToken, opts *ArticleRevisionListOptions) (ListPage[ArticleRevision], error)
+	GetArticlesPage(ctx context.Context, token PageToken, opts *ArticleListOptions) (ListPage[Article], error)
+	GetAutomationsPage(ctx context.Context, token PageToken, opts *AutomationListOptions) (ListPage[Automation], error)
+	GetBrandsPage(ctx context.Context, token PageToken, opts *BrandListOptions) (ListPage[Brand], error)
+	GetContentTagsPage(ctx context.Context, token PageToken, opts *ContentTagListOptions) (ListPage[ContentTag], error)
+	GetDynamicContentItemsPage(ctx context.Context, token PageToken) (ListPage[DynamicContentItem], error)
+	GetExternalContentRecordsPage(ctx context.Context, token PageToken) (ListPage[ExternalContentRecord], error)
+	GetExternalContentSourcesPage(ctx context.Context, token PageToken) (ListPage[ExternalContentSource], error)
+	GetGroupMembershipsPage(ctx context.Context, token PageToken, opts *GroupMembershipListOptions) (ListPage[GroupMembership], error)
+	GetGroupsPage(ctx context.Context, token PageToken, opts *GroupListOptions) (ListPage[Group], error)
+	GetMacrosPage(ctx context.Context, token PageToken, opts *MacroListOptions) (ListPage[Macro], error)
+	GetOrganizationMembershipsPage(ctx context.Context, token PageToken, opts *OrganizationMembershipListOptions) (ListPage[OrganizationMembership], error)
+	GetOrganizationsPage(ctx context.Context, token PageToken, opts *OrganizationListOptions) (ListPage[Organization], error)
+	GetPermissionGroupsPage(ctx context.Context, token PageToken) (ListPage[PermissionGroup], error)
+	GetRecipientAddressesPage(ctx context.Context, token PageToken) (ListPage[RecipientAddress], error)
+	GetSLAPoliciesPage(ctx context.Context, token PageToken, opts *SLAPolicyListOptions) (ListPage[SLAPolicy], error)
+	GetTargetsPage(ctx context.Context, token PageToken) (ListPage[Target], error)
+	GetTicketAuditsPage(ctx context.Context, ticketID int64, token PageToken) (ListPage[TicketAudit], error)
+	GetTicketFieldsPage(ctx context.Context, token PageToken) (ListPage[TicketField], error)
+	GetTicketFormsPage(ctx context.Context, token PageToken, opts *TicketFormListOptions) (ListPage[TicketForm], error)
+	GetTicketIncidentsPage(ctx context.Context, problemID int64, token PageToken) (ListPage[Ticket], error)
+	GetTicketsPage(ctx context.Context, token PageToken, opts *TicketListOptions) (ListPage[Ticket], error)
+	GetTriggersPage(ctx context.Context, token PageToken, opts *TriggerListOptions) (ListPage[Trigger], error)
+	GetUserFieldsPage(ctx context.Context, token PageToken, opts *UserFieldListOptions) (ListPage[UserField], error)
+	GetUserSegmentsPage(ctx context.Context, token PageToken) (ListPage[UserSegment], error)
+	GetUsersPage(ctx context.Context, token PageToken, opts *UserListOptions) (ListPage[User], error)
+	GetViewsPage(ctx context.Context, token PageToken) (ListPage[View], error)
+	ListGroupUsersPage(ctx context.Context, groupID int64, token PageToken, opts *UserListOptions) (ListPage[User], error)
+	SearchKnowledgePage(ctx context.Context, token PageToken, opts *KnowledgeSearchOptions) (ListPage[KnowledgeSearchResult], error)
+	SearchUsersPage(ctx context.Context, token PageToken, opts *SearchUsersOptions) (ListPage[User], error)
+}
+
+var _ ListPageAPI = (*Client)(nil)
+
+// ListFunc fetches the page of list results at the token
+type ListFunc[T any] func(ctx context.Context, token PageToken) (ListPage[T], error)
+
+// OffsetLister adapts offset paginated list method to ListFunc.
+//
+//	list := zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+//		return client.GetTickets(ctx, &zendesk.TicketListOptions{PageOptions: opts})
+//	})
+func OffsetLister[T any](list func(ctx context.Context, opts PageOptions) ([]T, Page, error)) ListFunc[T] {
+	return func(ctx context.Context, token PageToken) (ListPage[T], error) {
+		opts := token.PageOptions()
+		items, page, err := list(ctx, opts)
+		if err != nil {
+			return ListPage[T]{}, err
+		}
+		return NewOffsetListPage(items, page, opts), nil
+	}
+}
+
+// CursorLister adapts cursor paginated list method to ListFunc
+func CursorLister[T any](list func(ctx context.Context, opts CursorPagination) ([]T, CursorPaginationMeta, error)) ListFunc[T] {
+	return func(ctx context.Context, token PageToken) (ListPage[T], error) {
+		opts := token.CursorPagination()
+		items, meta, err := list(ctx, opts)
+		if err != nil {
+			return ListPage[T]{}, err
+		}
+		return NewCursorListPage(items, meta, opts), nil
+	}
+}
+
+// defaultPerPage is the number of records Zendesk returns per page if not specified
+const defaultPerPage = 100
+
+// FallbackLister returns ListFunc which fetches offset tokens with offset and
+// switches to cursor when Zendesk rejects the page with ErrOffsetPaginationLimit.
+// On switching, the listing restarts from the first cursor page and skips the
+// records already returned by the offset pages, so both listers must return
+// records in the same order.
+func FallbackLister[T any](offset ListFunc[T], cursor ListFunc[T]) ListFunc[T] {
+	return func(ctx context.Context, token PageToken) (ListPage[T], error) {
+		if token.CursorBased {
+			return cursor(ctx, token)
+		}
+
+		page, err := offset(ctx, token)
+		if !errors.Is(err, ErrOffsetPaginationLimit) {
+			return page, err
+		}
+
+		perPage := token.PerPage
+		if perPage == 0 {
+			perPage = defaultPerPage
+		}
+		skip := (token.Page - 1) * perPage
+		if skip < 0 {
+			skip = 0
+		}
+
+		next := PageToken{CursorBased: true, PageSize: perPage}
+		for {
+			page, err := cursor(ctx, next)
+			if err != nil {
+				return ListPage[T]{}, err
+			}
+
+			if skip < len(page.Items) {
+				page.Items = page.Items[skip:]
+				return page, nil
+			}
+
+			skip -= len(page.Items)
+			if !page.HasMore {
+				return ListPage[T]{Items: []T{}}, nil
+			}
+			next = page.Next
+		}
+	}
+}
+
+// ListAll fetches pages from start until the last page and returns all items
+func ListAll[T any](ctx context.Context, list ListFunc[T], start PageToken) ([]T, error) {
+	var all []T
+	token := start
+	for {
+		page, err := list(ctx, token)
+		if err != nil {
+			return all, err
+		}
+
+		all = append(all, page.Items...)
+		if !page.HasMore {
+			return all, nil
+		}
+		token = page.Next
+	}
+}
@@ -0,0 +1,227 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	token := PageToken{CursorBased: true, After: "xxx", PageSize: 100}
+
+	parsed, err := ParsePageToken(token.String())
+	if err != nil {
+		t.Fatalf("Failed to parse page token: %s", err)
+	}
+	if parsed != token {
+		t.Fatalf("expected %v, but got %v", token, parsed)
+	}
+
+	if _, err := ParsePageToken("%%%"); err == nil {
+		t.Fatal("ParsePageToken should fail for invalid token")
+	}
+
+	empty, err := ParsePageToken("")
+	if err != nil || empty != (PageToken{}) {
+		t.Fatalf("expected zero token, but got %v, %v", empty, err)
+	}
+}
+
+func TestNewOffsetListPage(t *testing.T) {
+	next := "https://example.zendesk.com/api/v2/tickets.json?page=3&per_page=50"
+	page := NewOffsetListPage([]int{1, 2}, Page{NextPage: &next}, PageOptions{Page: 2, PerPage: 50})
+
+	if !page.HasMore {
+		t.Fatal("expected HasMore to be true")
+	}
+	if page.Next.Page != 3 || page.Next.PerPage != 50 || page.Next.CursorBased {
+		t.Fatalf("unexpected next token %v", page.Next)
+	}
+
+	last := NewOffsetListPage([]int{1}, Page{}, PageOptions{Page: 3})
+	if last.HasMore {
+		t.Fatal("expected HasMore to be false")
+	}
+}
+
+func TestNewCursorListPage(t *testing.T) {
+	page := NewCursorListPage([]int{1}, CursorPaginationMeta{HasMore: true, AfterCursor: "abc"}, CursorPagination{PageSize: 10})
+
+	if !page.HasMore {
+		t.Fatal("expected HasMore to be true")
+	}
+	if page.Next.After != "abc" || page.Next.PageSize != 10 || !page.Next.CursorBased {
+		t.Fatalf("unexpected next token %v", page.Next)
+	}
+}
+
+func TestListAllWithOffsetLister(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			fmt.Fprintf(w, `{"groups":[{"id":1},{"id":2}],"next_page":"%s/groups.json?page=2"}`, "http://"+r.Host)
+		case "2":
+			fmt.Fprint(w, `{"groups":[{"id":3}],"next_page":null}`)
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	list := OffsetLister(func(ctx context.Context, opts PageOptions) ([]Group, Page, error) {
+		return client.GetGroups(ctx, &GroupListOptions{PageOptions: opts})
+	})
+
+	groups, err := ListAll(ctx, list, PageToken{})
+	if err != nil {
+		t.Fatalf("Failed to list groups: %s", err)
+	}
+	if len(groups) != 3 {
+		t.Fatalf("expected 3 groups, but got %d", len(groups))
+	}
+}
+
+func TestListAllWithCursorLister(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page[after]") {
+		case "":
+			fmt.Fprint(w, `{"comments":[{"id":1}],"meta":{"has_more":true,"after_cursor":"c1"}}`)
+		case "c1":
+			fmt.Fprint(w, `{"comments":[{"id":2}],"meta":{"has_more":false}}`)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	list := CursorLister(func(ctx context.Context, opts CursorPagination) ([]TicketComment, CursorPaginationMeta, error) {
+		result, err := client.ListTicketComments(ctx, 1, &ListTicketCommentsOptions{CursorPagination: opts})
+		if err != nil {
+			return nil, CursorPaginationMeta{}, err
+		}
+		return result.TicketComments, result.Meta, nil
+	})
+
+	comments, err := ListAll(ctx, list, PageToken{CursorBased: true})
+	if err != nil {
+		t.Fatalf("Failed to list comments: %s", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 comments, but got %d", len(comments))
+	}
+}
+
+func TestFallbackLister(t *testing.T) {
+	records := []int{1, 2, 3, 4, 5}
+	offset := func(ctx context.Context, token PageToken) (ListPage[int], error) {
+		if token.Page > 1 {
+			return ListPage[int]{}, Error{
+				body: []byte("Offset pagination is not supported beyond this page. Use cursor pagination."),
+				resp: &http.Response{StatusCode: http.StatusBadRequest},
+			}
+		}
+		return ListPage[int]{Items: records[:2], HasMore: true, Next: PageToken{Page: 2, PerPage: 2}}, nil
+	}
+	cursor := func(ctx context.Context, token PageToken) (ListPage[int], error) {
+		start := 0
+		if token.After != "" {
+			fmt.Sscanf(token.After, "%d", &start)
+		}
+		end := start + token.PageSize
+		if end >= len(records) {
+			return ListPage[int]{Items: records[start:]}, nil
+		}
+		return ListPage[int]{
+			Items:   records[start:end],
+			HasMore: true,
+			Next:    PageToken{CursorBased: true, After: fmt.Sprint(end), PageSize: token.PageSize},
+		}, nil
+	}
+
+	all, err := ListAll(ctx, FallbackLister(offset, cursor), PageToken{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Failed to list: %s", err)
+	}
+	if fmt.Sprint(all) != fmt.Sprint(records) {
+		t.Fatalf("expected %v, but got %v", records, all)
+	}
+}
+
+func TestGetTicketsPage(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("sort_by") != "id" || q.Get("page") != "2" || q.Get("per_page") != "1" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprintf(w, `{"tickets":[{"id":2}],"next_page":"%s/tickets.json?page=3&per_page=1","count":3}`, "http://"+r.Host)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	page, err := client.GetTicketsPage(ctx, PageToken{Page: 2, PerPage: 1}, &TicketListOptions{
+		PageOptions: PageOptions{Page: 5},
+		SortBy:      TicketSortByID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get tickets page: %s", err)
+	}
+	if len(page.Items) != 1 || page.Items[0].ID != 2 || page.Count != 3 {
+		t.Fatalf("unexpected page %+v", page)
+	}
+	if !page.HasMore || page.Next != (PageToken{Page: 3, PerPage: 1}) {
+		t.Fatalf("unexpected next token %v", page.Next)
+	}
+}
+
+func TestGetTicketFieldsPage(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"ticket_fields":[{"id":1}],"next_page":"%s/ticket_fields.json?page=2"}`, "http://"+r.Host)
+		case "2":
+			fmt.Fprint(w, `{"ticket_fields":[{"id":2}],"next_page":null}`)
+		default:
+			t.Fatalf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	fields, err := ListAll(ctx, client.GetTicketFieldsPage, PageToken{})
+	if err != nil {
+		t.Fatalf("Failed to list ticket fields: %s", err)
+	}
+	if len(fields) != 2 || fields[1].ID != 2 {
+		t.Fatalf("unexpected ticket fields %v", fields)
+	}
+}
+
+func TestGetContentTagsPage(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("filter[name_prefix]") != "pro" || q.Get("page[size]") != "1" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		switch q.Get("page[after]") {
+		case "":
+			fmt.Fprint(w, `{"records":[{"id":"a"}],"meta":{"has_more":true,"after_cursor":"c1"}}`)
+		case "c1":
+			fmt.Fprint(w, `{"records":[{"id":"b"}],"meta":{"has_more":false}}`)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	list := func(ctx context.Context, token PageToken) (ListPage[ContentTag], error) {
+		return client.GetContentTagsPage(ctx, token, &ContentTagListOptions{NamePrefix: "pro"})
+	}
+	tags, err := ListAll(ctx, list, PageToken{PageSize: 1})
+	if err != nil {
+		t.Fatalf("Failed to list content tags: %s", err)
+	}
+	if len(tags) != 2 || tags[1].ID != "b" {
+		t.Fatalf("unexpected content tags %v", tags)
+	}
+}
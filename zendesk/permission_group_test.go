@@ -0,0 +1,41 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetPermissionGroups(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "permission_groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, page, err := client.GetPermissionGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get permission groups: %s", err)
+	}
+	if len(groups) != 2 || page.Count != 2 {
+		t.Fatalf("unexpected permission groups %v", groups)
+	}
+	if len(groups[1].Edit) != 2 || groups[1].Publish[0] != 12 {
+		t.Fatalf("unexpected permission group %v", groups[1])
+	}
+}
+
+func TestUpdatePermissionGroup(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "permission_group.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	group, err := client.UpdatePermissionGroup(ctx, 1651, PermissionGroup{
+		Name:    "Writers",
+		Edit:    []int64{12, 13, 14},
+		Publish: []int64{12},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update permission group: %s", err)
+	}
+	if len(group.Edit) != 3 {
+		t.Fatalf("unexpected permission group %v", group)
+	}
+}
@@ -0,0 +1,269 @@
+package zendesk
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PlaceholderData is the ticket and its related resources which placeholders
+// of macros and trigger notifications refer to. The resources not given are
+// rendered as empty.
+type PlaceholderData struct {
+	Ticket       Ticket
+	Requester    *User
+	Assignee     *User
+	Submitter    *User
+	CurrentUser  *User
+	Organization *Organization
+	Group        *Group
+	// Comments are the comments of the ticket in chronological order
+	Comments []TicketComment
+	// Subdomain is used for ticket.url and ticket.link if Ticket.URL is empty
+	Subdomain string
+}
+
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([a-z0-9_.]+)\s*\}\}`)
+
+// RenderPlaceholders replaces Zendesk placeholders such as {{ticket.title}} and
+// {{ticket.requester.first_name}} in text with the values of data, so that
+// preview tooling can show what a macro or trigger notification will produce.
+// The placeholders which are not supported are kept as they are and returned
+// as unknown. Liquid tags and filters are not supported.
+func RenderPlaceholders(text string, data PlaceholderData) (rendered string, unknown []string) {
+	rendered = placeholderRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		name := placeholderRegexp.FindStringSubmatch(s)[1]
+		value, ok := data.placeholder(name)
+		if !ok {
+			unknown = append(unknown, name)
+			return s
+		}
+		return value
+	})
+	return rendered, unknown
+}
+
+func (d PlaceholderData) placeholder(name string) (string, bool) {
+	t := d.Ticket
+	switch {
+	case strings.HasPrefix(name, "ticket.requester."):
+		return userPlaceholder(d.Requester, strings.TrimPrefix(name, "ticket.requester."))
+	case strings.HasPrefix(name, "ticket.assignee."):
+		return userPlaceholder(d.Assignee, strings.TrimPrefix(name, "ticket.assignee."))
+	case strings.HasPrefix(name, "ticket.submitter."):
+		return userPlaceholder(d.Submitter, strings.TrimPrefix(name, "ticket.submitter."))
+	case strings.HasPrefix(name, "current_user."):
+		return userPlaceholder(d.CurrentUser, strings.TrimPrefix(name, "current_user."))
+	case strings.HasPrefix(name, "ticket.organization."):
+		return organizationPlaceholder(d.Organization, strings.TrimPrefix(name, "ticket.organization."))
+	case strings.HasPrefix(name, "ticket.ticket_field_"):
+		id, err := strconv.ParseInt(strings.TrimPrefix(name, "ticket.ticket_field_"), 10, 64)
+		if err != nil {
+			return "", false
+		}
+		for _, f := range t.CustomFields {
+			if f.ID == id {
+				return formatPlaceholderValue(f.Value), true
+			}
+		}
+		return "", true
+	}
+
+	switch name {
+	case "ticket.id":
+		return formatPlaceholderID(t.ID), true
+	case "ticket.title":
+		return t.Subject, true
+	case "ticket.description":
+		return t.Description, true
+	case "ticket.external_id":
+		return t.ExternalID, true
+	case "ticket.status":
+		return capitalize(string(t.Status)), true
+	case "ticket.priority":
+		return capitalize(string(t.Priority)), true
+	case "ticket.ticket_type":
+		return capitalize(string(t.Type)), true
+	case "ticket.tags":
+		return strings.Join(t.Tags, " "), true
+	case "ticket.via":
+		if t.Via == nil {
+			return "", true
+		}
+		return capitalize(strings.ReplaceAll(t.Via.Channel, "_", " ")), true
+	case "ticket.due_date":
+		return formatPlaceholderDate(t.DueAt), true
+	case "ticket.created_at":
+		return formatPlaceholderDate(t.CreatedAt), true
+	case "ticket.updated_at":
+		return formatPlaceholderDate(t.UpdatedAt), true
+	case "ticket.url":
+		return d.agentTicketURL(), true
+	case "ticket.link":
+		if u := d.agentTicketURL(); u != "" {
+			return "https://" + u, true
+		}
+		return "", true
+	case "ticket.group.name":
+		if d.Group == nil {
+			return "", true
+		}
+		return d.Group.Name, true
+	case "ticket.latest_comment":
+		return d.latestComment(false), true
+	case "ticket.latest_public_comment":
+		return d.latestComment(true), true
+	}
+	return "", false
+}
+
+// agentTicketURL returns the URL of the ticket in agent interface without scheme
+func (d PlaceholderData) agentTicketURL() string {
+	host := ""
+	if u, err := url.Parse(d.Ticket.URL); err == nil && u.Host != "" {
+		host = u.Host
+	} else if d.Subdomain != "" {
+		host = d.Subdomain + ".zendesk.com"
+	}
+	if host == "" || d.Ticket.ID == 0 {
+		return ""
+	}
+	return host + "/agent/tickets/" + strconv.FormatInt(d.Ticket.ID, 10)
+}
+
+func (d PlaceholderData) latestComment(publicOnly bool) string {
+	for i := len(d.Comments) - 1; i >= 0; i-- {
+		c := d.Comments[i]
+		if publicOnly && c.Public != nil && !*c.Public {
+			continue
+		}
+		return c.PlainText()
+	}
+	return ""
+}
+
+func userPlaceholder(u *User, name string) (string, bool) {
+	if strings.HasPrefix(name, "custom_fields.") {
+		if u == nil {
+			return "", true
+		}
+		return formatPlaceholderValue(u.UserFields[strings.TrimPrefix(name, "custom_fields.")]), true
+	}
+
+	var user User
+	if u != nil {
+		user = *u
+	}
+	names := strings.Fields(user.Name)
+
+	switch name {
+	case "id":
+		return formatPlaceholderID(user.ID), true
+	case "name":
+		return user.Name, true
+	case "first_name":
+		if len(names) == 0 {
+			return "", true
+		}
+		return names[0], true
+	case "last_name":
+		if len(names) < 2 {
+			return "", true
+		}
+		return names[len(names)-1], true
+	case "email":
+		return user.Email, true
+	case "phone":
+		return user.Phone, true
+	case "language", "locale":
+		return user.Locale, true
+	case "external_id":
+		return user.ExternalID, true
+	case "details":
+		return user.Details, true
+	case "notes":
+		return user.Notes, true
+	case "signature":
+		return user.Signature, true
+	case "role":
+		return user.Role, true
+	case "tags":
+		return strings.Join(user.Tags, " "), true
+	}
+	return "", false
+}
+
+func organizationPlaceholder(o *Organization, name string) (string, bool) {
+	if strings.HasPrefix(name, "custom_fields.") {
+		if o == nil {
+			return "", true
+		}
+		return formatPlaceholderValue(o.OrganizationFields[strings.TrimPrefix(name, "custom_fields.")]), true
+	}
+
+	var org Organization
+	if o != nil {
+		org = *o
+	}
+
+	switch name {
+	case "id":
+		return formatPlaceholderID(org.ID), true
+	case "name":
+		return org.Name, true
+	case "external_id":
+		return org.ExternalID, true
+	case "details":
+		return org.Details, true
+	case "notes":
+		return org.Notes, true
+	case "tags":
+		return strings.Join(org.Tags, " "), true
+	}
+	return "", false
+}
+
+func formatPlaceholderID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// formatPlaceholderDate formats t like Zendesk, e.g. "October 17, 2026"
+func formatPlaceholderDate(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format("January 2, 2006")
+}
+
+func formatPlaceholderValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	}
+	if values, ok := customFieldMulti(v); ok {
+		return strings.Join(values, " ")
+	}
+	return ""
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRenderPlaceholders(t *testing.T) {
+	dueAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	public, private := true, false
+	data := PlaceholderData{
+		Ticket: Ticket{
+			ID:       35436,
+			URL:      "https://example.zendesk.com/api/v2/tickets/35436.json",
+			Subject:  "Printer is on fire",
+			Status:   TicketStatusPending,
+			Priority: TicketPriorityUrgent,
+			Tags:     []string{"printer", "fire"},
+			DueAt:    &dueAt,
+			CustomFields: []CustomField{
+				{ID: 27642, Value: "745"},
+				{ID: 27648, Value: []interface{}{"a", "b"}},
+			},
+		},
+		Requester:    &User{Name: "Jane Q Doe", Email: "jane@example.com", UserFields: UserFields{"plan": "premium"}},
+		Organization: &Organization{Name: "Acme", OrganizationFields: OrganizationFields{"tier": "gold"}},
+		Group:        &Group{Name: "Support"},
+		Comments: []TicketComment{
+			{Body: "public reply", Public: &public},
+			{HTMLBody: "<p>internal <b>note</b></p>", Public: &private},
+		},
+	}
+
+	text := "Hi {{ticket.requester.first_name}} {{ticket.requester.last_name}} ({{ticket.requester.custom_fields.plan}}),\n" +
+		"#{{ ticket.id }} {{ticket.title}} is {{ticket.status}}/{{ticket.priority}} due {{ticket.due_date}} " +
+		"[{{ticket.tags}}] {{ticket.ticket_field_27642}} {{ticket.ticket_field_27648}} {{ticket.ticket_field_1}}\n" +
+		"{{ticket.organization.name}}/{{ticket.organization.custom_fields.tier}} {{ticket.group.name}} {{ticket.assignee.name}}\n" +
+		"{{ticket.link}}\n{{ticket.latest_comment}} | {{ticket.latest_public_comment}} {{ticket.unknown}} {{satisfaction.rating_url}}"
+	expected := "Hi Jane Doe (premium),\n" +
+		"#35436 Printer is on fire is Pending/Urgent due October 17, 2026 " +
+		"[printer fire] 745 a b \n" +
+		"Acme/gold Support \n" +
+		"https://example.zendesk.com/agent/tickets/35436\ninternal note | public reply {{ticket.unknown}} {{satisfaction.rating_url}}"
+
+	rendered, unknown := RenderPlaceholders(text, data)
+	if rendered != expected {
+		t.Fatalf("unexpected rendered text\n got: %q\nwant: %q", rendered, expected)
+	}
+	if !reflect.DeepEqual(unknown, []string{"ticket.unknown", "satisfaction.rating_url"}) {
+		t.Fatalf("unexpected unknown placeholders %v", unknown)
+	}
+}
+
+func TestRenderPlaceholdersWithSubdomain(t *testing.T) {
+	rendered, _ := RenderPlaceholders("{{ticket.url}}", PlaceholderData{Ticket: Ticket{ID: 1}, Subdomain: "example"})
+	if rendered != "example.zendesk.com/agent/tickets/1" {
+		t.Fatalf("unexpected url %s", rendered)
+	}
+}
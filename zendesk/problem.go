@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrProblemNotSolved is returned by SolveIncidents when the problem is not solved or closed
+var ErrProblemNotSolved = errors.New("zendesk: problem is not solved")
+
+// ProblemAPI an interface containing the methods managing problems and their incidents
+type ProblemAPI interface {
+	GetTicketIncidents(ctx context.Context, problemID int64, opts *PageOptions) ([]Ticket, Page, error)
+	LinkIncidents(ctx context.Context, problemID int64, incidentIDs []int64, opts *BatchOptions) (BatchResult, error)
+	SolveIncidents(ctx context.Context, problemID int64, comment *TicketComment, opts *BatchOptions) (BatchResult, error)
+}
+
+// GetTicketIncidents fetches the incidents linked to the problem
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-ticket-incidents
+func (z *Client) GetTicketIncidents(ctx context.Context, problemID int64, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/incidents.json", problemID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+// GetTicketIncidentsPage fetches the page of incidents of the problem at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetTicketIncidentsPage(ctx context.Context, problemID int64, token PageToken) (ListPage[Ticket], error) {
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Ticket, Page, error) {
+		return z.GetTicketIncidents(ctx, problemID, &opts)
+	})(ctx, token)
+}
+
+// LinkIncidents turns the tickets into incidents of the problem with
+// UpdateManyTickets in batches, and waits until all the jobs finish.
+// Index of each result is the position of the ticket in incidentIDs.
+func (z *Client) LinkIncidents(ctx context.Context, problemID int64, incidentIDs []int64, opts *BatchOptions) (BatchResult, error) {
+	tickets := make([]Ticket, 0, len(incidentIDs))
+	for _, id := range incidentIDs {
+		tickets = append(tickets, Ticket{ID: id, Type: TicketTypeIncident, ProblemID: problemID})
+	}
+	return SubmitInBatches(ctx, z, tickets, z.UpdateManyTickets, opts)
+}
+
+// SolveIncidents solves the incidents of the solved or closed problem which
+// are still unsolved, e.g. the ones linked after the problem was solved, with
+// the comment if not nil, and waits until all the jobs finish.
+// ErrProblemNotSolved is returned if the problem is not solved yet.
+func (z *Client) SolveIncidents(ctx context.Context, problemID int64, comment *TicketComment, opts *BatchOptions) (BatchResult, error) {
+	problem, err := z.GetTicket(ctx, problemID)
+	if err != nil {
+		return BatchResult{}, err
+	}
+	if !problem.IsSolved() {
+		return BatchResult{}, fmt.Errorf("%w: ticket %d is %s", ErrProblemNotSolved, problemID, problem.Status)
+	}
+
+	list := OffsetLister(func(ctx context.Context, opts PageOptions) ([]Ticket, Page, error) {
+		return z.GetTicketIncidents(ctx, problemID, &opts)
+	})
+	incidents, err := ListAll(ctx, list, PageToken{})
+	if err != nil {
+		return BatchResult{}, err
+	}
+
+	var updates []Ticket
+	for _, incident := range incidents {
+		if incident.IsSolved() {
+			continue
+		}
+		updates = append(updates, Ticket{ID: incident.ID, Status: TicketStatusSolved, Comment: comment})
+	}
+	return SubmitInBatches(ctx, z, updates, z.UpdateManyTickets, opts)
+}
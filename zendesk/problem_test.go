@@ -0,0 +1,87 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newProblemMockAPI(t *testing.T, updated *[]Ticket) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tickets/1.json":
+			w.Write([]byte(`{"ticket": {"id": 1, "type": "problem", "status": "solved"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/tickets/5.json":
+			w.Write([]byte(`{"ticket": {"id": 5, "type": "problem", "status": "open"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/tickets/1/incidents.json":
+			w.Write([]byte(`{"tickets": [
+				{"id": 2, "type": "incident", "status": "open", "problem_id": 1},
+				{"id": 3, "type": "incident", "status": "solved", "problem_id": 1},
+				{"id": 4, "type": "incident", "status": "pending", "problem_id": 1}
+			], "next_page": null}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/tickets/update_many.json":
+			var payload struct {
+				Tickets []Ticket `json:"tickets"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request: %s", err)
+			}
+			*updated = append(*updated, payload.Tickets...)
+			w.Write([]byte(`{"job_status": {"id": "a", "status": "completed"}}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+}
+
+func TestLinkIncidents(t *testing.T) {
+	var updated []Ticket
+	mockAPI := newProblemMockAPI(t, &updated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.LinkIncidents(ctx, 1, []int64{2, 3}, &BatchOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to link incidents: %s", err)
+	}
+	if len(updated) != 2 || updated[1].ID != 3 || updated[1].ProblemID != 1 || updated[1].Type != TicketTypeIncident {
+		t.Fatalf("unexpected updates %v", updated)
+	}
+}
+
+func TestSolveIncidents(t *testing.T) {
+	var updated []Ticket
+	mockAPI := newProblemMockAPI(t, &updated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comment := NewPublicTicketComment("Fixed", 0)
+	_, err := client.SolveIncidents(ctx, 1, &comment, nil)
+	if err != nil {
+		t.Fatalf("Failed to solve incidents: %s", err)
+	}
+	if len(updated) != 2 || updated[0].ID != 2 || updated[1].ID != 4 {
+		t.Fatalf("unexpected updates %v", updated)
+	}
+	if updated[0].Status != TicketStatusSolved || updated[0].Comment == nil || updated[0].Comment.Body != "Fixed" {
+		t.Fatalf("unexpected update %v", updated[0])
+	}
+}
+
+func TestSolveIncidentsOfUnsolvedProblem(t *testing.T) {
+	var updated []Ticket
+	mockAPI := newProblemMockAPI(t, &updated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SolveIncidents(ctx, 5, nil, nil)
+	if !errors.Is(err, ErrProblemNotSolved) {
+		t.Fatalf("expected problem not solved error, but got %v", err)
+	}
+	if len(updated) != 0 {
+		t.Fatalf("unexpected updates %v", updated)
+	}
+}
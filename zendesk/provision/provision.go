@@ -0,0 +1,147 @@
+// Package provision reconciles the configuration of a Zendesk account with
+// desired-state definitions, like a small Terraform embedded in the library.
+//
+// Resources are matched by their name or title. Fields present in the JSON form
+// of the desired definition are compared with the live resource, including the
+// zero values of the fields without omitempty, and the resource is created or
+// updated when they differ. Resources missing from the definitions are
+// deleted only with Options.Prune.
+//
+//	plan, err := provision.Reconcile(ctx, client, provision.Config{
+//		Groups: []zendesk.Group{{Name: "Support"}},
+//	}, provision.Options{DryRun: true})
+//	fmt.Println(plan)
+package provision
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Config is the desired state of the account
+type Config struct {
+	Groups       []zendesk.Group
+	TicketFields []zendesk.TicketField
+	TicketForms  []zendesk.TicketForm
+	SLAPolicies  []zendesk.SLAPolicy
+	Triggers     []zendesk.Trigger
+	Automations  []zendesk.Automation
+	Macros       []zendesk.Macro
+}
+
+// Options is options of Reconcile
+type Options struct {
+	// DryRun only plans the changes without applying them
+	DryRun bool
+	// Prune deletes the resources which are not in Config.
+	// Only the kinds of resources with at least one definition are pruned, and
+	// the ones which cannot be deleted such as system ticket fields, the
+	// default group and the default ticket form are kept.
+	Prune bool
+}
+
+// ChangeKind is the kind of Change
+type ChangeKind string
+
+const (
+	// Create creates the resource
+	Create ChangeKind = "create"
+	// Update updates the resource
+	Update ChangeKind = "update"
+	// Delete deletes the resource
+	Delete ChangeKind = "delete"
+)
+
+// Change is a change to a resource in Plan
+type Change struct {
+	Kind     ChangeKind
+	Resource string
+	Name     string
+	// ID is zero for Create
+	ID int64
+	// Detail describes the fields to change for Update
+	Detail string
+
+	apply func(ctx context.Context) error
+}
+
+// String returns a line describing the change
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %q", c.Kind, c.Resource, c.Name)
+	if c.ID != 0 {
+		s += fmt.Sprintf(" (%d)", c.ID)
+	}
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+	return s
+}
+
+// Plan is the list of changes in the order they are applied
+type Plan []Change
+
+// Empty reports whether the account is already in the desired state
+func (p Plan) Empty() bool {
+	return len(p) == 0
+}
+
+// String returns the changes, one per line
+func (p Plan) String() string {
+	lines := make([]string, len(p))
+	for i, c := range p {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Apply applies the changes in order and stops at the first error
+func (p Plan) Apply(ctx context.Context) error {
+	for _, c := range p {
+		if err := c.apply(ctx); err != nil {
+			return fmt.Errorf("failed to %s: %w", c, err)
+		}
+	}
+	return nil
+}
+
+// Reconcile plans the changes to bring the account to config and applies them
+// unless opts.DryRun is set. The plan is returned in both cases.
+//
+// Resources are created and updated in dependency order, groups and fields
+// first, and deleted in the reverse order after that.
+func Reconcile(ctx context.Context, api zendesk.API, config Config, opts Options) (Plan, error) {
+	kinds := []planner{
+		groups(api, config.Groups),
+		ticketFields(api, config.TicketFields),
+		ticketForms(api, config.TicketForms),
+		slaPolicies(api, config.SLAPolicies),
+		triggers(api, config.Triggers),
+		automations(api, config.Automations),
+		macros(api, config.Macros),
+	}
+
+	var plan, deletions Plan
+	for _, k := range kinds {
+		changes, err := k.plan(ctx, opts.Prune)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range changes {
+			if c.Kind == Delete {
+				deletions = append(Plan{c}, deletions...)
+			} else {
+				plan = append(plan, c)
+			}
+		}
+	}
+	plan = append(plan, deletions...)
+
+	if opts.DryRun {
+		return plan, nil
+	}
+	return plan, plan.Apply(ctx)
+}
@@ -0,0 +1,161 @@
+package provision
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+var ctx = context.Background()
+
+func TestReconcileDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+
+	api.EXPECT().GetGroups(gomock.Any(), gomock.Any()).Return([]zendesk.Group{
+		{ID: 1, Name: "Support", Description: "old"},
+		{ID: 2, Name: "Billing"},
+		{ID: 3, Name: "Legacy"},
+	}, zendesk.Page{}, nil)
+
+	var trigger zendesk.Trigger
+	trigger.Title = "Notify"
+	trigger.Active = true
+	trigger.Conditions.All = []zendesk.TriggerCondition{{Field: "status", Operator: "is", Value: "new"}}
+	live := trigger
+	live.ID = 10
+	api.EXPECT().GetTriggers(gomock.Any(), gomock.Any()).Return([]zendesk.Trigger{live}, zendesk.Page{}, nil)
+
+	plan, err := Reconcile(ctx, api, Config{
+		Groups: []zendesk.Group{
+			{Name: "Support", Description: "Tier 1"},
+			{Name: "Billing"},
+			{Name: "Sales"},
+		},
+		Triggers: []zendesk.Trigger{trigger},
+	}, Options{DryRun: true, Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	expected := strings.Join([]string{
+		`update group "Support" (1): description`,
+		`create group "Sales"`,
+		`delete group "Legacy" (3)`,
+	}, "\n")
+	if plan.String() != expected {
+		t.Fatalf("expected plan\n%s\nbut got\n%s", expected, plan)
+	}
+}
+
+func TestReconcilePruneProtected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+
+	api.EXPECT().GetGroups(gomock.Any(), gomock.Any()).Return([]zendesk.Group{
+		{ID: 1, Name: "Support", Default: true},
+		{ID: 2, Name: "Billing"},
+		{ID: 3, Name: "Legacy"},
+	}, zendesk.Page{}, nil)
+	next := "https://example.zendesk.com/api/v2/ticket_fields.json?page=2"
+	api.EXPECT().GetTicketFieldsWithOptions(gomock.Any(), &zendesk.TicketFieldListOptions{}).Return([]zendesk.TicketField{
+		{ID: 10, Title: "Subject", Type: "subject"},
+		{ID: 11, Title: "Product", Type: "tagger", Removable: true},
+	}, zendesk.Page{NextPage: &next}, nil)
+	api.EXPECT().GetTicketFieldsWithOptions(gomock.Any(), &zendesk.TicketFieldListOptions{
+		PageOptions: zendesk.PageOptions{Page: 2},
+	}).Return([]zendesk.TicketField{
+		{ID: 12, Title: "Old", Type: "text", Removable: true},
+	}, zendesk.Page{}, nil)
+	api.EXPECT().GetTicketForms(gomock.Any(), gomock.Any()).Return([]zendesk.TicketForm{
+		{ID: 20, Name: "Default", Default: true},
+		{ID: 21, Name: "Web"},
+	}, zendesk.Page{}, nil)
+
+	plan, err := Reconcile(ctx, api, Config{
+		Groups:       []zendesk.Group{{Name: "Billing"}},
+		TicketFields: []zendesk.TicketField{{Title: "Product", Type: "tagger"}},
+		TicketForms:  []zendesk.TicketForm{{Name: "Web"}},
+	}, Options{DryRun: true, Prune: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	expected := strings.Join([]string{
+		`delete ticket field "Old" (12)`,
+		`delete group "Legacy" (3)`,
+	}, "\n")
+	if plan.String() != expected {
+		t.Fatalf("expected plan\n%s\nbut got\n%s", expected, plan)
+	}
+}
+
+func TestReconcileApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+
+	api.EXPECT().GetGroups(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetMacros(gomock.Any(), gomock.Any()).Return([]zendesk.Macro{
+		{ID: 5, Title: "Close", Actions: []zendesk.MacroAction{{Field: "status", Value: "open"}}},
+	}, zendesk.Page{}, nil)
+
+	gomock.InOrder(
+		api.EXPECT().CreateGroup(gomock.Any(), zendesk.Group{Name: "Support"}).Return(zendesk.Group{ID: 1, Name: "Support"}, nil),
+		api.EXPECT().UpdateMacro(gomock.Any(), int64(5), gomock.Any()).Return(zendesk.Macro{}, nil),
+	)
+
+	plan, err := Reconcile(ctx, api, Config{
+		Groups: []zendesk.Group{{Name: "Support"}},
+		Macros: []zendesk.Macro{
+			{Title: "Close", Actions: []zendesk.MacroAction{{Field: "status", Value: "solved"}}},
+		},
+	}, Options{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+	if len(plan) != 2 {
+		t.Fatalf("expected 2 changes, but got\n%s", plan)
+	}
+}
+
+func TestReconcileComparesZeroValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+
+	api.EXPECT().GetMacros(gomock.Any(), gomock.Any()).Return([]zendesk.Macro{
+		{ID: 5, Title: "Close", Active: true, Actions: []zendesk.MacroAction{{Field: "status", Value: "solved"}}},
+	}, zendesk.Page{}, nil)
+
+	plan, err := Reconcile(ctx, api, Config{
+		Macros: []zendesk.Macro{
+			{Title: "Close", Active: false, Actions: []zendesk.MacroAction{{Field: "status", Value: "solved"}}},
+		},
+	}, Options{DryRun: true})
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	expected := `update macro "Close" (5): active`
+	if plan.String() != expected {
+		t.Fatalf("expected plan\n%s\nbut got\n%s", expected, plan)
+	}
+}
+
+func TestReconcileNoChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+
+	api.EXPECT().GetGroups(gomock.Any(), gomock.Any()).Return([]zendesk.Group{{ID: 1, Name: "Support", Default: true}}, zendesk.Page{}, nil)
+
+	plan, err := Reconcile(ctx, api, Config{Groups: []zendesk.Group{{Name: "Support"}}}, Options{})
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+	if !plan.Empty() {
+		t.Fatalf("expected empty plan, but got\n%s", plan)
+	}
+}
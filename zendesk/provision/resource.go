@@ -0,0 +1,337 @@
+package provision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// planner plans the changes of a kind of resource
+type planner interface {
+	plan(ctx context.Context, prune bool) (Plan, error)
+}
+
+// resource describes how to reconcile a kind of resource
+type resource[T any] struct {
+	name    string
+	desired []T
+	key     func(T) string
+	id      func(T) int64
+	list    func(ctx context.Context) ([]T, error)
+	create  func(ctx context.Context, item T) error
+	update  func(ctx context.Context, id int64, item T) error
+	delete  func(ctx context.Context, id int64) error
+	// diff returns the description of differences, or empty string if none.
+	// diffFields is used if nil.
+	diff func(desired, actual T) string
+	// protected reports whether the item cannot be deleted, so it is never pruned
+	protected func(T) bool
+}
+
+func (r resource[T]) plan(ctx context.Context, prune bool) (Plan, error) {
+	if len(r.desired) == 0 {
+		return nil, nil
+	}
+
+	actual, err := r.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", r.name, err)
+	}
+
+	live := map[string]T{}
+	for _, item := range actual {
+		live[r.key(item)] = item
+	}
+
+	diff := r.diff
+	if diff == nil {
+		diff = func(desired, actual T) string { return diffFields(desired, actual) }
+	}
+
+	var plan Plan
+	wanted := map[string]bool{}
+	for _, item := range r.desired {
+		item := item
+		key := r.key(item)
+		wanted[key] = true
+
+		current, ok := live[key]
+		if !ok {
+			plan = append(plan, Change{
+				Kind:     Create,
+				Resource: r.name,
+				Name:     key,
+				apply:    func(ctx context.Context) error { return r.create(ctx, item) },
+			})
+			continue
+		}
+
+		if detail := diff(item, current); detail != "" {
+			id := r.id(current)
+			plan = append(plan, Change{
+				Kind:     Update,
+				Resource: r.name,
+				Name:     key,
+				ID:       id,
+				Detail:   detail,
+				apply:    func(ctx context.Context) error { return r.update(ctx, id, item) },
+			})
+		}
+	}
+
+	if prune {
+		for _, item := range actual {
+			key := r.key(item)
+			if wanted[key] || (r.protected != nil && r.protected(item)) {
+				continue
+			}
+			id := r.id(item)
+			plan = append(plan, Change{
+				Kind:     Delete,
+				Resource: r.name,
+				Name:     key,
+				ID:       id,
+				apply:    func(ctx context.Context) error { return r.delete(ctx, id) },
+			})
+		}
+	}
+	return plan, nil
+}
+
+// ignoredFields are read only fields never compared
+var ignoredFields = map[string]bool{
+	"id":         true,
+	"url":        true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// diffFields compares the fields present in the JSON form of desired with actual.
+// The fields omitted by omitempty and null fields are unset, but the zero values
+// such as false and 0 are compared since they are sent on update.
+// Triggers and automations are compared by zendesk.DiffTriggers and zendesk.DiffAutomations instead.
+func diffFields(desired, actual interface{}) string {
+	want, err := toFields(desired)
+	if err != nil {
+		return err.Error()
+	}
+	got, err := toFields(actual)
+	if err != nil {
+		return err.Error()
+	}
+
+	var changed []string
+	for k, v := range want {
+		if ignoredFields[k] || v == nil {
+			continue
+		}
+		if !reflect.DeepEqual(v, got[k]) {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+	return strings.Join(changed, ", ")
+}
+
+func toFields(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	err = json.Unmarshal(b, &fields)
+	return fields, err
+}
+
+func ruleDiff(diff zendesk.RuleDiff) string {
+	if diff.Empty() {
+		return ""
+	}
+	paths := []string{}
+	seen := map[string]bool{}
+	for _, c := range diff {
+		if !seen[c.Path] {
+			seen[c.Path] = true
+			paths = append(paths, c.Path)
+		}
+	}
+	return strings.Join(paths, ", ")
+}
+
+func groups(api zendesk.API, desired []zendesk.Group) planner {
+	return resource[zendesk.Group]{
+		name:    "group",
+		desired: desired,
+		key:     func(g zendesk.Group) string { return g.Name },
+		id:      func(g zendesk.Group) int64 { return g.ID },
+		list: func(ctx context.Context) ([]zendesk.Group, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Group, zendesk.Page, error) {
+				return api.GetGroups(ctx, &zendesk.GroupListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, g zendesk.Group) error {
+			_, err := api.CreateGroup(ctx, g)
+			return err
+		},
+		update: func(ctx context.Context, id int64, g zendesk.Group) error {
+			_, err := api.UpdateGroup(ctx, id, g)
+			return err
+		},
+		delete: api.DeleteGroup,
+		// the default group cannot be deleted
+		protected: func(g zendesk.Group) bool { return g.Default },
+	}
+}
+
+func ticketFields(api zendesk.API, desired []zendesk.TicketField) planner {
+	return resource[zendesk.TicketField]{
+		name:    "ticket field",
+		desired: desired,
+		key:     func(f zendesk.TicketField) string { return f.Title },
+		id:      func(f zendesk.TicketField) int64 { return f.ID },
+		list: func(ctx context.Context) ([]zendesk.TicketField, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.TicketField, zendesk.Page, error) {
+				return api.GetTicketFieldsWithOptions(ctx, &zendesk.TicketFieldListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, f zendesk.TicketField) error {
+			_, err := api.CreateTicketField(ctx, f)
+			return err
+		},
+		update: func(ctx context.Context, id int64, f zendesk.TicketField) error {
+			_, err := api.UpdateTicketField(ctx, id, f)
+			return err
+		},
+		delete: api.DeleteTicketField,
+		// system fields such as subject and status cannot be deleted
+		protected: func(f zendesk.TicketField) bool { return !f.Removable },
+	}
+}
+
+func ticketForms(api zendesk.API, desired []zendesk.TicketForm) planner {
+	return resource[zendesk.TicketForm]{
+		name:    "ticket form",
+		desired: desired,
+		key:     func(f zendesk.TicketForm) string { return f.Name },
+		id:      func(f zendesk.TicketForm) int64 { return f.ID },
+		list: func(ctx context.Context) ([]zendesk.TicketForm, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+				return api.GetTicketForms(ctx, &zendesk.TicketFormListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, f zendesk.TicketForm) error {
+			_, err := api.CreateTicketForm(ctx, f)
+			return err
+		},
+		update: func(ctx context.Context, id int64, f zendesk.TicketForm) error {
+			_, err := api.UpdateTicketForm(ctx, id, f)
+			return err
+		},
+		delete: api.DeleteTicketForm,
+		// the default form cannot be deleted
+		protected: func(f zendesk.TicketForm) bool { return f.Default },
+	}
+}
+
+func slaPolicies(api zendesk.API, desired []zendesk.SLAPolicy) planner {
+	return resource[zendesk.SLAPolicy]{
+		name:    "SLA policy",
+		desired: desired,
+		key:     func(p zendesk.SLAPolicy) string { return p.Title },
+		id:      func(p zendesk.SLAPolicy) int64 { return p.ID },
+		list: func(ctx context.Context) ([]zendesk.SLAPolicy, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+				return api.GetSLAPolicies(ctx, &zendesk.SLAPolicyListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, p zendesk.SLAPolicy) error {
+			_, err := api.CreateSLAPolicy(ctx, p)
+			return err
+		},
+		update: func(ctx context.Context, id int64, p zendesk.SLAPolicy) error {
+			_, err := api.UpdateSLAPolicy(ctx, id, p)
+			return err
+		},
+		delete: api.DeleteSLAPolicy,
+	}
+}
+
+func triggers(api zendesk.API, desired []zendesk.Trigger) planner {
+	return resource[zendesk.Trigger]{
+		name:    "trigger",
+		desired: desired,
+		key:     func(t zendesk.Trigger) string { return t.Title },
+		id:      func(t zendesk.Trigger) int64 { return t.ID },
+		list: func(ctx context.Context) ([]zendesk.Trigger, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+				return api.GetTriggers(ctx, &zendesk.TriggerListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, t zendesk.Trigger) error {
+			_, err := api.CreateTrigger(ctx, t)
+			return err
+		},
+		update: func(ctx context.Context, id int64, t zendesk.Trigger) error {
+			_, err := api.UpdateTrigger(ctx, id, t)
+			return err
+		},
+		delete: api.DeleteTrigger,
+		diff: func(desired, actual zendesk.Trigger) string {
+			return ruleDiff(zendesk.DiffTriggers(desired, actual))
+		},
+	}
+}
+
+func automations(api zendesk.API, desired []zendesk.Automation) planner {
+	return resource[zendesk.Automation]{
+		name:    "automation",
+		desired: desired,
+		key:     func(a zendesk.Automation) string { return a.Title },
+		id:      func(a zendesk.Automation) int64 { return a.ID },
+		list: func(ctx context.Context) ([]zendesk.Automation, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Automation, zendesk.Page, error) {
+				return api.GetAutomations(ctx, &zendesk.AutomationListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, a zendesk.Automation) error {
+			_, err := api.CreateAutomation(ctx, a)
+			return err
+		},
+		update: func(ctx context.Context, id int64, a zendesk.Automation) error {
+			_, err := api.UpdateAutomation(ctx, id, a)
+			return err
+		},
+		delete: api.DeleteAutomation,
+		diff: func(desired, actual zendesk.Automation) string {
+			return ruleDiff(zendesk.DiffAutomations(desired, actual))
+		},
+	}
+}
+
+func macros(api zendesk.API, desired []zendesk.Macro) planner {
+	return resource[zendesk.Macro]{
+		name:    "macro",
+		desired: desired,
+		key:     func(m zendesk.Macro) string { return m.Title },
+		id:      func(m zendesk.Macro) int64 { return m.ID },
+		list: func(ctx context.Context) ([]zendesk.Macro, error) {
+			return zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Macro, zendesk.Page, error) {
+				return api.GetMacros(ctx, &zendesk.MacroListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+		},
+		create: func(ctx context.Context, m zendesk.Macro) error {
+			_, err := api.CreateMacro(ctx, m)
+			return err
+		},
+		update: func(ctx context.Context, id int64, m zendesk.Macro) error {
+			_, err := api.UpdateMacro(ctx, id, m)
+			return err
+		},
+		delete: api.DeleteMacro,
+	}
+}
@@ -0,0 +1,206 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"gopkg.in/yaml.v3"
+)
+
+// Record is a resource in Snapshot in its JSON form
+type Record map[string]interface{}
+
+// Snapshot is the normalized configuration of an account keyed by the kind of
+// resources, e.g. "triggers". Records are sorted by name or title and do not
+// have url and timestamps, so that snapshots of two accounts or two points in
+// time can be compared by diff.
+type Snapshot map[string][]Record
+
+// SnapshotOptions is options of TakeSnapshot
+type SnapshotOptions struct {
+	// StripIDs removes IDs of the resources, which differ between environments
+	StripIDs bool
+}
+
+// volatileFields are removed from records of Snapshot
+var volatileFields = []string{"url", "created_at", "updated_at"}
+
+// TakeSnapshot fetches all configuration resources of the account: ticket fields
+// and forms, user fields, groups, triggers, automations, macros, views, SLA
+// policies, schedules, brands and custom roles.
+func TakeSnapshot(ctx context.Context, api zendesk.API, opts SnapshotOptions) (Snapshot, error) {
+	snapshot := Snapshot{}
+	add := func(kind string, items interface{}, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", kind, err)
+		}
+		records, err := normalizeRecords(items, opts)
+		if err != nil {
+			return fmt.Errorf("failed to normalize %s: %w", kind, err)
+		}
+		snapshot[kind] = records
+		return nil
+	}
+
+	fetchers := []func() error{
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.TicketField, zendesk.Page, error) {
+				return api.GetTicketFieldsWithOptions(ctx, &zendesk.TicketFieldListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("ticket_fields", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+				return api.GetTicketForms(ctx, &zendesk.TicketFormListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("ticket_forms", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.UserField, zendesk.Page, error) {
+				return api.GetUserFields(ctx, &zendesk.UserFieldListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("user_fields", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Group, zendesk.Page, error) {
+				return api.GetGroups(ctx, &zendesk.GroupListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("groups", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+				return api.GetTriggers(ctx, &zendesk.TriggerListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("triggers", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Automation, zendesk.Page, error) {
+				return api.GetAutomations(ctx, &zendesk.AutomationListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("automations", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Macro, zendesk.Page, error) {
+				return api.GetMacros(ctx, &zendesk.MacroListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("macros", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.View, zendesk.Page, error) {
+				return api.GetViewsWithOptions(ctx, &zendesk.ViewListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("views", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+				return api.GetSLAPolicies(ctx, &zendesk.SLAPolicyListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("sla_policies", items, err)
+		},
+		func() error {
+			items, err := api.GetSchedules(ctx)
+			return add("schedules", items, err)
+		},
+		func() error {
+			items, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.Brand, zendesk.Page, error) {
+				return api.GetBrands(ctx, &zendesk.BrandListOptions{PageOptions: opts})
+			}), zendesk.PageToken{})
+			return add("brands", items, err)
+		},
+		func() error {
+			items, err := api.GetCustomRoles(ctx)
+			return add("custom_roles", items, err)
+		},
+	}
+
+	for _, fetch := range fetchers {
+		if err := fetch(); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// WriteJSON writes the snapshot as indented JSON
+func (s Snapshot) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// WriteYAML writes the snapshot as YAML
+func (s Snapshot) WriteYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// normalizeRecords converts items into sorted records without volatile fields
+func normalizeRecords(items interface{}, opts SnapshotOptions) ([]Record, error) {
+	b, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var raw []map[string]interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, len(raw))
+	for i, r := range raw {
+		for _, f := range volatileFields {
+			delete(r, f)
+		}
+		if opts.StripIDs {
+			delete(r, "id")
+		}
+		records[i] = normalizeNumbers(r).(map[string]interface{})
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return recordKey(records[i]) < recordKey(records[j])
+	})
+	return records, nil
+}
+
+// recordKey returns the sort key of record, which is its title or name and ID
+func recordKey(r Record) string {
+	name, ok := r["title"].(string)
+	if !ok {
+		name, _ = r["name"].(string)
+	}
+	return fmt.Sprintf("%s\x00%020v", name, r["id"])
+}
+
+// normalizeNumbers converts json.Number to int64 or float64 so that IDs are
+// written as integers in YAML
+func normalizeNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeNumbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = normalizeNumbers(v[k])
+		}
+	}
+	return v
+}
@@ -0,0 +1,93 @@
+package provision
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func expectSnapshotCalls(api *mock.Client) {
+	now := time.Now()
+	api.EXPECT().GetTicketFieldsWithOptions(gomock.Any(), gomock.Any()).Return([]zendesk.TicketField{
+		{ID: 360002143133, Title: "Subject", Type: "subject"},
+	}, zendesk.Page{}, nil)
+	api.EXPECT().GetTicketForms(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetUserFields(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetGroups(gomock.Any(), gomock.Any()).Return([]zendesk.Group{
+		{ID: 2, Name: "Tier 2", CreatedAt: now},
+		{ID: 1, Name: "Tier 1", CreatedAt: now},
+	}, zendesk.Page{}, nil)
+	api.EXPECT().GetTriggers(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetAutomations(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetMacros(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	next := "https://example.zendesk.com/api/v2/views.json?page=2"
+	api.EXPECT().GetViewsWithOptions(gomock.Any(), &zendesk.ViewListOptions{}).Return([]zendesk.View{
+		{ID: 10, Title: "Open"},
+	}, zendesk.Page{NextPage: &next}, nil)
+	api.EXPECT().GetViewsWithOptions(gomock.Any(), &zendesk.ViewListOptions{
+		PageOptions: zendesk.PageOptions{Page: 2},
+	}).Return([]zendesk.View{
+		{ID: 11, Title: "Solved"},
+	}, zendesk.Page{}, nil)
+	api.EXPECT().GetSLAPolicies(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetSchedules(gomock.Any()).Return([]zendesk.Schedule{{ID: 3, Name: "Office", TimeZone: "Tokyo"}}, nil)
+	api.EXPECT().GetBrands(gomock.Any(), gomock.Any()).Return(nil, zendesk.Page{}, nil)
+	api.EXPECT().GetCustomRoles(gomock.Any()).Return(nil, nil)
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+	expectSnapshotCalls(api)
+
+	snapshot, err := TakeSnapshot(ctx, api, SnapshotOptions{})
+	if err != nil {
+		t.Fatalf("Failed to take snapshot: %s", err)
+	}
+
+	groups := snapshot["groups"]
+	if len(groups) != 2 || groups[0]["name"] != "Tier 1" {
+		t.Fatalf("groups should be sorted by name: %v", groups)
+	}
+	if _, ok := groups[0]["created_at"]; ok {
+		t.Fatal("timestamps should be removed")
+	}
+	if len(snapshot["views"]) != 2 {
+		t.Fatalf("views of all pages should be included: %v", snapshot["views"])
+	}
+	if len(snapshot) != 12 {
+		t.Fatalf("expected 12 kinds of resources, but got %d", len(snapshot))
+	}
+
+	var buf bytes.Buffer
+	if err := snapshot.WriteYAML(&buf); err != nil {
+		t.Fatalf("Failed to write yaml: %s", err)
+	}
+	if !strings.Contains(buf.String(), "id: 360002143133") {
+		t.Fatalf("IDs should be written as integers:\n%s", buf.String())
+	}
+}
+
+func TestTakeSnapshotStripIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := mock.NewClient(ctrl)
+	expectSnapshotCalls(api)
+
+	snapshot, err := TakeSnapshot(ctx, api, SnapshotOptions{StripIDs: true})
+	if err != nil {
+		t.Fatalf("Failed to take snapshot: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := snapshot.WriteJSON(&buf); err != nil {
+		t.Fatalf("Failed to write json: %s", err)
+	}
+	if strings.Contains(buf.String(), `"id"`) {
+		t.Fatalf("IDs should be stripped:\n%s", buf.String())
+	}
+}
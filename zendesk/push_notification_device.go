@@ -0,0 +1,31 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+)
+
+// errNoDeviceTokens is returned when no device tokens are given to DeletePushNotificationDevices
+var errNoDeviceTokens = errors.New("no push notification device tokens")
+
+// PushNotificationDeviceAPI an interface containing all push notification device related methods
+type PushNotificationDeviceAPI interface {
+	DeletePushNotificationDevices(ctx context.Context, tokens []string) error
+}
+
+// DeletePushNotificationDevices unregisters the mobile devices of the tokens from
+// push notifications of Mobile SDK, e.g. when the end users are offboarded
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/push_notification_devices/#bulk-unregister-push-notification-devices
+func (z *Client) DeletePushNotificationDevices(ctx context.Context, tokens []string) error {
+	if len(tokens) == 0 {
+		return errNoDeviceTokens
+	}
+
+	var data struct {
+		Tokens []string `json:"push_notification_devices"`
+	}
+	data.Tokens = tokens
+
+	_, err := z.post(ctx, "/push_notification_devices/destroy_many.json", data)
+	return err
+}
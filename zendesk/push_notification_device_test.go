@@ -0,0 +1,39 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDeletePushNotificationDevices(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/push_notification_devices/destroy_many.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		var payload struct {
+			Tokens []string `json:"push_notification_devices"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if !reflect.DeepEqual(payload.Tokens, []string{"token1", "token2"}) {
+			t.Fatalf("unexpected tokens %v", payload.Tokens)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeletePushNotificationDevices(ctx, []string{"token1", "token2"})
+	if err != nil {
+		t.Fatalf("Failed to delete push notification devices: %s", err)
+	}
+
+	if err := client.DeletePushNotificationDevices(ctx, nil); err != errNoDeviceTokens {
+		t.Fatalf("expected errNoDeviceTokens, but got %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package zendesk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RequestPriority is the priority class of requests in the request queue
+type RequestPriority int
+
+const (
+	// PriorityInteractive is for user-facing requests. It is the default.
+	PriorityInteractive RequestPriority = iota
+	// PriorityBatch is for bulk jobs, which are sent only while no
+	// interactive request is waiting.
+	PriorityBatch
+
+	numRequestPriorities
+)
+
+type requestPriorityKey struct{}
+
+// WithRequestPriority returns a copy of ctx which makes the requests wait in
+// the request queue with the priority. It has no effect unless the queue is
+// enabled with SetRequestQueue.
+func WithRequestPriority(ctx context.Context, priority RequestPriority) context.Context {
+	return context.WithValue(ctx, requestPriorityKey{}, priority)
+}
+
+func requestPriorityFrom(ctx context.Context) RequestPriority {
+	p, ok := ctx.Value(requestPriorityKey{}).(RequestPriority)
+	if !ok || p < 0 || p >= numRequestPriorities {
+		return PriorityInteractive
+	}
+	return p
+}
+
+// RequestQueueOptions is options for SetRequestQueue
+type RequestQueueOptions struct {
+	// Concurrency is the maximum number of requests in flight. The default is 4.
+	Concurrency int
+	// RequestsPerMinute is the rate limit budget shared by all priorities.
+	// Requests are spaced evenly within the budget. Zero means no limit.
+	RequestsPerMinute int
+}
+
+// requestQueue admits requests in the order of priority, then arrival
+type requestQueue struct {
+	mu          sync.Mutex
+	concurrency int
+	interval    time.Duration
+	inFlight    int
+	next        time.Time
+	waiters     [numRequestPriorities][]*queueWaiter
+}
+
+type queueWaiter struct {
+	ready chan struct{}
+	start time.Time
+}
+
+// SetRequestQueue makes the requests of the client wait in a queue, so that
+// bulk jobs marked with WithRequestPriority(ctx, PriorityBatch) don't starve
+// user-facing calls sharing the same rate limit. If opts is nil, the queue is disabled.
+func (z *Client) SetRequestQueue(opts *RequestQueueOptions) {
+	if opts == nil {
+		z.queue = nil
+		return
+	}
+
+	q := &requestQueue{concurrency: opts.Concurrency}
+	if q.concurrency <= 0 {
+		q.concurrency = 4
+	}
+	if opts.RequestsPerMinute > 0 {
+		q.interval = time.Minute / time.Duration(opts.RequestsPerMinute)
+	}
+	z.queue = q
+}
+
+// acquire waits for a turn of the request. release must be called after
+// the request if it returns nil.
+func (q *requestQueue) acquire(ctx context.Context, priority RequestPriority) error {
+	q.mu.Lock()
+	w := &queueWaiter{ready: make(chan struct{})}
+	if q.inFlight < q.concurrency && !q.hasWaiters(priority) {
+		q.admit(w)
+	} else {
+		q.waiters[priority] = append(q.waiters[priority], w)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		select {
+		case <-w.ready:
+			// admitted concurrently, give the turn to the next one
+			q.inFlight--
+			q.admitNext()
+		default:
+			q.remove(priority, w)
+		}
+		return ctx.Err()
+	}
+
+	if err := sleepContext(ctx, time.Until(w.start)); err != nil {
+		q.release()
+		return err
+	}
+	return nil
+}
+
+// release finishes the request and admits the next waiter
+func (q *requestQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inFlight--
+	q.admitNext()
+}
+
+// hasWaiters reports whether requests of the priority or higher are waiting
+func (q *requestQueue) hasWaiters(priority RequestPriority) bool {
+	for p := PriorityInteractive; p <= priority; p++ {
+		if len(q.waiters[p]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// admit reserves a slot and the start time within the rate limit budget
+func (q *requestQueue) admit(w *queueWaiter) {
+	q.inFlight++
+	now := time.Now()
+	if q.next.Before(now) {
+		q.next = now
+	}
+	w.start = q.next
+	q.next = q.next.Add(q.interval)
+	close(w.ready)
+}
+
+func (q *requestQueue) admitNext() {
+	for p := range q.waiters {
+		if q.inFlight >= q.concurrency {
+			return
+		}
+		if len(q.waiters[p]) > 0 {
+			w := q.waiters[p][0]
+			q.waiters[p] = q.waiters[p][1:]
+			q.admit(w)
+			return
+		}
+	}
+}
+
+func (q *requestQueue) remove(priority RequestPriority, w *queueWaiter) {
+	waiters := q.waiters[priority]
+	for i := range waiters {
+		if waiters[i] == w {
+			q.waiters[priority] = append(waiters[:i:i], waiters[i+1:]...)
+			return
+		}
+	}
+}
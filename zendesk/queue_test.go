@@ -0,0 +1,113 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRequestQueuePriority(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	block := make(chan struct{})
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/first.json" {
+			<-block
+		}
+		mu.Lock()
+		order = append(order, r.URL.Path)
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRequestQueue(&RequestQueueOptions{Concurrency: 1})
+
+	var wg sync.WaitGroup
+	send := func(ctx context.Context, path string) {
+		defer wg.Done()
+		if _, err := client.get(ctx, path); err != nil {
+			t.Errorf("Failed to send request: %s", err)
+		}
+	}
+
+	wg.Add(1)
+	go send(ctx, "/first.json")
+	waitQueue(t, client, func(q *requestQueue) bool { return q.inFlight == 1 })
+
+	wg.Add(2)
+	go send(WithRequestPriority(ctx, PriorityBatch), "/batch.json")
+	waitQueue(t, client, func(q *requestQueue) bool { return len(q.waiters[PriorityBatch]) == 1 })
+	go send(ctx, "/interactive.json")
+	waitQueue(t, client, func(q *requestQueue) bool { return len(q.waiters[PriorityInteractive]) == 1 })
+
+	close(block)
+	wg.Wait()
+
+	expected := []string{"/first.json", "/interactive.json", "/batch.json"}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("unexpected order %v", order)
+		}
+	}
+}
+
+func TestRequestQueueRateLimit(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	// a request per 20ms
+	client.SetRequestQueue(&RequestQueueOptions{RequestsPerMinute: 3000})
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := client.get(ctx, "/groups.json"); err != nil {
+			t.Fatalf("Failed to send request: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Fatalf("requests were not spaced: %s", elapsed)
+	}
+}
+
+func TestRequestQueueCanceled(t *testing.T) {
+	block := make(chan struct{})
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+	defer close(block)
+
+	client.SetRequestQueue(&RequestQueueOptions{Concurrency: 1})
+	go client.get(ctx, "/groups.json")
+	waitQueue(t, client, func(q *requestQueue) bool { return q.inFlight == 1 })
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.get(timeoutCtx, "/groups.json"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, but got %v", err)
+	}
+	waitQueue(t, client, func(q *requestQueue) bool { return len(q.waiters[PriorityInteractive]) == 0 })
+}
+
+func waitQueue(t *testing.T, client *Client, cond func(q *requestQueue) bool) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		client.queue.mu.Lock()
+		ok := cond(client.queue)
+		client.queue.mu.Unlock()
+		if ok {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("timed out waiting for the queue")
+}
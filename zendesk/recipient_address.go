@@ -0,0 +1,248 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// RecipientAddressStatusUnknown is the status of check which has not been run
+	RecipientAddressStatusUnknown = "unknown"
+	// RecipientAddressStatusWaiting is the status of forwarding check waiting for the test email
+	RecipientAddressStatusWaiting = "waiting"
+	// RecipientAddressStatusVerified is the status of check which passed
+	RecipientAddressStatusVerified = "verified"
+	// RecipientAddressStatusFailed is the status of check which failed
+	RecipientAddressStatusFailed = "failed"
+)
+
+// RecipientAddressCheck is the kind of verification of support address
+type RecipientAddressCheck string
+
+const (
+	// RecipientAddressCheckForwarding verifies that emails to the address are forwarded to Zendesk
+	RecipientAddressCheckForwarding RecipientAddressCheck = "forwarding"
+	// RecipientAddressCheckSPF verifies the SPF record of the domain
+	RecipientAddressCheckSPF RecipientAddressCheck = "spf"
+	// RecipientAddressCheckDNS verifies the domain verification record of the domain
+	RecipientAddressCheckDNS RecipientAddressCheck = "dns"
+)
+
+// RecipientAddress is a support address receiving emails which become tickets
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+type RecipientAddress struct {
+	ID                       int64  `json:"id,omitempty"`
+	BrandID                  int64  `json:"brand_id,omitempty"`
+	Default                  bool   `json:"default,omitempty"`
+	Email                    string `json:"email"`
+	Name                     string `json:"name,omitempty"`
+	ForwardingStatus         string `json:"forwarding_status,omitempty"`
+	SPFStatus                string `json:"spf_status,omitempty"`
+	CNAMEStatus              string `json:"cname_status,omitempty"`
+	DomainVerificationStatus string `json:"domain_verification_status,omitempty"`
+	DomainVerificationCode   string `json:"domain_verification_code,omitempty"`
+	CreatedAt                *Time  `json:"created_at,omitempty"`
+	UpdatedAt                *Time  `json:"updated_at,omitempty"`
+}
+
+// Status returns the status of the check
+func (a RecipientAddress) Status(check RecipientAddressCheck) string {
+	switch check {
+	case RecipientAddressCheckForwarding:
+		return a.ForwardingStatus
+	case RecipientAddressCheckSPF:
+		return a.SPFStatus
+	case RecipientAddressCheckDNS:
+		return a.DomainVerificationStatus
+	}
+	return ""
+}
+
+// RecipientAddressAPI an interface containing all recipient address related methods
+type RecipientAddressAPI interface {
+	GetRecipientAddresses(ctx context.Context, opts *PageOptions) ([]RecipientAddress, Page, error)
+	GetRecipientAddress(ctx context.Context, addressID int64) (RecipientAddress, error)
+	CreateRecipientAddress(ctx context.Context, address RecipientAddress) (RecipientAddress, error)
+	UpdateRecipientAddress(ctx context.Context, addressID int64, address RecipientAddress) (RecipientAddress, error)
+	DeleteRecipientAddress(ctx context.Context, addressID int64) error
+	VerifyRecipientAddress(ctx context.Context, addressID int64, check RecipientAddressCheck) error
+	WaitRecipientAddressVerified(ctx context.Context, addressID int64, opts *RecipientAddressVerifyOptions) (RecipientAddress, error)
+}
+
+// GetRecipientAddresses fetches the support addresses
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#list-support-addresses
+func (z *Client) GetRecipientAddresses(ctx context.Context, opts *PageOptions) ([]RecipientAddress, Page, error) {
+	var data struct {
+		RecipientAddresses []RecipientAddress `json:"recipient_addresses"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/recipient_addresses.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.RecipientAddresses, data.Page, nil
+}
+
+// GetRecipientAddressesPage fetches the page of recipient addresses at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetRecipientAddressesPage(ctx context.Context, token PageToken) (ListPage[RecipientAddress], error) {
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]RecipientAddress, Page, error) {
+		return z.GetRecipientAddresses(ctx, &opts)
+	})(ctx, token)
+}
+
+// GetRecipientAddress fetches the support address
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#show-support-address
+func (z *Client) GetRecipientAddress(ctx context.Context, addressID int64) (RecipientAddress, error) {
+	var result struct {
+		RecipientAddress RecipientAddress `json:"recipient_address"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID))
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+	return result.RecipientAddress, nil
+}
+
+// CreateRecipientAddress adds a new support address
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#create-support-address
+func (z *Client) CreateRecipientAddress(ctx context.Context, address RecipientAddress) (RecipientAddress, error) {
+	var data, result struct {
+		RecipientAddress RecipientAddress `json:"recipient_address"`
+	}
+	data.RecipientAddress = address
+
+	body, err := z.post(ctx, "/recipient_addresses.json", data)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+	return result.RecipientAddress, nil
+}
+
+// UpdateRecipientAddress updates the support address
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#update-support-address
+func (z *Client) UpdateRecipientAddress(ctx context.Context, addressID int64, address RecipientAddress) (RecipientAddress, error) {
+	var data, result struct {
+		RecipientAddress RecipientAddress `json:"recipient_address"`
+	}
+	data.RecipientAddress = address
+
+	body, err := z.put(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID), data)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+	return result.RecipientAddress, nil
+}
+
+// DeleteRecipientAddress deletes the support address
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#delete-recipient-address
+func (z *Client) DeleteRecipientAddress(ctx context.Context, addressID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/recipient_addresses/%d.json", addressID))
+}
+
+// VerifyRecipientAddress starts the check of the support address.
+// The result is reflected to the status of the address asynchronously.
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#verify-support-address-forwarding
+func (z *Client) VerifyRecipientAddress(ctx context.Context, addressID int64, check RecipientAddressCheck) error {
+	var data struct {
+		Type RecipientAddressCheck `json:"type"`
+	}
+	data.Type = check
+
+	_, err := z.put(ctx, fmt.Sprintf("/recipient_addresses/%d/verify.json", addressID), data)
+	return err
+}
+
+// RecipientAddressVerifyOptions is options for WaitRecipientAddressVerified
+type RecipientAddressVerifyOptions struct {
+	// Checks are the checks to wait for. The default is forwarding only.
+	Checks []RecipientAddressCheck
+	// PollInterval is the interval of re-checking. The default is 10 seconds.
+	PollInterval time.Duration
+}
+
+// WaitRecipientAddressVerified starts the checks of the support address and
+// re-checks the unverified ones until all of them are verified. Failed checks
+// are retried as well because DNS changes take time to propagate, so set a
+// deadline on ctx. If ctx is done, the latest address is returned with ctx.Err().
+func (z *Client) WaitRecipientAddressVerified(ctx context.Context, addressID int64, opts *RecipientAddressVerifyOptions) (RecipientAddress, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &RecipientAddressVerifyOptions{}
+	}
+	checks := tmp.Checks
+	if len(checks) == 0 {
+		checks = []RecipientAddressCheck{RecipientAddressCheckForwarding}
+	}
+	interval := tmp.PollInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	address, err := z.GetRecipientAddress(ctx, addressID)
+	if err != nil {
+		return RecipientAddress{}, err
+	}
+
+	for {
+		var pending []RecipientAddressCheck
+		for _, check := range checks {
+			if address.Status(check) != RecipientAddressStatusVerified {
+				pending = append(pending, check)
+			}
+		}
+		if len(pending) == 0 {
+			return address, nil
+		}
+
+		for _, check := range pending {
+			if err := z.VerifyRecipientAddress(ctx, addressID, check); err != nil {
+				return address, err
+			}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return address, err
+		}
+
+		latest, err := z.GetRecipientAddress(ctx, addressID)
+		if err != nil {
+			return address, err
+		}
+		address = latest
+	}
+}
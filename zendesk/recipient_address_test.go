@@ -0,0 +1,91 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetRecipientAddresses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "recipient_addresses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	addresses, _, err := client.GetRecipientAddresses(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get recipient addresses: %s", err)
+	}
+	if len(addresses) != 2 || !addresses[0].Default {
+		t.Fatalf("unexpected recipient addresses %v", addresses)
+	}
+	if addresses[1].Status(RecipientAddressCheckForwarding) != RecipientAddressStatusWaiting ||
+		addresses[1].Status(RecipientAddressCheckSPF) != RecipientAddressStatusFailed {
+		t.Fatalf("unexpected statuses %v", addresses[1])
+	}
+}
+
+func TestWaitRecipientAddressVerified(t *testing.T) {
+	var mu sync.Mutex
+	var verified []string
+	status := map[string]string{"forwarding": "waiting", "spf": "failed"}
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/verify.json") {
+			var payload struct {
+				Type string `json:"type"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			verified = append(verified, payload.Type)
+			// the check passes on the second attempt
+			if strings.Count(strings.Join(verified, ","), payload.Type) == 2 {
+				status[payload.Type] = "verified"
+			}
+			return
+		}
+		fmt.Fprintf(w, `{"recipient_address": {"id": 34, "forwarding_status": %q, "spf_status": %q}}`, status["forwarding"], status["spf"])
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	address, err := client.WaitRecipientAddressVerified(ctx, 34, &RecipientAddressVerifyOptions{
+		Checks:       []RecipientAddressCheck{RecipientAddressCheckForwarding, RecipientAddressCheckSPF},
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to wait for verification: %s", err)
+	}
+	if address.ForwardingStatus != RecipientAddressStatusVerified || address.SPFStatus != RecipientAddressStatusVerified {
+		t.Fatalf("unexpected address %v", address)
+	}
+	if strings.Join(verified, ",") != "forwarding,spf,forwarding,spf" {
+		t.Fatalf("unexpected checks %v", verified)
+	}
+}
+
+func TestWaitRecipientAddressVerifiedTimeout(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"recipient_address": {"id": 34, "forwarding_status": "failed"}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	address, err := client.WaitRecipientAddressVerified(timeoutCtx, 34, &RecipientAddressVerifyOptions{PollInterval: time.Minute})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, but got %v", err)
+	}
+	if address.ForwardingStatus != RecipientAddressStatusFailed {
+		t.Fatalf("unexpected address %v", address)
+	}
+}
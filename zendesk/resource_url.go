@@ -0,0 +1,129 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ResourceURL is a Zendesk resource identified by a URL of API, agent interface or Help Center
+type ResourceURL struct {
+	// Host is the host of the URL, e.g. "example.zendesk.com" or a host mapped domain
+	Host string
+	// Resource is the collection of the resource, e.g. "tickets", "users" and "articles"
+	Resource string
+	ID       int64
+	// ParentResource and ParentID are set for nested resources such as
+	// "/api/v2/users/1/identities/2.json"
+	ParentResource string
+	ParentID       int64
+	// Agent is true if the URL is of agent interface
+	Agent bool
+}
+
+// Subdomain returns the subdomain of zendesk.com, or empty string for host mapped domains
+func (u ResourceURL) Subdomain() string {
+	if sub, ok := strings.CutSuffix(u.Host, ".zendesk.com"); ok && !strings.Contains(sub, ".") {
+		return sub
+	}
+	return ""
+}
+
+// APIURL returns the API URL of the resource
+func (u ResourceURL) APIURL() string {
+	path := fmt.Sprintf("/%s/%d.json", u.Resource, u.ID)
+	if u.ParentResource != "" {
+		path = fmt.Sprintf("/%s/%d", u.ParentResource, u.ParentID) + path
+	}
+	if u.Resource == "articles" || u.Resource == "sections" || u.Resource == "categories" {
+		path = "/help_center" + path
+	}
+	return "https://" + u.Host + "/api/" + DefaultAPIVersion + path
+}
+
+// AgentURL returns the URL of the resource in agent interface
+func (u ResourceURL) AgentURL() string {
+	return AgentURL(u.Host, u.Resource, u.ID)
+}
+
+// ParseResourceURL parses the URL of a resource such as
+// "https://example.zendesk.com/api/v2/tickets/1.json",
+// "https://example.zendesk.com/agent/tickets/1" and
+// "https://example.zendesk.com/hc/en-us/articles/1-title".
+// Trailing collections such as "/api/v2/tickets/1/comments.json" and
+// "/agent/users/1/requested_tickets" are ignored.
+func ParseResourceURL(raw string) (ResourceURL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ResourceURL{}, err
+	}
+	if u.Host == "" {
+		return ResourceURL{}, fmt.Errorf("zendesk: no host in resource URL %q", raw)
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	result := ResourceURL{Host: u.Host}
+	switch {
+	case len(segments) > 2 && segments[0] == "api":
+		segments = segments[2:]
+		segments[len(segments)-1] = strings.TrimSuffix(segments[len(segments)-1], ".json")
+		// locale of help center such as /api/v2/help_center/en-us/articles/1.json
+		if segments[0] == "help_center" {
+			segments = segments[1:]
+			if len(segments) > 0 && strings.Contains(segments[0], "-") {
+				segments = segments[1:]
+			}
+		}
+		// collection of the resource such as /api/v2/tickets/1/comments.json
+		if len(segments)%2 == 1 {
+			segments = segments[:len(segments)-1]
+		}
+	case segments[0] == "agent":
+		result.Agent = true
+		segments = segments[1:]
+		if len(segments) > 2 {
+			segments = segments[:2]
+		}
+	case segments[0] == "hc":
+		// /hc/{locale}/articles/{id}-{title}
+		if len(segments) == 4 {
+			segments = segments[2:]
+			segments[1], _, _ = strings.Cut(segments[1], "-")
+		}
+	}
+
+	if len(segments) < 2 || len(segments)%2 != 0 {
+		return ResourceURL{}, fmt.Errorf("zendesk: unknown resource URL %q", raw)
+	}
+	for i := 0; i < len(segments); i += 2 {
+		id, err := strconv.ParseInt(segments[i+1], 10, 64)
+		if err != nil {
+			return ResourceURL{}, fmt.Errorf("zendesk: unknown resource URL %q", raw)
+		}
+		result.ParentResource, result.ParentID = result.Resource, result.ID
+		result.Resource, result.ID = segments[i], id
+	}
+	return result, nil
+}
+
+// TicketIDFromURL returns the ID of the ticket from its API or agent interface URL
+func TicketIDFromURL(raw string) (int64, error) {
+	u, err := ParseResourceURL(raw)
+	if err != nil {
+		return 0, err
+	}
+	if u.Resource != "tickets" {
+		return 0, fmt.Errorf("zendesk: %q is not a ticket URL", raw)
+	}
+	return u.ID, nil
+}
+
+// AgentURL returns the URL of the resource such as "tickets", "users",
+// "organizations" in agent interface. host is either a subdomain or a host name.
+func AgentURL(host string, resource string, id int64) string {
+	if !strings.Contains(host, ".") {
+		host += ".zendesk.com"
+	}
+	return fmt.Sprintf("https://%s/agent/%s/%d", host, resource, id)
+}
@@ -0,0 +1,62 @@
+package zendesk
+
+import "testing"
+
+func TestParseResourceURL(t *testing.T) {
+	cases := []struct {
+		raw      string
+		resource string
+		id       int64
+		parent   string
+		parentID int64
+		agent    bool
+	}{
+		{"https://example.zendesk.com/api/v2/tickets/35436.json", "tickets", 35436, "", 0, false},
+		{"https://example.zendesk.com/api/v2/tickets/35436/comments.json", "tickets", 35436, "", 0, false},
+		{"https://example.zendesk.com/api/v2/users/1/identities/2.json", "identities", 2, "users", 1, false},
+		{"https://example.zendesk.com/api/v2/help_center/en-us/articles/5.json", "articles", 5, "", 0, false},
+		{"https://example.zendesk.com/agent/tickets/35436", "tickets", 35436, "", 0, true},
+		{"https://example.zendesk.com/agent/users/1/requested_tickets", "users", 1, "", 0, true},
+		{"https://help.example.com/hc/en-us/articles/360001-How-to-reset", "articles", 360001, "", 0, false},
+	}
+	for _, c := range cases {
+		u, err := ParseResourceURL(c.raw)
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %s", c.raw, err)
+		}
+		if u.Resource != c.resource || u.ID != c.id || u.ParentResource != c.parent || u.ParentID != c.parentID || u.Agent != c.agent {
+			t.Fatalf("unexpected result of %s: %+v", c.raw, u)
+		}
+	}
+
+	for _, raw := range []string{"/agent/tickets/1", "https://example.zendesk.com/api/v2", "https://example.zendesk.com/agent/tickets/new", "https://example.zendesk.com/"} {
+		if _, err := ParseResourceURL(raw); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
+
+func TestResourceURLLinks(t *testing.T) {
+	u, err := ParseResourceURL("https://example.zendesk.com/agent/tickets/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Subdomain() != "example" || u.APIURL() != "https://example.zendesk.com/api/v2/tickets/1.json" {
+		t.Fatalf("unexpected URL %+v", u)
+	}
+
+	id, err := TicketIDFromURL("https://example.zendesk.com/api/v2/tickets/7.json")
+	if err != nil || id != 7 {
+		t.Fatalf("unexpected ticket ID %d, %v", id, err)
+	}
+	if _, err := TicketIDFromURL("https://example.zendesk.com/agent/users/7"); err == nil {
+		t.Fatal("expected error for user URL")
+	}
+
+	if link := AgentURL("example", "users", 3); link != "https://example.zendesk.com/agent/users/3" {
+		t.Fatalf("unexpected link %s", link)
+	}
+	if link := AgentURL("support.example.com", "tickets", 3); link != "https://support.example.com/agent/tickets/3" {
+		t.Fatalf("unexpected link %s", link)
+	}
+}
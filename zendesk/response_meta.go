@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ResponseMeta holds metadata of the HTTP response returned by Zendesk API.
+// Pass it to WithResponseMeta to capture headers and status code of
+// successful calls, which are otherwise discarded by the client.
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+	// Retry is the attempts of the call, which tell how much it was throttled
+	Retry RetryStats
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a copy of ctx which makes the client record the
+// metadata of the response into meta. If the call is retried, meta holds
+// the last response received.
+//
+//	var meta zendesk.ResponseMeta
+//	tickets, page, err := client.GetTickets(zendesk.WithResponseMeta(ctx, &meta), nil)
+//	remaining := meta.RateLimitRemaining()
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+// responseMetaFromContext returns ResponseMeta registered by WithResponseMeta
+func responseMetaFromContext(ctx context.Context) *ResponseMeta {
+	meta, _ := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	return meta
+}
+
+// recordResponse stores status code and headers of resp to ResponseMeta in ctx if exists
+func recordResponse(ctx context.Context, resp *http.Response) {
+	meta := responseMetaFromContext(ctx)
+	if meta == nil {
+		return
+	}
+
+	meta.StatusCode = resp.StatusCode
+	meta.Header = resp.Header
+}
+
+// recordRetryStats stores the attempts of the call to ResponseMeta in ctx if exists
+func recordRetryStats(ctx context.Context, stats RetryStats) {
+	if meta := responseMetaFromContext(ctx); meta != nil {
+		meta.Retry = stats
+	}
+}
+
+// ETag returns the entity tag of the resource given by ETag header.
+// Pass it to WithIfMatch to make the following update conditional.
+func (m ResponseMeta) ETag() string {
+	if m.Header == nil {
+		return ""
+	}
+	return m.Header.Get("ETag")
+}
+
+// RateLimit returns the number of requests allowed per minute.
+// It returns -1 if the response does not include the header.
+//
+// ref: https://developer.zendesk.com/api-reference/introduction/rate-limits/
+func (m ResponseMeta) RateLimit() int {
+	return m.intHeader("X-Rate-Limit")
+}
+
+// RateLimitRemaining returns the number of requests remaining in the current
+// rate limit window. It returns -1 if the response does not include the header.
+func (m ResponseMeta) RateLimitRemaining() int {
+	return m.intHeader("X-Rate-Limit-Remaining")
+}
+
+// RetryAfter returns the duration to wait before the next request,
+// given by Retry-After header. It returns 0 if the header is absent.
+func (m ResponseMeta) RetryAfter() time.Duration {
+	sec := m.intHeader("Retry-After")
+	if sec < 0 {
+		return 0
+	}
+	return time.Duration(sec) * time.Second
+}
+
+func (m ResponseMeta) intHeader(key string) int {
+	if m.Header == nil {
+		return -1
+	}
+
+	v, err := strconv.Atoi(m.Header.Get(key))
+	if err != nil {
+		return -1
+	}
+	return v
+}
@@ -0,0 +1,66 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithResponseMeta(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit", "700")
+		w.Header().Set("X-Rate-Limit-Remaining", "699")
+		w.Write(readFixture("GET/ticket.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var meta ResponseMeta
+	_, err := client.GetTicket(WithResponseMeta(ctx, &meta), 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if meta.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, but got %d", http.StatusOK, meta.StatusCode)
+	}
+	if v := meta.RateLimit(); v != 700 {
+		t.Fatalf("expected rate limit 700, but got %d", v)
+	}
+	if v := meta.RateLimitRemaining(); v != 699 {
+		t.Fatalf("expected remaining rate limit 699, but got %d", v)
+	}
+}
+
+func TestWithResponseMetaOnFailure(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var meta ResponseMeta
+	_, err := client.GetTicket(WithResponseMeta(ctx, &meta), 2)
+	if err == nil {
+		t.Fatal("Did not receive error from client")
+	}
+
+	if meta.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected status code %d, but got %d", http.StatusTooManyRequests, meta.StatusCode)
+	}
+	if v := meta.RetryAfter(); v != 30*time.Second {
+		t.Fatalf("expected retry after 30s, but got %s", v)
+	}
+}
+
+func TestResponseMetaWithoutHeader(t *testing.T) {
+	var meta ResponseMeta
+	if v := meta.RateLimit(); v != -1 {
+		t.Fatalf("expected -1, but got %d", v)
+	}
+	if v := meta.RetryAfter(); v != 0 {
+		t.Fatalf("expected 0, but got %s", v)
+	}
+}
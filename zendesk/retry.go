@@ -0,0 +1,191 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy decides whether a request is sent again. Retry is called after
+// each attempt with the request, and either the response or the error of the
+// transport. attempt counts from 0. It returns how long to wait before the
+// next attempt, and false to stop and return the result to the caller.
+// Retry must not read or close the body of the response.
+type RetryPolicy interface {
+	Retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool)
+}
+
+// RetryPolicyFunc is an adapter to use an ordinary function as RetryPolicy
+type RetryPolicyFunc func(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool)
+
+// Retry implements RetryPolicy
+func (f RetryPolicyFunc) Retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	return f(req, resp, err, attempt)
+}
+
+// RateLimitRetryPolicy is the default RetryPolicy. It retries the requests
+// rate limited with 429 after Retry-After seconds, as long as the delay is
+// no longer than MaxSleep and the request has been sent less than MaxRetry times.
+// Zero MaxSleep means no limit of the delay.
+type RateLimitRetryPolicy struct {
+	MaxRetry int
+	MaxSleep time.Duration
+}
+
+// Retry implements RetryPolicy
+func (p RateLimitRetryPolicy) Retry(_ *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt+1 >= p.MaxRetry {
+		return 0, false
+	}
+
+	retrySec, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+	wait := time.Duration(retrySec) * time.Second
+	if wait <= 0 || (p.MaxSleep > 0 && wait > p.MaxSleep) {
+		return 0, false
+	}
+	return wait, true
+}
+
+// maxNetworkRetryBackoff caps the exponential backoff of NetworkErrorRetryPolicy
+const maxNetworkRetryBackoff = 30 * time.Second
+
+// NetworkErrorRetryPolicy retries idempotent GET requests failed with transient
+// network errors such as connection resets, unexpected EOFs and timeouts.
+// The delay starts at Backoff and doubles on each attempt up to 30 seconds.
+// The other results are decided by Next, or not retried if Next is nil.
+type NetworkErrorRetryPolicy struct {
+	MaxRetry int
+	Backoff  time.Duration
+	Next     RetryPolicy
+}
+
+// Retry implements RetryPolicy
+func (p NetworkErrorRetryPolicy) Retry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if err != nil && req != nil && req.Method == http.MethodGet && isTransientNetworkError(req.Context(), err) {
+		if attempt >= p.MaxRetry {
+			return 0, false
+		}
+
+		wait := p.Backoff
+		for i := 0; i < attempt && wait < maxNetworkRetryBackoff; i++ {
+			wait *= 2
+		}
+		if wait > maxNetworkRetryBackoff {
+			wait = maxNetworkRetryBackoff
+		}
+		return wait, true
+	}
+
+	if p.Next == nil {
+		return 0, false
+	}
+	return p.Next.Retry(req, resp, err, attempt)
+}
+
+// isTransientNetworkError reports whether the transport error is likely to
+// be resolved by sending the request again
+func isTransientNetworkError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// SetNetworkRetry sets the number of times GET requests are retried on
+// transient network errors, waiting backoff doubled on each attempt.
+// It is applied on top of the retry policy. Defaults to 0, which means no retry.
+func (z *Client) SetNetworkRetry(retries int, backoff time.Duration) {
+	if retries >= 0 {
+		z.networkRetry = retries
+		z.networkBackoff = backoff
+	}
+}
+
+// SetRetryPolicy replaces the policy of retrying requests. If policy is nil,
+// RateLimitRetryPolicy configured with SetMaxRetry and SetMaxRetrySleepDelay is used.
+func (z *Client) SetRetryPolicy(policy RetryPolicy) {
+	z.retryPolicy = policy
+}
+
+// RetryStats is the attempts made by a call of the client
+type RetryStats struct {
+	Method string
+	Path   string
+	// Attempts is the number of requests sent, including the first one
+	Attempts int
+	// Throttled is the number of responses rate limited with 429
+	Throttled int
+	// TotalWait is the time spent sleeping between the attempts
+	TotalWait time.Duration
+	// LastRetryAfter is Retry-After of the last 429 response
+	LastRetryAfter time.Duration
+}
+
+// Retried reports whether the request was sent more than once
+func (s RetryStats) Retried() bool {
+	return s.Attempts > 1
+}
+
+// SetRetryObserver sets the function called with the attempts of each call
+// which was retried or throttled, e.g. to export metrics, so that operators
+// can alert on persistent throttling. It must be safe for concurrent use.
+func (z *Client) SetRetryObserver(fn func(stats RetryStats)) {
+	z.retryObserver = fn
+}
+
+// reportRetryStats records the attempts of the call to ResponseMeta and the observer
+func (z *Client) reportRetryStats(ctx context.Context, stats RetryStats) {
+	recordRetryStats(ctx, stats)
+	if z.retryObserver != nil && (stats.Retried() || stats.Throttled > 0) {
+		z.retryObserver(stats)
+	}
+}
+
+// recordThrottle counts the response if it is rate limited
+func (s *RetryStats) recordThrottle(resp *http.Response) {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	s.Throttled++
+	retrySec, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+	s.LastRetryAfter = time.Duration(retrySec) * time.Second
+}
+
+// currentRetryPolicy returns the policy used by sendRequest
+func (z *Client) currentRetryPolicy() RetryPolicy {
+	policy := z.retryPolicy
+	if policy == nil {
+		policy = RateLimitRetryPolicy{MaxRetry: z.maxRetry, MaxSleep: z.maxSleep}
+	}
+	if z.networkRetry > 0 {
+		policy = NetworkErrorRetryPolicy{MaxRetry: z.networkRetry, Backoff: z.networkBackoff, Next: policy}
+	}
+	return policy
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,198 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimitRetryPolicy(t *testing.T) {
+	policy := RateLimitRetryPolicy{MaxRetry: 3, MaxSleep: 5 * time.Second}
+	newResponse := func(status int, retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		resp.Header.Set("Retry-After", retryAfter)
+		return resp
+	}
+
+	tests := []struct {
+		name    string
+		resp    *http.Response
+		err     error
+		attempt int
+		wait    time.Duration
+		retry   bool
+	}{
+		{"rate limited", newResponse(http.StatusTooManyRequests, "2"), nil, 0, 2 * time.Second, true},
+		{"too many attempts", newResponse(http.StatusTooManyRequests, "2"), nil, 2, 0, false},
+		{"too long delay", newResponse(http.StatusTooManyRequests, "10"), nil, 0, 0, false},
+		{"no delay", newResponse(http.StatusTooManyRequests, ""), nil, 0, 0, false},
+		{"success", newResponse(http.StatusOK, ""), nil, 0, 0, false},
+		{"transport error", nil, errors.New("connection reset"), 0, 0, false},
+	}
+	for _, test := range tests {
+		wait, retry := policy.Retry(nil, test.resp, test.err, test.attempt)
+		if wait != test.wait || retry != test.retry {
+			t.Fatalf("%s: unexpected decision %s %v", test.name, wait, retry)
+		}
+	}
+
+	unlimited := RateLimitRetryPolicy{MaxRetry: 3}
+	if wait, retry := unlimited.Retry(nil, newResponse(http.StatusTooManyRequests, "60"), nil, 0); wait != time.Minute || !retry {
+		t.Fatalf("zero MaxSleep should not limit the delay: %s %v", wait, retry)
+	}
+}
+
+func TestDefaultRetryPolicyHasNoSleepLimit(t *testing.T) {
+	client, _ := NewClient(nil)
+	policy, ok := client.currentRetryPolicy().(RateLimitRetryPolicy)
+	if !ok || policy.MaxRetry != 3 || policy.MaxSleep != 0 {
+		t.Fatalf("unexpected default retry policy %#v", client.currentRetryPolicy())
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	attempts := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRetryPolicy(RetryPolicyFunc(func(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+		if req.Method != http.MethodPut {
+			t.Fatalf("unexpected request %s", req.Method)
+		}
+		return time.Millisecond, err == nil && resp.StatusCode == http.StatusConflict && attempt < 5
+	}))
+
+	if _, err := client.put(ctx, "/tickets/1.json", map[string]string{}); err != nil {
+		t.Fatalf("Failed to retry request: %s", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("unexpected attempts %d", attempts)
+	}
+}
+
+func TestRetryPolicyCanceled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetRetryPolicy(RetryPolicyFunc(func(*http.Request, *http.Response, error, int) (time.Duration, bool) {
+		return time.Minute, true
+	}))
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.get(timeoutCtx, "/groups.json"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, but got %v", err)
+	}
+}
+
+func TestSetNetworkRetry(t *testing.T) {
+	attempts := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			// drop the connection without response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.get(ctx, "/groups.json"); err == nil {
+		t.Fatal("expected network error without retry")
+	}
+
+	attempts = 0
+	client.SetNetworkRetry(2, time.Millisecond)
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to retry request: %s", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("unexpected attempts %d", attempts)
+	}
+
+	// mutating requests are not retried
+	attempts = 0
+	if _, err := client.post(ctx, "/groups.json", Group{}); err == nil || attempts != 1 {
+		t.Fatalf("unexpected result of post %v after %d attempts", err, attempts)
+	}
+}
+
+func TestNetworkErrorRetryPolicyBackoff(t *testing.T) {
+	policy := NetworkErrorRetryPolicy{MaxRetry: 10, Backoff: time.Second}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.zendesk.com/api/v2/groups.json", nil)
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if wait, retry := policy.Retry(req, nil, io.ErrUnexpectedEOF, attempt); !retry || wait != expected {
+			t.Fatalf("unexpected decision at %d: %s %v", attempt, wait, retry)
+		}
+	}
+	if wait, _ := policy.Retry(req, nil, io.ErrUnexpectedEOF, 9); wait != 30*time.Second {
+		t.Fatalf("backoff is not capped: %s", wait)
+	}
+	if _, retry := policy.Retry(req, nil, errors.New("x509: certificate signed by unknown authority"), 0); retry {
+		t.Fatal("non-transient error should not be retried")
+	}
+}
+
+func TestRetryStats(t *testing.T) {
+	attempts := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var observed []RetryStats
+	client.SetRetryObserver(func(stats RetryStats) {
+		observed = append(observed, stats)
+	})
+	client.SetRetryPolicy(RetryPolicyFunc(func(_ *http.Request, resp *http.Response, _ error, _ int) (time.Duration, bool) {
+		return time.Millisecond, resp.StatusCode == http.StatusTooManyRequests
+	}))
+
+	var meta ResponseMeta
+	if _, err := client.get(WithResponseMeta(ctx, &meta), "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+	stats := meta.Retry
+	if stats.Attempts != 3 || stats.Throttled != 2 || stats.TotalWait != 2*time.Millisecond ||
+		stats.LastRetryAfter != 7*time.Second || stats.Path != "/groups.json" || !stats.Retried() {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if len(observed) != 1 || observed[0] != stats {
+		t.Fatalf("unexpected observed stats %v", observed)
+	}
+
+	// calls without retry are not observed
+	if _, err := client.get(WithResponseMeta(ctx, &meta), "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+	if meta.Retry.Attempts != 1 || len(observed) != 1 {
+		t.Fatalf("unexpected stats %+v %v", meta.Retry, observed)
+	}
+}
@@ -0,0 +1,218 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RuleChangeKind is the kind of RuleChange
+type RuleChangeKind string
+
+const (
+	// RuleChangeAdded : the item is in desired but not in actual
+	RuleChangeAdded RuleChangeKind = "added"
+	// RuleChangeRemoved : the item is in actual but not in desired
+	RuleChangeRemoved RuleChangeKind = "removed"
+	// RuleChangeModified : the value differs
+	RuleChangeModified RuleChangeKind = "modified"
+)
+
+// RuleChange is a difference between desired and actual definitions of a trigger or automation.
+// Path is "title", "active", "conditions.all", "conditions.any", "actions" etc.
+// Desired is nil for RuleChangeRemoved and Actual is nil for RuleChangeAdded.
+type RuleChange struct {
+	Path    string
+	Kind    RuleChangeKind
+	Desired interface{}
+	Actual  interface{}
+}
+
+// String returns a line of human readable diff
+func (c RuleChange) String() string {
+	switch c.Kind {
+	case RuleChangeAdded:
+		return fmt.Sprintf("+ %s: %v", c.Path, c.Desired)
+	case RuleChangeRemoved:
+		return fmt.Sprintf("- %s: %v", c.Path, c.Actual)
+	}
+	return fmt.Sprintf("~ %s: %v => %v", c.Path, c.Actual, c.Desired)
+}
+
+// RuleDiff is the structured difference of two definitions of a trigger or automation
+type RuleDiff []RuleChange
+
+// Empty reports whether the definitions are equivalent
+func (d RuleDiff) Empty() bool {
+	return len(d) == 0
+}
+
+// String returns human readable diff, one change per line
+func (d RuleDiff) String() string {
+	lines := make([]string, len(d))
+	for i, c := range d {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ruleItem is a condition or action in the comparable form
+type ruleItem struct {
+	Field    string      `json:"field"`
+	Operator string      `json:"operator,omitempty"`
+	Value    interface{} `json:"value"`
+}
+
+// DiffTriggers compares desired and actual trigger definitions to detect config drift.
+// IDs, timestamps and zero Position of desired are ignored. Conditions and
+// actions are compared regardless of order, and numbers are compared with
+// numeric strings as Zendesk returns IDs in values as strings.
+func DiffTriggers(desired, actual Trigger) RuleDiff {
+	var diff RuleDiff
+	diff = diffScalar(diff, "title", desired.Title, actual.Title)
+	diff = diffScalar(diff, "active", desired.Active, actual.Active)
+	if desired.Position != 0 {
+		diff = diffScalar(diff, "position", desired.Position, actual.Position)
+	}
+	diff = diffScalar(diff, "description", desired.Description, actual.Description)
+	diff = diffScalar(diff, "category_id", desired.CategoryID, actual.CategoryID)
+	diff = diffItems(diff, "conditions.all", triggerConditionItems(desired.Conditions.All), triggerConditionItems(actual.Conditions.All))
+	diff = diffItems(diff, "conditions.any", triggerConditionItems(desired.Conditions.Any), triggerConditionItems(actual.Conditions.Any))
+	diff = diffItems(diff, "actions", triggerActionItems(desired.Actions), triggerActionItems(actual.Actions))
+	return diff
+}
+
+// DiffAutomations compares desired and actual automation definitions as DiffTriggers does
+func DiffAutomations(desired, actual Automation) RuleDiff {
+	var diff RuleDiff
+	diff = diffScalar(diff, "title", desired.Title, actual.Title)
+	diff = diffScalar(diff, "active", desired.Active, actual.Active)
+	if desired.Position != 0 {
+		diff = diffScalar(diff, "position", desired.Position, actual.Position)
+	}
+	diff = diffItems(diff, "conditions.all", automationConditionItems(desired.Conditions.All), automationConditionItems(actual.Conditions.All))
+	diff = diffItems(diff, "conditions.any", automationConditionItems(desired.Conditions.Any), automationConditionItems(actual.Conditions.Any))
+	diff = diffItems(diff, "actions", automationActionItems(desired.Actions), automationActionItems(actual.Actions))
+	return diff
+}
+
+func triggerConditionItems(conditions []TriggerCondition) []ruleItem {
+	items := make([]ruleItem, len(conditions))
+	for i, c := range conditions {
+		items[i] = ruleItem{Field: c.Field, Operator: c.Operator, Value: c.Value}
+	}
+	return items
+}
+
+func triggerActionItems(actions []TriggerAction) []ruleItem {
+	items := make([]ruleItem, len(actions))
+	for i, a := range actions {
+		items[i] = ruleItem{Field: a.Field, Value: a.Value}
+	}
+	return items
+}
+
+func automationConditionItems(conditions []AutomationCondition) []ruleItem {
+	items := make([]ruleItem, len(conditions))
+	for i, c := range conditions {
+		items[i] = ruleItem{Field: c.Field, Operator: c.Operator, Value: c.Value}
+	}
+	return items
+}
+
+func automationActionItems(actions []AutomationAction) []ruleItem {
+	items := make([]ruleItem, len(actions))
+	for i, a := range actions {
+		items[i] = ruleItem{Field: a.Field, Value: a.Value}
+	}
+	return items
+}
+
+func diffScalar[T comparable](diff RuleDiff, path string, desired, actual T) RuleDiff {
+	if desired == actual {
+		return diff
+	}
+	return append(diff, RuleChange{Path: path, Kind: RuleChangeModified, Desired: desired, Actual: actual})
+}
+
+// diffItems compares items as multisets. Each item is reported as added or removed.
+func diffItems(diff RuleDiff, path string, desired, actual []ruleItem) RuleDiff {
+	remaining := map[string]int{}
+	for _, item := range actual {
+		remaining[ruleItemKey(item)]++
+	}
+
+	var added []ruleItem
+	for _, item := range desired {
+		key := ruleItemKey(item)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		added = append(added, item)
+	}
+
+	var removed []ruleItem
+	for _, item := range actual {
+		key := ruleItemKey(item)
+		if remaining[key] > 0 {
+			remaining[key]--
+			removed = append(removed, item)
+		}
+	}
+
+	sortRuleItems(added)
+	sortRuleItems(removed)
+	for _, item := range removed {
+		diff = append(diff, RuleChange{Path: path, Kind: RuleChangeRemoved, Actual: item})
+	}
+	for _, item := range added {
+		diff = append(diff, RuleChange{Path: path, Kind: RuleChangeAdded, Desired: item})
+	}
+	return diff
+}
+
+func sortRuleItems(items []ruleItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return ruleItemKey(items[i]) < ruleItemKey(items[j])
+	})
+}
+
+// ruleItemKey returns the canonical form of item used for comparison
+func ruleItemKey(item ruleItem) string {
+	item.Value = normalizeRuleValue(item.Value)
+	b, _ := json.Marshal(item)
+	return string(b)
+}
+
+// normalizeRuleValue converts value into canonical JSON types, with numbers as strings
+func normalizeRuleValue(value interface{}) interface{} {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return value
+	}
+	return stringifyNumbers(v)
+}
+
+func stringifyNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		for i := range v {
+			v[i] = stringifyNumbers(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = stringifyNumbers(v[k])
+		}
+	}
+	return v
+}
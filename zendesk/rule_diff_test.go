@@ -0,0 +1,65 @@
+package zendesk
+
+import "testing"
+
+func TestDiffTriggers(t *testing.T) {
+	var desired, actual Trigger
+	desired.Title = "Notify requester"
+	desired.Active = true
+	desired.Conditions.All = []TriggerCondition{
+		{Field: "status", Operator: "is", Value: "solved"},
+		{Field: "group_id", Operator: "is", Value: 123},
+	}
+	desired.Actions = []TriggerAction{
+		{Field: "notification_user", Value: []string{"requester_id", "Solved", "Your ticket was solved"}},
+	}
+
+	actual.ID = 1
+	actual.Title = "Notify requester"
+	actual.Active = true
+	actual.Conditions.All = []TriggerCondition{
+		{Field: "group_id", Operator: "is", Value: "123"},
+		{Field: "status", Operator: "is", Value: "solved"},
+	}
+	actual.Actions = []TriggerAction{
+		{Field: "notification_user", Value: []interface{}{"requester_id", "Solved", "Your ticket was solved"}},
+	}
+
+	if diff := DiffTriggers(desired, actual); !diff.Empty() {
+		t.Fatalf("equivalent triggers should not have diff, but got\n%s", diff)
+	}
+
+	actual.Active = false
+	actual.Conditions.Any = []TriggerCondition{{Field: "priority", Operator: "is", Value: "urgent"}}
+	desired.Actions = append(desired.Actions, TriggerAction{Field: "status", Value: "open"})
+
+	diff := DiffTriggers(desired, actual)
+	if len(diff) != 3 {
+		t.Fatalf("expected 3 changes, but got\n%s", diff)
+	}
+	if diff[0].Path != "active" || diff[0].Kind != RuleChangeModified || diff[0].Desired != true {
+		t.Fatalf("unexpected change %v", diff[0])
+	}
+	if diff[1].Path != "conditions.any" || diff[1].Kind != RuleChangeRemoved {
+		t.Fatalf("unexpected change %v", diff[1])
+	}
+	if diff[2].Path != "actions" || diff[2].Kind != RuleChangeAdded {
+		t.Fatalf("unexpected change %v", diff[2])
+	}
+}
+
+func TestDiffAutomations(t *testing.T) {
+	var desired, actual Automation
+	desired.Title = "Close solved tickets"
+	desired.Conditions.All = []AutomationCondition{{Field: "SOLVED", Operator: "greater_than", Value: "96"}}
+	actual.Title = "Close solved"
+	actual.Conditions.All = []AutomationCondition{{Field: "SOLVED", Operator: "greater_than", Value: "48"}}
+
+	diff := DiffAutomations(desired, actual)
+	expected := "~ title: Close solved => Close solved tickets\n" +
+		"- conditions.all: {SOLVED greater_than 48}\n" +
+		"+ conditions.all: {SOLVED greater_than 96}"
+	if diff.String() != expected {
+		t.Fatalf("expected\n%s\nbut got\n%s", expected, diff)
+	}
+}
@@ -0,0 +1,340 @@
+package zendesk
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RuleContext is the ticket update which conditions of triggers and
+// automations are evaluated against
+type RuleContext struct {
+	Ticket Ticket
+	// Previous is the ticket before the update, or nil if the ticket is being
+	// created. Fields of a created ticket are treated as changed from empty.
+	Previous *Ticket
+	// Comment is the comment added by the update if any
+	Comment *TicketComment
+	// Now is the time of evaluation for the time based conditions of
+	// automations. time.Now() is used if zero.
+	Now time.Time
+}
+
+// UnsupportedConditionError is returned when a condition can't be evaluated
+// locally, e.g. because it depends on the state only Zendesk knows
+type UnsupportedConditionError struct {
+	Field    string
+	Operator string
+}
+
+// Error implements error
+func (e *UnsupportedConditionError) Error() string {
+	return fmt.Sprintf("condition %s %s is not supported", e.Field, e.Operator)
+}
+
+// EvaluateTrigger reports whether the conditions of the trigger are met by
+// the update, without sending any request. Whether the trigger is active is
+// not considered.
+func EvaluateTrigger(trigger Trigger, rc RuleContext) (bool, error) {
+	return evaluateConditions(trigger.Conditions.All, trigger.Conditions.Any, rc)
+}
+
+// EvaluateAutomation reports whether the conditions of the automation are met
+// by the ticket at rc.Now
+func EvaluateAutomation(automation Automation, rc RuleContext) (bool, error) {
+	all := make([]TriggerCondition, 0, len(automation.Conditions.All))
+	for _, c := range automation.Conditions.All {
+		all = append(all, TriggerCondition{Field: c.Field, Operator: c.Operator, Value: c.Value})
+	}
+	anyOf := make([]TriggerCondition, 0, len(automation.Conditions.Any))
+	for _, c := range automation.Conditions.Any {
+		anyOf = append(anyOf, TriggerCondition{Field: c.Field, Operator: c.Operator, Value: c.Value})
+	}
+	return evaluateConditions(all, anyOf, rc)
+}
+
+// MatchingTriggers returns the active triggers whose conditions are met by
+// the update in the order of position. It answers "which triggers would fire"
+// for the update alone, so the changes made by the actions of the triggers
+// are not taken into account.
+func MatchingTriggers(triggers []Trigger, rc RuleContext) ([]Trigger, error) {
+	sorted := append([]Trigger(nil), triggers...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	var matched []Trigger
+	for _, trigger := range sorted {
+		if !trigger.Active {
+			continue
+		}
+		ok, err := EvaluateTrigger(trigger, rc)
+		if err != nil {
+			return nil, fmt.Errorf("trigger %d: %w", trigger.ID, err)
+		}
+		if ok {
+			matched = append(matched, trigger)
+		}
+	}
+	return matched, nil
+}
+
+func evaluateConditions(all, anyOf []TriggerCondition, rc RuleContext) (bool, error) {
+	for _, c := range all {
+		ok, err := evaluateCondition(c, rc)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	if len(anyOf) == 0 {
+		return true, nil
+	}
+	for _, c := range anyOf {
+		ok, err := evaluateCondition(c, rc)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+var (
+	ruleStatusOrder   = map[string]int{"new": 0, "open": 1, "pending": 2, "hold": 3, "solved": 4, "closed": 5}
+	rulePriorityOrder = map[string]int{"": 0, "low": 1, "normal": 2, "high": 3, "urgent": 4}
+)
+
+func evaluateCondition(c TriggerCondition, rc RuleContext) (bool, error) {
+	cur := rc.Ticket
+	var prev Ticket
+	if rc.Previous != nil {
+		prev = *rc.Previous
+	}
+	value := ruleValueString(c.Value)
+	unsupported := &UnsupportedConditionError{Field: c.Field, Operator: c.Operator}
+
+	switch c.Field {
+	case "status":
+		return compareRuleField(c.Operator, value, string(cur.Status), string(prev.Status), ruleStatusOrder, unsupported)
+	case "priority":
+		return compareRuleField(c.Operator, value, string(cur.Priority), string(prev.Priority), rulePriorityOrder, unsupported)
+	case "type":
+		return compareRuleField(c.Operator, value, string(cur.Type), string(prev.Type), nil, unsupported)
+	case "group_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.GroupID), ruleID(prev.GroupID), nil, unsupported)
+	case "assignee_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.AssigneeID), ruleID(prev.AssigneeID), nil, unsupported)
+	case "requester_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.RequesterID), ruleID(prev.RequesterID), nil, unsupported)
+	case "organization_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.OrganizationID), ruleID(prev.OrganizationID), nil, unsupported)
+	case "brand_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.BrandID), ruleID(prev.BrandID), nil, unsupported)
+	case "ticket_form_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.TicketFormID), ruleID(prev.TicketFormID), nil, unsupported)
+	case "custom_status_id":
+		return compareRuleField(c.Operator, value, ruleID(cur.CustomStatusID), ruleID(prev.CustomStatusID), nil, unsupported)
+	case "recipient":
+		return compareRuleField(c.Operator, value, cur.Recipient, prev.Recipient, nil, unsupported)
+	case "current_tags":
+		return matchRuleWords(c.Operator, value, cur.Tags, unsupported)
+	case "subject_includes_word":
+		return matchRuleText(c.Operator, value, cur.Subject, unsupported)
+	case "description_includes_word":
+		return matchRuleText(c.Operator, value, cur.Description, unsupported)
+	case "comment_includes_word":
+		text := ""
+		if rc.Comment != nil {
+			text = rc.Comment.PlainText()
+		}
+		return matchRuleText(c.Operator, value, text, unsupported)
+	case "comment_is_public":
+		if c.Operator != "is" {
+			return false, unsupported
+		}
+		switch value {
+		case "not_relevant":
+			return true, nil
+		case "requester_can_see_comment", "true":
+			return rc.Comment != nil && (rc.Comment.Public == nil || *rc.Comment.Public), nil
+		case "false":
+			return rc.Comment != nil && rc.Comment.Public != nil && !*rc.Comment.Public, nil
+		}
+		return false, unsupported
+	case "ticket_is_public":
+		if c.Operator != "is" {
+			return false, unsupported
+		}
+		return (value == "public") == cur.IsPublic, nil
+	case "update_type":
+		if c.Operator != "is" {
+			return false, unsupported
+		}
+		updateType := "Change"
+		if rc.Previous == nil {
+			updateType = "Create"
+		}
+		return strings.EqualFold(value, updateType), nil
+	case "created_at", "updated_at", "due_date", "until_due_date":
+		return compareRuleHours(c, rc, unsupported)
+	}
+
+	if strings.HasPrefix(c.Field, "custom_fields_") {
+		id, err := strconv.ParseInt(strings.TrimPrefix(c.Field, "custom_fields_"), 10, 64)
+		if err != nil {
+			return false, unsupported
+		}
+		curValue, _ := cur.CustomFieldValue(id)
+		prevValue, _ := prev.CustomFieldValue(id)
+		switch c.Operator {
+		case "present":
+			return ruleValueString(curValue) != "", nil
+		case "not_present":
+			return ruleValueString(curValue) == "", nil
+		case "includes", "not_includes":
+			values, _ := customFieldMulti(curValue)
+			return matchRuleWords(c.Operator, value, values, unsupported)
+		}
+		return compareRuleField(c.Operator, value, ruleValueString(curValue), ruleValueString(prevValue), nil, unsupported)
+	}
+	return false, unsupported
+}
+
+// compareRuleField evaluates the operators for the fields which can be compared
+// with the value and the previous value. order is used for less_than and greater_than.
+func compareRuleField(op, value, cur, prev string, order map[string]int, unsupported error) (bool, error) {
+	changed := cur != prev
+	switch op {
+	case "is":
+		return cur == value, nil
+	case "is_not":
+		return cur != value, nil
+	case "less_than", "greater_than":
+		if order == nil {
+			return false, unsupported
+		}
+		c, ok1 := order[cur]
+		v, ok2 := order[value]
+		if !ok1 || !ok2 {
+			return false, nil
+		}
+		if op == "less_than" {
+			return c < v, nil
+		}
+		return c > v, nil
+	case "changed":
+		return changed, nil
+	case "not_changed":
+		return !changed, nil
+	case "value":
+		return changed && cur == value, nil
+	case "value_previous":
+		return changed && prev == value, nil
+	case "not_value":
+		return changed && cur != value, nil
+	case "not_value_previous":
+		return changed && prev != value, nil
+	}
+	return false, unsupported
+}
+
+// matchRuleWords evaluates includes and not_includes of space separated value against the words
+func matchRuleWords(op, value string, words []string, unsupported error) (bool, error) {
+	set := map[string]bool{}
+	for _, w := range words {
+		set[strings.ToLower(w)] = true
+	}
+
+	found := false
+	for _, w := range strings.Fields(value) {
+		if set[strings.ToLower(w)] {
+			found = true
+			break
+		}
+	}
+
+	switch op {
+	case "includes":
+		return found, nil
+	case "not_includes":
+		return !found, nil
+	}
+	return false, unsupported
+}
+
+// matchRuleText evaluates the operators of *_includes_word conditions. "is"
+// and "is_not" match the value as a phrase.
+func matchRuleText(op, value, text string, unsupported error) (bool, error) {
+	switch op {
+	case "is", "is_not":
+		contains := strings.Contains(strings.ToLower(text), strings.ToLower(value))
+		return contains == (op == "is"), nil
+	}
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !(r == '-' || r == '_' || r == '\'' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || r > 127)
+	})
+	return matchRuleWords(op, value, words, unsupported)
+}
+
+// compareRuleHours evaluates the time based conditions of automations in hours
+func compareRuleHours(c TriggerCondition, rc RuleContext, unsupported error) (bool, error) {
+	now := rc.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var hours float64
+	switch c.Field {
+	case "created_at", "updated_at":
+		t := rc.Ticket.CreatedAt
+		if c.Field == "updated_at" {
+			t = rc.Ticket.UpdatedAt
+		}
+		if t == nil {
+			return false, nil
+		}
+		hours = now.Sub(*t).Hours()
+	case "due_date", "until_due_date":
+		if rc.Ticket.DueAt == nil {
+			return false, nil
+		}
+		hours = rc.Ticket.DueAt.Sub(now).Hours()
+		if c.Field == "due_date" {
+			hours = -hours
+		}
+	}
+
+	target, err := strconv.ParseFloat(ruleValueString(c.Value), 64)
+	if err != nil {
+		return false, unsupported
+	}
+	switch c.Operator {
+	case "is":
+		return int64(hours) == int64(target), nil
+	case "less_than":
+		return hours < target, nil
+	case "greater_than":
+		return hours > target, nil
+	}
+	return false, unsupported
+}
+
+func ruleID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// ruleValueString returns the value of condition or field as string
+func ruleValueString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if values, ok := customFieldMulti(v); ok {
+		return strings.Join(values, " ")
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestTrigger(id int64, position int64, all []TriggerCondition, anyOf []TriggerCondition) Trigger {
+	trigger := Trigger{ID: id, Title: "trigger", Active: true, Position: position}
+	trigger.Conditions.All = all
+	trigger.Conditions.Any = anyOf
+	return trigger
+}
+
+func TestEvaluateTrigger(t *testing.T) {
+	public := true
+	prev := Ticket{Status: TicketStatusNew, Priority: TicketPriorityNormal, GroupID: 1, Tags: []string{"vip"}}
+	cur := Ticket{
+		Status:       TicketStatusOpen,
+		Priority:     TicketPriorityUrgent,
+		GroupID:      1,
+		Subject:      "Printer is on fire",
+		Tags:         []string{"vip", "printer"},
+		CustomFields: []CustomField{{ID: 100, Value: "hardware"}, {ID: 101, Value: []interface{}{"a", "b"}}},
+	}
+	rc := RuleContext{Ticket: cur, Previous: &prev, Comment: &TicketComment{Body: "Please refund me", Public: &public}}
+
+	tests := []struct {
+		condition TriggerCondition
+		expected  bool
+	}{
+		{TriggerCondition{"status", "is", "open"}, true},
+		{TriggerCondition{"status", "less_than", "solved"}, true},
+		{TriggerCondition{"status", "value", "open"}, true},
+		{TriggerCondition{"status", "value_previous", "new"}, true},
+		{TriggerCondition{"priority", "greater_than", "high"}, true},
+		{TriggerCondition{"priority", "not_changed", nil}, false},
+		{TriggerCondition{"group_id", "changed", nil}, false},
+		{TriggerCondition{"group_id", "is", float64(1)}, true},
+		{TriggerCondition{"assignee_id", "is", ""}, true},
+		{TriggerCondition{"current_tags", "includes", "billing printer"}, true},
+		{TriggerCondition{"current_tags", "not_includes", "billing"}, true},
+		{TriggerCondition{"subject_includes_word", "includes", "fire smoke"}, true},
+		{TriggerCondition{"subject_includes_word", "is", "is on fire"}, true},
+		{TriggerCondition{"comment_includes_word", "includes", "REFUND"}, true},
+		{TriggerCondition{"comment_is_public", "is", "true"}, true},
+		{TriggerCondition{"update_type", "is", "Change"}, true},
+		{TriggerCondition{"custom_fields_100", "is", "hardware"}, true},
+		{TriggerCondition{"custom_fields_100", "changed", nil}, true},
+		{TriggerCondition{"custom_fields_101", "includes", "b"}, true},
+		{TriggerCondition{"custom_fields_102", "not_present", nil}, true},
+	}
+	for _, test := range tests {
+		trigger := newTestTrigger(1, 1, []TriggerCondition{test.condition}, nil)
+		ok, err := EvaluateTrigger(trigger, rc)
+		if err != nil {
+			t.Fatalf("Failed to evaluate %v: %s", test.condition, err)
+		}
+		if ok != test.expected {
+			t.Errorf("condition %v: expected %v, but got %v", test.condition, test.expected, ok)
+		}
+	}
+}
+
+func TestEvaluateTriggerUnsupported(t *testing.T) {
+	trigger := newTestTrigger(1, 1, []TriggerCondition{{"via_id", "is", float64(4)}}, nil)
+	_, err := EvaluateTrigger(trigger, RuleContext{})
+	var unsupported *UnsupportedConditionError
+	if !errors.As(err, &unsupported) || unsupported.Field != "via_id" {
+		t.Fatalf("expected UnsupportedConditionError, but got %v", err)
+	}
+}
+
+func TestMatchingTriggers(t *testing.T) {
+	triggers := []Trigger{
+		newTestTrigger(3, 3, []TriggerCondition{{"update_type", "is", "Create"}},
+			[]TriggerCondition{{"priority", "is", "urgent"}, {"current_tags", "includes", "vip"}}),
+		newTestTrigger(1, 1, []TriggerCondition{{"update_type", "is", "Create"}, {"status", "is", "new"}}, nil),
+		newTestTrigger(2, 2, []TriggerCondition{{"update_type", "is", "Change"}}, nil),
+		newTestTrigger(4, 4, []TriggerCondition{{"status", "changed", nil}}, nil),
+	}
+	inactive := newTestTrigger(5, 0, nil, nil)
+	inactive.Active = false
+	triggers = append(triggers, inactive)
+
+	matched, err := MatchingTriggers(triggers, RuleContext{Ticket: Ticket{Status: TicketStatusNew, Tags: []string{"vip"}}})
+	if err != nil {
+		t.Fatalf("Failed to match triggers: %s", err)
+	}
+	if len(matched) != 3 || matched[0].ID != 1 || matched[1].ID != 3 || matched[2].ID != 4 {
+		t.Fatalf("unexpected triggers %v", matched)
+	}
+}
+
+func TestEvaluateAutomation(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	updatedAt := now.Add(-50 * time.Hour)
+	automation := Automation{}
+	automation.Conditions.All = []AutomationCondition{
+		{Field: "status", Operator: "is", Value: "pending"},
+		{Field: "updated_at", Operator: "greater_than", Value: "48"},
+	}
+
+	ok, err := EvaluateAutomation(automation, RuleContext{
+		Ticket: Ticket{Status: TicketStatusPending, UpdatedAt: &updatedAt},
+		Now:    now,
+	})
+	if err != nil || !ok {
+		t.Fatalf("unexpected result %v %v", ok, err)
+	}
+}
@@ -0,0 +1,71 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ScheduleInterval is an interval of business hours in minutes from the start of the week (Sunday 00:00)
+type ScheduleInterval struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+// Schedule is struct for business hours schedule payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/
+type Schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals,omitempty"`
+	CreatedAt *Time              `json:"created_at,omitempty"`
+	UpdatedAt *Time              `json:"updated_at,omitempty"`
+}
+
+// ScheduleAPI an interface containing all schedule related methods
+type ScheduleAPI interface {
+	GetSchedules(ctx context.Context) ([]Schedule, error)
+	GetSchedule(ctx context.Context, id int64) (Schedule, error)
+}
+
+// GetSchedules fetches all schedules
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#list-schedules
+func (z *Client) GetSchedules(ctx context.Context) ([]Schedule, error) {
+	var data struct {
+		Schedules []Schedule `json:"schedules"`
+	}
+
+	body, err := z.get(ctx, "/business_hours/schedules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Schedules, nil
+}
+
+// GetSchedule fetches a schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/schedules/#show-schedule
+func (z *Client) GetSchedule(ctx context.Context, id int64) (Schedule, error) {
+	var result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+	return result.Schedule, nil
+}
@@ -0,0 +1,36 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetSchedules(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedules.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedules, err := client.GetSchedules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get schedules: %s", err)
+	}
+
+	if len(schedules) != 1 || len(schedules[0].Intervals) != 2 {
+		t.Fatalf("unexpected schedules %v", schedules)
+	}
+}
+
+func TestGetSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.GetSchedule(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %s", err)
+	}
+
+	if schedule.ID != 1 || schedule.TimeZone != "Pacific Time (US & Canada)" {
+		t.Fatalf("unexpected schedule %v", schedule)
+	}
+}
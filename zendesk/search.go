@@ -11,9 +11,35 @@ import (
 // ref: https://developer.zendesk.com/rest_api/docs/support/search#available-parameters
 type SearchOptions struct {
 	PageOptions
-	Query     string `url:"query"`
-	SortBy    string `url:"sort_by,omitempty"`
-	SortOrder string `url:"sort_order,omitempty"`
+	Query     string          `url:"query"`
+	SortBy    SearchSortField `url:"sort_by,omitempty"`
+	SortOrder SortOrder       `url:"sort_order,omitempty"`
+}
+
+// SearchSortField is the field which search results are sorted by.
+// The results are sorted by relevance by default.
+type SearchSortField string
+
+const (
+	// SearchSortByUpdatedAt sorts search results by last update time
+	SearchSortByUpdatedAt SearchSortField = "updated_at"
+	// SearchSortByCreatedAt sorts search results by creation time
+	SearchSortByCreatedAt SearchSortField = "created_at"
+	// SearchSortByPriority sorts search results by ticket priority
+	SearchSortByPriority SearchSortField = "priority"
+	// SearchSortByStatus sorts search results by ticket status
+	SearchSortByStatus SearchSortField = "status"
+	// SearchSortByTicketType sorts search results by ticket type
+	SearchSortByTicketType SearchSortField = "ticket_type"
+)
+
+// Validate checks the sort field and order
+func (o SearchOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		SearchSortByUpdatedAt, SearchSortByCreatedAt, SearchSortByPriority, SearchSortByStatus, SearchSortByTicketType); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
 }
 
 // CountOptions are the options that can be provided to the search results count API
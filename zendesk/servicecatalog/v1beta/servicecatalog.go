@@ -0,0 +1,90 @@
+// Package servicecatalog provides read access to the employee service catalog
+// of Help Center, which is in early access.
+//
+// The package is versioned as v1beta separately from package zendesk because
+// the endpoints may change before they are generally available. Breaking
+// changes of the endpoints are followed by a new version of this package.
+//
+//	catalog := servicecatalog.New(client)
+//	items, meta, err := catalog.GetItems(ctx, nil)
+package servicecatalog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Item is a service which employees can request from the catalog.
+// Requesting the item creates a ticket with the ticket form of FormID.
+type Item struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description,omitempty"`
+	FormID       int64                  `json:"form_id,omitempty"`
+	ThumbnailURL string                 `json:"thumbnail_url,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_object_fields,omitempty"`
+	CreatedAt    zendesk.Time           `json:"created_at"`
+	UpdatedAt    zendesk.Time           `json:"updated_at"`
+}
+
+// ListOptions is options for GetItems and SearchItems
+type ListOptions struct {
+	zendesk.CursorPagination
+}
+
+// Client is a client of service catalog endpoints.
+// It sends requests through zendesk.BaseAPI, so the credential, retries and
+// middlewares of the zendesk.Client are shared.
+type Client struct {
+	api zendesk.BaseAPI
+}
+
+// New creates Client sending requests with api, typically *zendesk.Client
+func New(api zendesk.BaseAPI) *Client {
+	return &Client{api: api}
+}
+
+// GetItems fetches the service catalog items
+func (c *Client) GetItems(ctx context.Context, opts *ListOptions) ([]Item, zendesk.CursorPaginationMeta, error) {
+	return c.listItems(ctx, "/help_center/service_catalog/items", nil, opts)
+}
+
+// SearchItems fetches the service catalog items matching the query
+func (c *Client) SearchItems(ctx context.Context, q string, opts *ListOptions) ([]Item, zendesk.CursorPaginationMeta, error) {
+	return c.listItems(ctx, "/help_center/service_catalog/items/search", url.Values{"query": {q}}, opts)
+}
+
+// GetItem fetches the service catalog item
+func (c *Client) GetItem(ctx context.Context, itemID string) (Item, error) {
+	return zendesk.GetData[Item](ctx, c.api, fmt.Sprintf("/help_center/service_catalog/items/%s", url.PathEscape(itemID)), "service_catalog_item")
+}
+
+func (c *Client) listItems(ctx context.Context, path string, params url.Values, opts *ListOptions) ([]Item, zendesk.CursorPaginationMeta, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, zendesk.CursorPaginationMeta{}, err
+	}
+	for key, values := range params {
+		v[key] = values
+	}
+	if encoded := v.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+
+	result, err := zendesk.GetData[struct {
+		Items []Item                       `json:"service_catalog_items"`
+		Meta  zendesk.CursorPaginationMeta `json:"meta"`
+	}](ctx, c.api, path, "")
+	if err != nil {
+		return nil, zendesk.CursorPaginationMeta{}, err
+	}
+	return result.Items, result.Meta, nil
+}
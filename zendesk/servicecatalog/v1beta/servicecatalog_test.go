@@ -0,0 +1,68 @@
+package servicecatalog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+var ctx = context.Background()
+
+func newTestCatalog(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := zendesk.NewClient(nil)
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+	if err := client.SetEndpointURL(server.URL); err != nil {
+		t.Fatalf("Failed to set endpoint: %s", err)
+	}
+	return New(client)
+}
+
+func TestSearchItems(t *testing.T) {
+	catalog := newTestCatalog(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/help_center/service_catalog/items/search" || q.Get("query") != "laptop" || q.Get("page[size]") != "10" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{
+			"service_catalog_items": [
+				{"id": "01HQ8X1", "name": "New laptop", "form_id": 123, "created_at": "2024-01-10T10:00:00Z", "updated_at": "2024-01-10T10:00:00Z"}
+			],
+			"meta": {"has_more": true, "after_cursor": "MDFIUThYMQ"}
+		}`))
+	})
+
+	items, meta, err := catalog.SearchItems(ctx, "laptop", &ListOptions{
+		CursorPagination: zendesk.CursorPagination{PageSize: 10},
+	})
+	if err != nil {
+		t.Fatalf("Failed to search items: %s", err)
+	}
+	if len(items) != 1 || items[0].FormID != 123 || !meta.HasMore {
+		t.Fatalf("unexpected items %v %v", items, meta)
+	}
+}
+
+func TestGetItem(t *testing.T) {
+	catalog := newTestCatalog(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/service_catalog/items/01HQ8X1" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"service_catalog_item": {"id": "01HQ8X1", "name": "New laptop", "custom_object_fields": {"cost": 1200}}}`))
+	})
+
+	item, err := catalog.GetItem(ctx, "01HQ8X1")
+	if err != nil {
+		t.Fatalf("Failed to get item: %s", err)
+	}
+	if item.Name != "New laptop" || item.CustomFields["cost"] != float64(1200) {
+		t.Fatalf("unexpected item %v", item)
+	}
+}
@@ -0,0 +1,131 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// SharingAgreementTypeInbound is the type of agreement sharing tickets from the remote account
+	SharingAgreementTypeInbound = "inbound"
+	// SharingAgreementTypeOutbound is the type of agreement sharing tickets to the remote account
+	SharingAgreementTypeOutbound = "outbound"
+
+	// SharingAgreementStatusPending is the status of agreement waiting for the response of the remote account
+	SharingAgreementStatusPending = "pending"
+	// SharingAgreementStatusAccepted is the status of agreement accepted by the remote account
+	SharingAgreementStatusAccepted = "accepted"
+	// SharingAgreementStatusDeclined is the status of agreement declined by the remote account
+	SharingAgreementStatusDeclined = "declined"
+	// SharingAgreementStatusInactive is the status of agreement deactivated by either account
+	SharingAgreementStatusInactive = "inactive"
+)
+
+// SharingAgreement is an agreement of sharing tickets with another Zendesk account
+// https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/
+type SharingAgreement struct {
+	ID              int64  `json:"id,omitempty"`
+	URL             string `json:"url,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Status          string `json:"status,omitempty"`
+	PartnerName     string `json:"partner_name,omitempty"`
+	RemoteSubdomain string `json:"remote_subdomain,omitempty"`
+	CreatedAt       *Time  `json:"created_at,omitempty"`
+	UpdatedAt       *Time  `json:"updated_at,omitempty"`
+}
+
+// SharingAgreementAPI an interface containing all sharing agreement related methods
+type SharingAgreementAPI interface {
+	GetSharingAgreements(ctx context.Context) ([]SharingAgreement, error)
+	GetSharingAgreement(ctx context.Context, agreementID int64) (SharingAgreement, error)
+	CreateSharingAgreement(ctx context.Context, agreement SharingAgreement) (SharingAgreement, error)
+	UpdateSharingAgreement(ctx context.Context, agreementID int64, agreement SharingAgreement) (SharingAgreement, error)
+	DeleteSharingAgreement(ctx context.Context, agreementID int64) error
+}
+
+// GetSharingAgreements fetches the sharing agreements
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/#list-sharing-agreements
+func (z *Client) GetSharingAgreements(ctx context.Context) ([]SharingAgreement, error) {
+	var result struct {
+		SharingAgreements []SharingAgreement `json:"sharing_agreements"`
+	}
+
+	body, err := z.get(ctx, "/sharing_agreements.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.SharingAgreements, nil
+}
+
+// GetSharingAgreement fetches the sharing agreement
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/#show-a-sharing-agreement
+func (z *Client) GetSharingAgreement(ctx context.Context, agreementID int64) (SharingAgreement, error) {
+	var result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/sharing_agreements/%d.json", agreementID))
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// CreateSharingAgreement invites the account of RemoteSubdomain to share tickets
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/#create-sharing-agreement
+func (z *Client) CreateSharingAgreement(ctx context.Context, agreement SharingAgreement) (SharingAgreement, error) {
+	var data, result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+	data.SharingAgreement = agreement
+
+	body, err := z.post(ctx, "/sharing_agreements.json", data)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// UpdateSharingAgreement updates the sharing agreement. Only Status can be changed,
+// e.g. SharingAgreementStatusAccepted to accept an inbound agreement.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/#update-a-sharing-agreement
+func (z *Client) UpdateSharingAgreement(ctx context.Context, agreementID int64, agreement SharingAgreement) (SharingAgreement, error) {
+	var data, result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+	data.SharingAgreement = agreement
+
+	body, err := z.put(ctx, fmt.Sprintf("/sharing_agreements/%d.json", agreementID), data)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// DeleteSharingAgreement deletes the sharing agreement
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/sharing_agreements/#delete-a-sharing-agreement
+func (z *Client) DeleteSharingAgreement(ctx context.Context, agreementID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/sharing_agreements/%d.json", agreementID))
+}
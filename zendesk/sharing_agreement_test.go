@@ -0,0 +1,65 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSharingAgreements(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sharing_agreements.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreements, err := client.GetSharingAgreements(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get sharing agreements: %s", err)
+	}
+	if len(agreements) != 2 || agreements[0].RemoteSubdomain != "partner" || agreements[1].PartnerName != "jira" {
+		t.Fatalf("unexpected sharing agreements %v", agreements)
+	}
+}
+
+func TestCreateSharingAgreement(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "sharing_agreement.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreement, err := client.CreateSharingAgreement(ctx, SharingAgreement{RemoteSubdomain: "reseller"})
+	if err != nil {
+		t.Fatalf("Failed to create sharing agreement: %s", err)
+	}
+	if agreement.ID != 3 || agreement.Status != SharingAgreementStatusPending {
+		t.Fatalf("unexpected sharing agreement %v", agreement)
+	}
+}
+
+func TestUpdateSharingAgreement(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "sharing_agreement.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreement, err := client.UpdateSharingAgreement(ctx, 4, SharingAgreement{Status: SharingAgreementStatusAccepted})
+	if err != nil {
+		t.Fatalf("Failed to update sharing agreement: %s", err)
+	}
+	if agreement.Type != SharingAgreementTypeInbound || agreement.Status != SharingAgreementStatusAccepted {
+		t.Fatalf("unexpected sharing agreement %v", agreement)
+	}
+}
+
+func TestDeleteSharingAgreement(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/sharing_agreements/4.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteSharingAgreement(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to delete sharing agreement: %s", err)
+	}
+}
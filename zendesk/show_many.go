@@ -0,0 +1,155 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// showManyLimit is the maximum number of IDs show_many endpoints accept
+const showManyLimit = 100
+
+// GetTicketsByIDs gets the tickets of any number of IDs, splitting them into
+// chunks of show_many endpoint. Chunks are fetched with the given concurrency.
+// If some chunks fail, the tickets of the other chunks are returned along with
+// the errors joined.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#show-multiple-tickets
+func (z *Client) GetTicketsByIDs(ctx context.Context, ids []int64, concurrency int) ([]Ticket, error) {
+	return showManyInChunks(ctx, ids, concurrency, z.GetMultipleTickets)
+}
+
+// GetUsersByIDs gets the users of any number of IDs in chunks as GetTicketsByIDs does
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
+func (z *Client) GetUsersByIDs(ctx context.Context, ids []int64, concurrency int) ([]User, error) {
+	return showManyInChunks(ctx, ids, concurrency, func(ctx context.Context, chunk []int64) ([]User, error) {
+		users, _, err := z.GetManyUsers(ctx, &GetManyUsersOptions{IDs: joinIDs(chunk)})
+		return users, err
+	})
+}
+
+// GetOrganizationsByIDs gets the organizations of any number of IDs in chunks as GetTicketsByIDs does
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+func (z *Client) GetOrganizationsByIDs(ctx context.Context, ids []int64, concurrency int) ([]Organization, error) {
+	return showManyInChunks(ctx, ids, concurrency, func(ctx context.Context, chunk []int64) ([]Organization, error) {
+		var result struct {
+			Organizations []Organization `json:"organizations"`
+		}
+
+		body, err := z.get(ctx, "/organizations/show_many.json?ids="+joinIDs(chunk))
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+		return result.Organizations, nil
+	})
+}
+
+// getByExternalIDs gets the records of externalIDs from show_many endpoint at path,
+// splitting them into chunks. key is the key of records in the response.
+func getByExternalIDs[T any](ctx context.Context, z *Client, path string, key string, externalIDs []string) ([]T, error) {
+	var all []T
+	for start := 0; start < len(externalIDs); start += showManyLimit {
+		end := start + showManyLimit
+		if end > len(externalIDs) {
+			end = len(externalIDs)
+		}
+
+		var opts struct {
+			ExternalIDs string `url:"external_ids"`
+		}
+		opts.ExternalIDs = strings.Join(externalIDs[start:end], ",")
+
+		u, err := addOptions(path, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		var result map[string]json.RawMessage
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		raw, ok := result[key]
+		if !ok {
+			continue
+		}
+
+		var items []T
+		err = json.Unmarshal(raw, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
+
+// showManyInChunks calls fetch for each chunk of unique ids. Unlike FetchInBatches,
+// a failed chunk does not stop the others so that the partial result is returned.
+func showManyInChunks[T any](ctx context.Context, ids []int64, concurrency int, fetch func(ctx context.Context, chunk []int64) ([]T, error)) ([]T, error) {
+	seen := make(map[int64]bool, len(ids))
+	var chunks [][]int64
+	var chunk []int64
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		chunk = append(chunk, id)
+		if len(chunk) == showManyLimit {
+			chunks = append(chunks, chunk)
+			chunk = nil
+		}
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	indices := make([]int, len(chunks))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	errs := make([]error, len(chunks))
+	results, err := fetchConcurrently(ctx, indices, concurrency, func(ctx context.Context, i int) ([]T, error) {
+		items, err := fetch(ctx, chunks[i])
+		if err != nil {
+			errs[i] = fmt.Errorf("ids %d..%d: %w", chunks[i][0], chunks[i][len(chunks[i])-1], err)
+		}
+		return items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var all []T
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	return all, errors.Join(errs...)
+}
+
+func joinIDs(ids []int64) string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(strs, ",")
+}
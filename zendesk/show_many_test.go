@@ -0,0 +1,106 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetTicketsByIDs(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(ids) > showManyLimit {
+			t.Errorf("%d ids exceed the limit", len(ids))
+		}
+
+		var tickets []Ticket
+		for _, s := range ids {
+			id, _ := strconv.ParseInt(s, 10, 64)
+			tickets = append(tickets, Ticket{ID: id})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"tickets": tickets})
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	ids = append(ids, 1, 2)
+
+	tickets, err := client.GetTicketsByIDs(ctx, ids, 2)
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 250 {
+		t.Fatalf("expected 250 tickets, but got %d", len(tickets))
+	}
+	if tickets[0].ID != 1 || tickets[249].ID != 250 {
+		t.Fatal("tickets should be in the order of ids")
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests, but got %d", requests)
+	}
+}
+
+func TestGetUsersByIDsPartialFailure(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if ids[0] != "1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var users []User
+		for _, s := range ids {
+			id, _ := strconv.ParseInt(s, 10, 64)
+			users = append(users, User{ID: id})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"users": users})
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	users, err := client.GetUsersByIDs(ctx, ids, 1)
+	if err == nil {
+		t.Fatal("expected error of the failed chunk")
+	}
+	if !strings.Contains(err.Error(), "ids 101..150") {
+		t.Fatalf("error should describe the failed chunk: %s", err)
+	}
+	if len(users) != 100 {
+		t.Fatalf("expected users of the succeeded chunk, but got %d", len(users))
+	}
+}
+
+func TestGetOrganizationsByIDs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/show_many.json" || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"organizations":[{"id":1},{"id":2}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.GetOrganizationsByIDs(ctx, []int64{1, 2}, 0)
+	if err != nil {
+		t.Fatalf("Failed to get organizations: %s", err)
+	}
+	if len(orgs) != 2 {
+		t.Fatalf("expected 2 organizations, but got %d", len(orgs))
+	}
+}
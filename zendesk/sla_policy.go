@@ -58,9 +58,32 @@ type SLAPolicy struct {
 // ref: https://developer.zendesk.com/rest_api/docs/support/slas/policies#list-slas/policies
 type SLAPolicyListOptions struct {
 	PageOptions
-	Active    bool   `url:"active,omitempty"`
-	SortBy    string `url:"sort_by,omitempty"`
-	SortOrder string `url:"sort_order,omitempty"`
+	Active    bool               `url:"active,omitempty"`
+	SortBy    SLAPolicySortField `url:"sort_by,omitempty"`
+	SortOrder SortOrder          `url:"sort_order,omitempty"`
+}
+
+// SLAPolicySortField is the field which SLA policies are sorted by
+type SLAPolicySortField string
+
+const (
+	// SLAPolicySortByPosition sorts SLA policies by the order they are applied
+	SLAPolicySortByPosition SLAPolicySortField = "position"
+	// SLAPolicySortByTitle sorts SLA policies by title
+	SLAPolicySortByTitle SLAPolicySortField = "title"
+	// SLAPolicySortByCreatedAt sorts SLA policies by creation time
+	SLAPolicySortByCreatedAt SLAPolicySortField = "created_at"
+	// SLAPolicySortByUpdatedAt sorts SLA policies by last update time
+	SLAPolicySortByUpdatedAt SLAPolicySortField = "updated_at"
+)
+
+// Validate checks the sort field and order
+func (o SLAPolicyListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		SLAPolicySortByPosition, SLAPolicySortByTitle, SLAPolicySortByCreatedAt, SLAPolicySortByUpdatedAt); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
 }
 
 // SLAPolicyAPI an interface containing all slaPolicy related methods
@@ -103,6 +126,20 @@ func (z *Client) GetSLAPolicies(ctx context.Context, opts *SLAPolicyListOptions)
 	return data.SLAPolicies, data.Page, nil
 }
 
+// GetSLAPoliciesPage fetches the page of SLA policies at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetSLAPoliciesPage(ctx context.Context, token PageToken, opts *SLAPolicyListOptions) (ListPage[SLAPolicy], error) {
+	tmp := SLAPolicyListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]SLAPolicy, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetSLAPolicies(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateSLAPolicy creates new slaPolicy
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/slas/policies#create-slaPolicy
@@ -0,0 +1,223 @@
+package zendesk
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// SLABreachState is the state of SLABreach
+type SLABreachState string
+
+const (
+	// SLABreachUpcoming is the state of metric which breaches within the window
+	SLABreachUpcoming SLABreachState = "upcoming"
+	// SLABreachOverdue is the state of metric which has breached
+	SLABreachOverdue SLABreachState = "overdue"
+)
+
+// SLABreach is a notification of a metric of a ticket breaching its SLA target
+type SLABreach struct {
+	TicketID    int64
+	Metric      string
+	InstanceID  int64
+	PolicyID    int64
+	PolicyTitle string
+	// Target is in minutes
+	Target        int
+	BusinessHours bool
+	// DueAt is when the metric breaches
+	DueAt time.Time
+	State SLABreachState
+}
+
+// SLAWatcherOptions is options for NewSLAWatcher
+type SLAWatcherOptions struct {
+	// Policies limits the watched metrics to those under the policies if not empty
+	Policies []SLAPolicy
+	// Window is how long before DueAt a metric is reported as upcoming. The default is 1 hour.
+	Window time.Duration
+}
+
+type slaMetricKey struct {
+	ticketID   int64
+	metric     string
+	instanceID int64
+}
+
+type slaMetricState struct {
+	sla       *TicketMetricEventSLA
+	dueAt     time.Time
+	paused    bool
+	fulfilled bool
+	notified  SLABreachState
+}
+
+// SLAWatcher computes the upcoming and overdue SLA breaches from ticket metric events.
+// Feed the events with Add in the order of the incremental export, then call
+// Breaches or Notify. SLAWatcher is not safe for concurrent use.
+type SLAWatcher struct {
+	policies map[int64]SLAPolicy
+	window   time.Duration
+	metrics  map[slaMetricKey]*slaMetricState
+}
+
+// NewSLAWatcher creates SLAWatcher
+func NewSLAWatcher(opts *SLAWatcherOptions) *SLAWatcher {
+	tmp := opts
+	if tmp == nil {
+		tmp = &SLAWatcherOptions{}
+	}
+
+	w := &SLAWatcher{
+		window:  tmp.Window,
+		metrics: map[slaMetricKey]*slaMetricState{},
+	}
+	if w.window <= 0 {
+		w.window = time.Hour
+	}
+	if len(tmp.Policies) > 0 {
+		w.policies = map[int64]SLAPolicy{}
+		for _, p := range tmp.Policies {
+			w.policies[p.ID] = p
+		}
+	}
+	return w
+}
+
+// Add applies the ticket metric events to the state of the watcher
+func (w *SLAWatcher) Add(events ...TicketMetricEvent) {
+	for _, e := range events {
+		key := slaMetricKey{e.TicketID, e.Metric, e.InstanceID}
+		m, ok := w.metrics[key]
+		if !ok {
+			m = &slaMetricState{}
+			w.metrics[key] = m
+		}
+
+		switch e.Type {
+		case TicketMetricEventApplySLA, TicketMetricEventApplyGroupSLA:
+			m.sla = e.SLA
+		case TicketMetricEventBreach:
+			m.dueAt = e.Time.Time
+		case TicketMetricEventPause:
+			m.paused = true
+			m.dueAt = time.Time{}
+		case TicketMetricEventActivate:
+			m.paused = false
+		case TicketMetricEventFulfill:
+			m.fulfilled = true
+		}
+	}
+}
+
+// Breaches returns the metrics which are overdue or breach within the window
+// at now, sorted by DueAt. Fulfilled and paused metrics are excluded.
+func (w *SLAWatcher) Breaches(now time.Time) []SLABreach {
+	var breaches []SLABreach
+	for key, m := range w.metrics {
+		if b, ok := w.breach(key, m, now); ok {
+			breaches = append(breaches, b)
+		}
+	}
+	sortSLABreaches(breaches)
+	return breaches
+}
+
+// Notify returns the breaches whose state has changed since the last call,
+// so that each metric is reported once as upcoming and once as overdue.
+func (w *SLAWatcher) Notify(now time.Time) []SLABreach {
+	var breaches []SLABreach
+	for key, m := range w.metrics {
+		b, ok := w.breach(key, m, now)
+		if !ok || m.notified == b.State {
+			continue
+		}
+		m.notified = b.State
+		breaches = append(breaches, b)
+	}
+	sortSLABreaches(breaches)
+	return breaches
+}
+
+func (w *SLAWatcher) breach(key slaMetricKey, m *slaMetricState, now time.Time) (SLABreach, bool) {
+	if m.sla == nil || m.fulfilled || m.paused {
+		return SLABreach{}, false
+	}
+	if w.policies != nil {
+		if _, ok := w.policies[m.sla.Policy.ID]; !ok {
+			return SLABreach{}, false
+		}
+	}
+
+	dueAt := m.dueAt
+	if dueAt.IsZero() {
+		return SLABreach{}, false
+	}
+
+	b := SLABreach{
+		TicketID:      key.ticketID,
+		Metric:        key.metric,
+		InstanceID:    key.instanceID,
+		PolicyID:      m.sla.Policy.ID,
+		PolicyTitle:   m.sla.Policy.Title,
+		Target:        m.sla.Target,
+		BusinessHours: m.sla.BusinessHours,
+		DueAt:         dueAt,
+	}
+	if b.PolicyTitle == "" {
+		b.PolicyTitle = w.policies[b.PolicyID].Title
+	}
+
+	switch {
+	case !now.Before(dueAt):
+		b.State = SLABreachOverdue
+	case dueAt.Sub(now) <= w.window:
+		b.State = SLABreachUpcoming
+	default:
+		return SLABreach{}, false
+	}
+	return b, true
+}
+
+func sortSLABreaches(breaches []SLABreach) {
+	sort.Slice(breaches, func(i, j int) bool {
+		if !breaches[i].DueAt.Equal(breaches[j].DueAt) {
+			return breaches[i].DueAt.Before(breaches[j].DueAt)
+		}
+		return breaches[i].TicketID < breaches[j].TicketID
+	})
+}
+
+// Watch follows the incremental ticket metric events export from startTime,
+// and calls fn for each breach notified by Notify. It polls the export every
+// interval until ctx is done or fn returns an error.
+func (w *SLAWatcher) Watch(ctx context.Context, z *Client, startTime int64, interval time.Duration, fn func(SLABreach) error) error {
+	for {
+		for {
+			events, page, err := z.GetIncrementalTicketMetricEvents(ctx, &IncrementalTicketMetricEventsOptions{StartTime: startTime})
+			if err != nil {
+				return err
+			}
+			w.Add(events...)
+
+			done := page.EndOfStream || len(events) == 0 || page.EndTime <= startTime
+			if page.EndTime > startTime {
+				startTime = page.EndTime
+			}
+			if done {
+				break
+			}
+		}
+
+		for _, b := range w.Notify(time.Now()) {
+			if err := fn(b); err != nil {
+				return err
+			}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
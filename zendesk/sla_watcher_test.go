@@ -0,0 +1,90 @@
+package zendesk
+
+import (
+	"testing"
+	"time"
+)
+
+func slaTestEvents(ticketID int64, policyID int64, start time.Time, target int) []TicketMetricEvent {
+	sla := &TicketMetricEventSLA{Target: target}
+	sla.Policy.ID = policyID
+	sla.Policy.Title = "Urgent tickets"
+	return []TicketMetricEvent{
+		{TicketID: ticketID, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventActivate, Time: NewTime(start)},
+		{TicketID: ticketID, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventApplySLA, Time: NewTime(start), SLA: sla},
+		{TicketID: ticketID, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventBreach, Time: NewTime(start.Add(time.Duration(target) * time.Minute))},
+	}
+}
+
+func TestSLAWatcherBreaches(t *testing.T) {
+	start := time.Date(2020, 9, 29, 5, 0, 0, 0, time.UTC)
+	w := NewSLAWatcher(&SLAWatcherOptions{Window: 30 * time.Minute})
+	w.Add(slaTestEvents(1, 10, start, 60)...)
+	w.Add(slaTestEvents(2, 10, start, 20)...)
+	w.Add(slaTestEvents(3, 10, start, 240)...)
+	w.Add(slaTestEvents(4, 10, start, 10)...)
+	w.Add(TicketMetricEvent{TicketID: 4, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventFulfill, Time: NewTime(start.Add(5 * time.Minute))})
+
+	breaches := w.Breaches(start.Add(40 * time.Minute))
+	if len(breaches) != 2 {
+		t.Fatalf("unexpected breaches %v", breaches)
+	}
+	if breaches[0].TicketID != 2 || breaches[0].State != SLABreachOverdue || breaches[0].PolicyTitle != "Urgent tickets" {
+		t.Fatalf("unexpected breach %v", breaches[0])
+	}
+	if breaches[1].TicketID != 1 || breaches[1].State != SLABreachUpcoming || !breaches[1].DueAt.Equal(start.Add(time.Hour)) {
+		t.Fatalf("unexpected breach %v", breaches[1])
+	}
+}
+
+func TestSLAWatcherPause(t *testing.T) {
+	start := time.Date(2020, 9, 29, 5, 0, 0, 0, time.UTC)
+	w := NewSLAWatcher(nil)
+	w.Add(slaTestEvents(1, 10, start, 60)...)
+	w.Add(TicketMetricEvent{TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventPause, Time: NewTime(start.Add(time.Minute))})
+
+	if breaches := w.Breaches(start.Add(2 * time.Hour)); len(breaches) != 0 {
+		t.Fatalf("paused metric should not breach %v", breaches)
+	}
+}
+
+func TestSLAWatcherPolicies(t *testing.T) {
+	start := time.Date(2020, 9, 29, 5, 0, 0, 0, time.UTC)
+	w := NewSLAWatcher(&SLAWatcherOptions{Policies: []SLAPolicy{{ID: 10}}})
+	w.Add(slaTestEvents(1, 10, start, 60)...)
+	w.Add(slaTestEvents(2, 20, start, 60)...)
+
+	breaches := w.Breaches(start.Add(2 * time.Hour))
+	if len(breaches) != 1 || breaches[0].TicketID != 1 {
+		t.Fatalf("unexpected breaches %v", breaches)
+	}
+}
+
+func TestSLAWatcherNotify(t *testing.T) {
+	start := time.Date(2020, 9, 29, 5, 0, 0, 0, time.UTC)
+	w := NewSLAWatcher(nil)
+	w.Add(slaTestEvents(1, 10, start, 90)...)
+
+	steps := []struct {
+		now   time.Duration
+		state SLABreachState
+	}{
+		{10 * time.Minute, ""},
+		{40 * time.Minute, SLABreachUpcoming},
+		{50 * time.Minute, ""},
+		{90 * time.Minute, SLABreachOverdue},
+		{100 * time.Minute, ""},
+	}
+	for _, s := range steps {
+		breaches := w.Notify(start.Add(s.now))
+		if s.state == "" {
+			if len(breaches) != 0 {
+				t.Fatalf("unexpected notification at %s: %v", s.now, breaches)
+			}
+			continue
+		}
+		if len(breaches) != 1 || breaches[0].State != s.state {
+			t.Fatalf("unexpected notification at %s: %v", s.now, breaches)
+		}
+	}
+}
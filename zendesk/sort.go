@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SortOrder is the order of the list sorted by SortBy
+type SortOrder string
+
+const (
+	// SortAscending sorts the list in ascending order
+	SortAscending SortOrder = "asc"
+	// SortDescending sorts the list in descending order
+	SortDescending SortOrder = "desc"
+)
+
+// SortError is returned by list methods when the options have a sort field
+// or order which the endpoint doesn't accept, instead of sending the request
+// to be rejected by Zendesk.
+type SortError struct {
+	Param   string
+	Value   string
+	Allowed []string
+}
+
+// Error the error string for this error
+func (e *SortError) Error() string {
+	return fmt.Sprintf("invalid %s %q: must be one of %s", e.Param, e.Value, strings.Join(e.Allowed, ", "))
+}
+
+// validateSort checks that the value is empty or one of allowed
+func validateSort[T ~string](param string, value T, allowed ...T) error {
+	if value == "" {
+		return nil
+	}
+	names := make([]string, 0, len(allowed))
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+		names = append(names, string(a))
+	}
+	return &SortError{Param: param, Value: string(value), Allowed: names}
+}
+
+// validateSortOrder checks that the order is empty, asc or desc
+func validateSortOrder(order SortOrder) error {
+	return validateSort("sort_order", order, SortAscending, SortDescending)
+}
+
+// optionsValidator is implemented by the options validated by addOptions
+type optionsValidator interface {
+	Validate() error
+}
+
+// validateOptions validates the options if they implement optionsValidator
+func validateOptions(opts any) error {
+	v, ok := opts.(optionsValidator)
+	if !ok {
+		return nil
+	}
+	if rv := reflect.ValueOf(opts); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	return v.Validate()
+}
+
+// RuleSortField is the field which business rules such as macros, triggers
+// and automations are sorted by. Position is not available for macros, and
+// usage_30d is not available for automations.
+type RuleSortField string
+
+const (
+	// RuleSortByAlphabetical sorts rules by title
+	RuleSortByAlphabetical RuleSortField = "alphabetical"
+	// RuleSortByCreatedAt sorts rules by creation time
+	RuleSortByCreatedAt RuleSortField = "created_at"
+	// RuleSortByUpdatedAt sorts rules by last update time
+	RuleSortByUpdatedAt RuleSortField = "updated_at"
+	// RuleSortByPosition sorts rules by the order they are run
+	RuleSortByPosition RuleSortField = "position"
+	// RuleSortByUsage1h sorts rules by the number of uses in the last hour
+	RuleSortByUsage1h RuleSortField = "usage_1h"
+	// RuleSortByUsage24h sorts rules by the number of uses in the last 24 hours
+	RuleSortByUsage24h RuleSortField = "usage_24h"
+	// RuleSortByUsage7d sorts rules by the number of uses in the last 7 days
+	RuleSortByUsage7d RuleSortField = "usage_7d"
+	// RuleSortByUsage30d sorts rules by the number of uses in the last 30 days
+	RuleSortByUsage30d RuleSortField = "usage_30d"
+)
@@ -0,0 +1,53 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInvalidSortFailsLocally(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request %s", r.URL)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var sortErr *SortError
+	_, _, err := client.GetTickets(ctx, &TicketListOptions{SortBy: "priority"})
+	if !errors.As(err, &sortErr) || sortErr.Param != "sort_by" || sortErr.Value != "priority" {
+		t.Fatalf("expected SortError, got %v", err)
+	}
+
+	_, _, err = client.GetMacros(ctx, &MacroListOptions{SortBy: RuleSortByPosition})
+	if !errors.As(err, &sortErr) {
+		t.Fatalf("expected SortError, got %v", err)
+	}
+
+	_, _, err = client.GetSLAPolicies(ctx, &SLAPolicyListOptions{SortBy: "name"})
+	if !errors.As(err, &sortErr) || sortErr.Param != "sort_by" || sortErr.Value != "name" {
+		t.Fatalf("expected SortError, got %v", err)
+	}
+
+	_, _, err = client.Search(ctx, &SearchOptions{Query: "type:ticket", SortOrder: "descending"})
+	if !errors.As(err, &sortErr) || sortErr.Param != "sort_order" {
+		t.Fatalf("expected SortError, got %v", err)
+	}
+}
+
+func TestValidateSort(t *testing.T) {
+	if err := (TicketListOptions{SortBy: TicketSortByRequesterName, SortOrder: SortDescending}).Validate(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := (SLAPolicyListOptions{SortBy: SLAPolicySortByPosition, SortOrder: SortAscending}).Validate(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := (TriggerListOptions{}).Validate(); err != nil {
+		t.Fatalf("empty sort should be valid, got %v", err)
+	}
+	err := (ContentTagListOptions{Sort: "-title"}).Validate()
+	if err == nil || err.Error() != `invalid sort "-title": must be one of name, -name, created_at, -created_at` {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
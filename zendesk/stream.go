@@ -0,0 +1,113 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// StreamList fetches the list at path and decodes the JSON array at key of the
+// response item by item, calling fn for each of them. Unlike the list methods
+// of Client, the response is never held in memory as a whole, which makes it
+// suitable for large payloads such as incremental exports.
+//
+// The other members of the response object, e.g. next_page or end_time, are
+// returned as raw JSON so that the caller can continue pagination.
+// If fn returns an error, streaming stops and the error is returned.
+//
+//	rest, err := zendesk.StreamList(ctx, client, "/incremental/tickets/cursor.json?start_time=0", "tickets",
+//		func(ticket zendesk.Ticket) error {
+//			return enc.Encode(ticket)
+//		})
+func StreamList[T any](ctx context.Context, z *Client, path string, key string, fn func(T) error) (map[string]json.RawMessage, error) {
+	resp, err := z.doRequest(ctx, path, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	return decodeListStream(json.NewDecoder(resp.Body), key, fn)
+}
+
+// decodeListStream decodes JSON object from dec, calling fn for each element of the array at key
+func decodeListStream[T any](dec *json.Decoder, key string, fn func(T) error) (map[string]json.RawMessage, error) {
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	rest := map[string]json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		name, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v in list response", tok)
+		}
+
+		if name != key {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			rest[name] = raw
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if tok == nil {
+			continue
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return nil, fmt.Errorf("expected [ but got %v in list response", tok)
+		}
+		for dec.More() {
+			var item T
+			if err := dec.Decode(&item); err != nil {
+				return nil, err
+			}
+			if err := fn(item); err != nil {
+				return nil, err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return rest, nil
+}
+
+// expectDelim reads next token from dec and checks if it is the delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %s but got %v in list response", delim, tok)
+	}
+	return nil
+}
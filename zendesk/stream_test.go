@@ -0,0 +1,81 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStreamList(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	rest, err := StreamList(ctx, client, "/tickets.json", "tickets", func(ticket Ticket) error {
+		ids = append(ids, ticket.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream tickets: %s", err)
+	}
+
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 tickets, but got %d", len(ids))
+	}
+	if _, ok := rest["count"]; !ok {
+		t.Fatalf("expected count to be returned, but got %v", rest)
+	}
+}
+
+func TestStreamListStopsOnCallbackError(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stop := errors.New("stop")
+	calls := 0
+	_, err := StreamList(ctx, client, "/tickets.json", "tickets", func(ticket Ticket) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("expected callback error, but got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, but got %d", calls)
+	}
+}
+
+func TestStreamListNull(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"tickets":null,"end_of_stream":true}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rest, err := StreamList(ctx, client, "/tickets.json", "tickets", func(ticket Ticket) error {
+		t.Fatal("callback should not be called")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream tickets: %s", err)
+	}
+	if string(rest["end_of_stream"]) != "true" {
+		t.Fatalf("unexpected rest %v", rest)
+	}
+}
+
+func TestStreamListFailure(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "tickets.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := StreamList(ctx, client, "/tickets.json", "tickets", func(ticket Ticket) error {
+		return nil
+	})
+	if _, ok := err.(Error); !ok {
+		t.Fatalf("Did not return a zendesk error %s", err)
+	}
+}
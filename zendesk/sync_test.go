@@ -0,0 +1,145 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newIncrementalMockAPI(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets/cursor.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			if r.URL.Query().Get("start_time") != "1559529292" {
+				t.Fatalf("unexpected start_time %s", r.URL.Query().Get("start_time"))
+			}
+			w.Write([]byte(`{"tickets":[{"id":1},{"id":2}],"after_cursor":"c1","end_of_stream":false}`))
+		case "c1":
+			w.Write([]byte(`{"tickets":[{"id":3}],"after_cursor":"c2","end_of_stream":true}`))
+		case "c2":
+			w.Write([]byte(`{"tickets":[],"after_cursor":"c2","end_of_stream":true}`))
+		default:
+			t.Fatalf("unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+}
+
+func TestSyncRunnerResume(t *testing.T) {
+	mockAPI := newIncrementalMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	store := NewMemoryCursorStore()
+	runner := NewSyncRunner[Ticket](client, "tickets", store)
+	runner.StartTime = time.Unix(1559529292, 0)
+
+	errCrash := errors.New("crash")
+	var ids []int64
+	err := runner.Run(ctx, func(ticket Ticket) error {
+		if ticket.ID == 3 {
+			return errCrash
+		}
+		ids = append(ids, ticket.ID)
+		return nil
+	})
+	if !errors.Is(err, errCrash) {
+		t.Fatalf("expected error from callback, but got %v", err)
+	}
+	if cursor, _ := store.Load(ctx, "tickets"); cursor != "c1" {
+		t.Fatalf("checkpoint should be c1, but got %s", cursor)
+	}
+
+	err = runner.Run(ctx, func(ticket Ticket) error {
+		ids = append(ids, ticket.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to resume sync: %s", err)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Fatalf("unexpected tickets %v", ids)
+	}
+	if cursor, _ := store.Load(ctx, "tickets"); cursor != "c2" {
+		t.Fatalf("checkpoint should be c2, but got %s", cursor)
+	}
+
+	if err := runner.Run(ctx, func(ticket Ticket) error { return nil }); err != nil {
+		t.Fatalf("Failed to sync from the end of stream: %s", err)
+	}
+}
+
+func TestSyncRunnerWithoutStartTime(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start_time") != "0" {
+			t.Fatalf("unexpected start_time %s", r.URL.Query().Get("start_time"))
+		}
+		w.Write([]byte(`{"tickets":[{"id":1}],"after_cursor":"c1","end_of_stream":true}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	runner := NewSyncRunner[Ticket](client, "tickets", NewMemoryCursorStore())
+	var ids []int64
+	err := runner.Run(ctx, func(ticket Ticket) error {
+		ids = append(ids, ticket.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to sync: %s", err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("unexpected tickets %v", ids)
+	}
+}
+
+func TestFileCursorStore(t *testing.T) {
+	store, err := NewFileCursorStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create store: %s", err)
+	}
+
+	if cursor, err := store.Load(ctx, "tickets"); err != nil || cursor != "" {
+		t.Fatalf("expected empty checkpoint, but got %q, %v", cursor, err)
+	}
+	if err := store.Save(ctx, "tickets", "c1"); err != nil {
+		t.Fatalf("Failed to save: %s", err)
+	}
+	if err := store.Save(ctx, "tickets", "c2"); err != nil {
+		t.Fatalf("Failed to save: %s", err)
+	}
+	if cursor, err := store.Load(ctx, "tickets"); err != nil || cursor != "c2" {
+		t.Fatalf("expected c2, but got %q, %v", cursor, err)
+	}
+}
+
+func TestFileCursorStoreKey(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileCursorStore(filepath.Join(dir, "cursors"))
+	if err != nil {
+		t.Fatalf("Failed to create store: %s", err)
+	}
+
+	if err := store.Save(ctx, "../tickets", "c1"); err != nil {
+		t.Fatalf("Failed to save: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "tickets")); !os.IsNotExist(err) {
+		t.Fatalf("checkpoint was saved outside of the directory: %v", err)
+	}
+	if cursor, err := store.Load(ctx, "../tickets"); err != nil || cursor != "c1" {
+		t.Fatalf("expected c1, but got %q, %v", cursor, err)
+	}
+
+	for _, key := range []string{"", ".", ".."} {
+		if err := store.Save(ctx, key, "c1"); !errors.Is(err, errInvalidCursorKey) {
+			t.Fatalf("expected invalid key error for %q, but got %v", key, err)
+		}
+	}
+}
@@ -38,12 +38,22 @@ type TargetAPI interface {
 // GetTargets fetches target list
 // ref: https://developer.zendesk.com/rest_api/docs/core/targets#list-targets
 func (z *Client) GetTargets(ctx context.Context) ([]Target, Page, error) {
+	return z.getTargets(ctx, PageOptions{})
+}
+
+// getTargets fetches the page of targets of opts
+func (z *Client) getTargets(ctx context.Context, opts PageOptions) ([]Target, Page, error) {
 	var data struct {
 		Targets []Target `json:"targets"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/targets.json")
+	u, err := addOptions("/targets.json", opts)
+	if err != nil {
+		return []Target{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
 	if err != nil {
 		return []Target{}, Page{}, err
 	}
@@ -56,6 +66,12 @@ func (z *Client) GetTargets(ctx context.Context) ([]Target, Page, error) {
 	return data.Targets, data.Page, nil
 }
 
+// GetTargetsPage fetches the page of targets at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetTargetsPage(ctx context.Context, token PageToken) (ListPage[Target], error) {
+	return OffsetLister(z.getTargets)(ctx, token)
+}
+
 // CreateTarget creates new target
 // ref: https://developer.zendesk.com/rest_api/docs/core/targets#create-target
 func (z *Client) CreateTarget(ctx context.Context, target Target) (Target, error) {
@@ -1,8 +1,10 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,23 +13,42 @@ import (
 
 type CustomField struct {
 	ID int64 `json:"id"`
-	// Valid types are string or []string.
+	// Valid types are string, bool, []string, int64 or float64. Numeric fields are
+	// returned as string, and lookup relationship fields as int64.
+	// Use the typed accessors of Ticket such as CustomFieldString to read the value.
 	Value interface{} `json:"value"`
 }
 
 // UnmarshalJSON Custom Unmarshal function required because a custom field's value can be
-// a string or array of strings.
+// a string, array of strings or number. Integer numbers are decoded as int64.
 func (cf *CustomField) UnmarshalJSON(data []byte) error {
 	var temp map[string]interface{}
-	if err := json.Unmarshal(data, &temp); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&temp); err != nil {
 		return err
 	}
 
-	cf.ID = int64(temp["id"].(float64))
+	id, ok := temp["id"].(json.Number)
+	if !ok {
+		return fmt.Errorf("%T is an invalid type for custom field id", temp["id"])
+	}
+	var err error
+	if cf.ID, err = id.Int64(); err != nil {
+		return err
+	}
 
 	switch v := temp["value"].(type) {
 	case string, nil, bool:
 		cf.Value = v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			cf.Value = i
+		} else if f, err := v.Float64(); err == nil {
+			cf.Value = f
+		} else {
+			return err
+		}
 	case []interface{}:
 		var list []string
 
@@ -48,31 +69,31 @@ func (cf *CustomField) UnmarshalJSON(data []byte) error {
 }
 
 type Ticket struct {
-	ID              int64         `json:"id,omitempty"`
-	URL             string        `json:"url,omitempty"`
-	ExternalID      string        `json:"external_id,omitempty"`
-	Type            string        `json:"type,omitempty"`
-	Subject         string        `json:"subject,omitempty"`
-	RawSubject      string        `json:"raw_subject,omitempty"`
-	Description     string        `json:"description,omitempty"`
-	Priority        string        `json:"priority,omitempty"`
-	Status          string        `json:"status,omitempty"`
-	CustomStatusID  int64         `json:"custom_status_id,omitempty"`
-	Recipient       string        `json:"recipient,omitempty"`
-	RequesterID     int64         `json:"requester_id,omitempty"`
-	SubmitterID     int64         `json:"submitter_id,omitempty"`
-	AssigneeID      int64         `json:"assignee_id,omitempty"`
-	OrganizationID  int64         `json:"organization_id,omitempty"`
-	GroupID         int64         `json:"group_id,omitempty"`
-	CollaboratorIDs []int64       `json:"collaborator_ids,omitempty"`
-	FollowerIDs     []int64       `json:"follower_ids,omitempty"`
-	EmailCCIDs      []int64       `json:"email_cc_ids,omitempty"`
-	ForumTopicID    int64         `json:"forum_topic_id,omitempty"`
-	ProblemID       int64         `json:"problem_id,omitempty"`
-	HasIncidents    bool          `json:"has_incidents,omitempty"`
-	DueAt           *time.Time    `json:"due_at,omitempty"`
-	Tags            []string      `json:"tags,omitempty"`
-	CustomFields    []CustomField `json:"custom_fields,omitempty"`
+	ID              int64          `json:"id,omitempty"`
+	URL             string         `json:"url,omitempty"`
+	ExternalID      string         `json:"external_id,omitempty"`
+	Type            TicketType     `json:"type,omitempty"`
+	Subject         string         `json:"subject,omitempty"`
+	RawSubject      string         `json:"raw_subject,omitempty"`
+	Description     string         `json:"description,omitempty"`
+	Priority        TicketPriority `json:"priority,omitempty"`
+	Status          TicketStatus   `json:"status,omitempty"`
+	CustomStatusID  int64          `json:"custom_status_id,omitempty"`
+	Recipient       string         `json:"recipient,omitempty"`
+	RequesterID     int64          `json:"requester_id,omitempty"`
+	SubmitterID     int64          `json:"submitter_id,omitempty"`
+	AssigneeID      int64          `json:"assignee_id,omitempty"`
+	OrganizationID  int64          `json:"organization_id,omitempty"`
+	GroupID         int64          `json:"group_id,omitempty"`
+	CollaboratorIDs []int64        `json:"collaborator_ids,omitempty"`
+	FollowerIDs     []int64        `json:"follower_ids,omitempty"`
+	EmailCCIDs      []int64        `json:"email_cc_ids,omitempty"`
+	ForumTopicID    int64          `json:"forum_topic_id,omitempty"`
+	ProblemID       int64          `json:"problem_id,omitempty"`
+	HasIncidents    bool           `json:"has_incidents,omitempty"`
+	DueAt           *time.Time     `json:"due_at,omitempty"`
+	Tags            []string       `json:"tags,omitempty"`
+	CustomFields    []CustomField  `json:"custom_fields,omitempty"`
 
 	Via *Via `json:"via,omitempty"`
 
@@ -97,20 +118,46 @@ type Ticket struct {
 	// Collaborators is POST only
 	Collaborators *Collaborators `json:"collaborators,omitempty"`
 
+	// AdditionalCollaborators, EmailCCs and Followers are write only.
+	// AdditionalCollaborators adds collaborators without replacing the current ones,
+	// and EmailCCs and Followers add or remove users by their Action.
+	AdditionalCollaborators *Collaborators `json:"additional_collaborators,omitempty"`
+	EmailCCs                []EmailCC      `json:"email_ccs,omitempty"`
+	Followers               []Follower     `json:"followers,omitempty"`
+
+	// Metadata is write only and saved as the custom metadata of the audit of the update
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
 	// Comment is POST only and required
 	Comment *TicketComment `json:"comment,omitempty"`
 
+	// ViaID and VoiceComment are POST only and used by CreateVoiceTicket.
+	// ViaID is one of ViaVoicemail, ViaAPIPhoneCallInbound or ViaAPIPhoneCallOutbound etc.
+	ViaID        int           `json:"via_id,omitempty"`
+	VoiceComment *VoiceComment `json:"voice_comment,omitempty"`
+
 	// Requester is POST only and can be used to create a ticket for a nonexistent requester
 	Requester *Requester `json:"requester,omitempty"`
 
 	// safe update fields
 	// https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-updating-tickets/#protecting-against-ticket-update-collisions
+	// If SafeUpdate is true and UpdatedStamp is nil, UpdateTicket uses UpdatedAt as the stamp.
 	UpdatedStamp *time.Time `json:"updated_stamp,omitempty"`
 	SafeUpdate   bool       `json:"safe_update,omitempty"`
 
+	// NullFields is a list of JSON field names sent as null to clear them on update,
+	// e.g. []string{"assignee_id"} to unassign the ticket.
+	NullFields []string `json:"-"`
+
 	// TODO: TicketAudit (POST only) #126
 }
 
+// MarshalJSON is marshaller for Ticket which sends NullFields as null
+func (t Ticket) MarshalJSON() ([]byte, error) {
+	type ticket Ticket
+	return marshalWithNullFields(ticket(t), t.NullFields)
+}
+
 // Requester is the struct that can be passed to create a new requester on ticket creation
 // https://develop.zendesk.com/hc/en-us/articles/360059146153#creating-a-ticket-with-a-new-requester
 type Requester struct {
@@ -121,31 +168,163 @@ type Requester struct {
 
 // Via is information about source of Ticket or TicketComment
 type Via struct {
-	Channel string `json:"channel"`
-	Source  struct {
-		From map[string]interface{} `json:"from"`
-		To   map[string]interface{} `json:"to"`
-		Rel  string                 `json:"rel"`
-	} `json:"source"`
+	Channel string    `json:"channel"`
+	Source  ViaSource `json:"source"`
+}
+
+// ViaSource is the source of Via. The members of From and To depend on the channel,
+// e.g. address and name for email, phone and formatted_phone for voice.
+//
+// https://developer.zendesk.com/documentation/ticketing/reference-guides/via-object-reference/
+type ViaSource struct {
+	From map[string]interface{} `json:"from"`
+	To   map[string]interface{} `json:"to"`
+	Rel  string                 `json:"rel"`
+}
+
+// ViaParty is the typed form of From and To of ViaSource.
+// Only the members of the channel are set, e.g. Address for email,
+// Phone for voice and sms, Username for twitter and ID and Title for rule.
+type ViaParty struct {
+	ID             int64  `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Title          string `json:"title,omitempty"`
+	Address        string `json:"address,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	FormattedPhone string `json:"formatted_phone,omitempty"`
+	TicketID       int64  `json:"ticket_id,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+
+	// OriginalRecipients and EmailCCs are the addresses of email channel
+	OriginalRecipients []string `json:"original_recipients,omitempty"`
+	EmailCCs           []string `json:"email_ccs,omitempty"`
+
+	// ProfileURL is the profile of twitter and facebook users
+	ProfileURL string `json:"profile_url,omitempty"`
+	Username   string `json:"username,omitempty"`
+	TwitterID  string `json:"twitter_id,omitempty"`
+	FacebookID string `json:"facebook_id,omitempty"`
+
+	// ServiceInfo and the following are the integration of any_channel
+	ServiceInfo                      string `json:"service_info,omitempty"`
+	RegisteredIntegrationServiceName string `json:"registered_integration_service_name,omitempty"`
+	SupportsChannelback              bool   `json:"supports_channelback,omitempty"`
+	SupportsClickthrough             bool   `json:"supports_clickthrough,omitempty"`
+}
+
+// FromParty returns From as ViaParty
+func (s ViaSource) FromParty() ViaParty {
+	return newViaParty(s.From)
+}
+
+// ToParty returns To as ViaParty
+func (s ViaSource) ToParty() ViaParty {
+	return newViaParty(s.To)
+}
+
+func newViaParty(m map[string]interface{}) ViaParty {
+	var party ViaParty
+	if b, err := json.Marshal(m); err == nil {
+		_ = json.Unmarshal(b, &party)
+	}
+	return party
+}
+
+// VoiceComment is the voice comment of a ticket created by Talk Partner Edition integrations
+//
+// https://developer.zendesk.com/api-reference/voice/talk-partner-edition-api/reference/#creating-tickets
+type VoiceComment struct {
+	From              string `json:"from,omitempty"`
+	To                string `json:"to,omitempty"`
+	RecordingURL      string `json:"recording_url,omitempty"`
+	StartedAt         *Time  `json:"started_at,omitempty"`
+	CallDuration      int64  `json:"call_duration,omitempty"`
+	AnsweredByID      int64  `json:"answered_by_id,omitempty"`
+	TranscriptionText string `json:"transcription_text,omitempty"`
+	Location          string `json:"location,omitempty"`
+}
+
+// SafeUpdateError is returned by UpdateTicket when a safe update was rejected
+// because the ticket had been updated after the given UpdatedStamp.
+// It matches ErrConflict by errors.Is.
+type SafeUpdateError struct {
+	TicketID     int64
+	UpdatedStamp time.Time
+	err          error
+}
+
+// Error the error string for this error
+func (e *SafeUpdateError) Error() string {
+	return fmt.Sprintf("ticket %d was updated after %s: %s", e.TicketID, e.UpdatedStamp.Format(time.RFC3339), e.err)
 }
 
+// Unwrap returns the underlying Error
+func (e *SafeUpdateError) Unwrap() error {
+	return e.err
+}
+
+// errSafeUpdateWithoutStamp is returned when safe update was requested without any timestamp
+var errSafeUpdateWithoutStamp = errors.New("safe update requires UpdatedStamp or UpdatedAt")
+
+// TicketSortField is the field which tickets are sorted by
+type TicketSortField string
+
+const (
+	// TicketSortByAssignee sorts tickets by assignee
+	TicketSortByAssignee TicketSortField = "assignee"
+	// TicketSortByAssigneeName sorts tickets by the name of assignee
+	TicketSortByAssigneeName TicketSortField = "assignee.name"
+	// TicketSortByCreatedAt sorts tickets by creation time
+	TicketSortByCreatedAt TicketSortField = "created_at"
+	// TicketSortByGroup sorts tickets by group
+	TicketSortByGroup TicketSortField = "group"
+	// TicketSortByID sorts tickets by ID
+	TicketSortByID TicketSortField = "id"
+	// TicketSortByLocale sorts tickets by the locale of requester
+	TicketSortByLocale TicketSortField = "locale"
+	// TicketSortByRequester sorts tickets by requester
+	TicketSortByRequester TicketSortField = "requester"
+	// TicketSortByRequesterName sorts tickets by the name of requester
+	TicketSortByRequesterName TicketSortField = "requester.name"
+	// TicketSortByStatus sorts tickets by status
+	TicketSortByStatus TicketSortField = "status"
+	// TicketSortBySubject sorts tickets by subject
+	TicketSortBySubject TicketSortField = "subject"
+	// TicketSortByUpdatedAt sorts tickets by last update time
+	TicketSortByUpdatedAt TicketSortField = "updated_at"
+)
+
 type TicketListOptions struct {
 	PageOptions
 
-	// SortBy can take "assignee", "assignee.name", "created_at", "group", "id",
-	// "locale", "requester", "requester.name", "status", "subject", "updated_at"
-	SortBy string `url:"sort_by,omitempty"`
+	SortBy    TicketSortField `url:"sort_by,omitempty"`
+	SortOrder SortOrder       `url:"sort_order,omitempty"`
 
-	// SortOrder can take "asc" or "desc"
-	SortOrder string `url:"sort_order,omitempty"`
+	// ExternalID lists only the tickets with the external ID
+	ExternalID string `url:"external_id,omitempty"`
+}
+
+// Validate checks the sort field and order
+func (o TicketListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		TicketSortByAssignee, TicketSortByAssigneeName, TicketSortByCreatedAt, TicketSortByGroup,
+		TicketSortByID, TicketSortByLocale, TicketSortByRequester, TicketSortByRequesterName,
+		TicketSortByStatus, TicketSortBySubject, TicketSortByUpdatedAt); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
 }
 
 // TicketAPI an interface containing all ticket related methods
 type TicketAPI interface {
 	GetTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTicketsCursor(ctx context.Context, opts *CursorPagination) ([]Ticket, CursorPaginationMeta, error)
 	GetTicket(ctx context.Context, id int64) (Ticket, error)
 	GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error)
+	GetTicketsByIDs(ctx context.Context, ids []int64, concurrency int) ([]Ticket, error)
+	GetTicketsByExternalIDs(ctx context.Context, externalIDs []string) ([]Ticket, error)
 	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
+	CreateVoiceTicket(ctx context.Context, ticket Ticket) (Ticket, error)
 	UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
 }
@@ -181,6 +360,56 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 	return data.Tickets, data.Page, nil
 }
 
+// GetTicketsPage fetches the page of tickets at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetTicketsPage(ctx context.Context, token PageToken, opts *TicketListOptions) (ListPage[Ticket], error) {
+	tmp := TicketListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Ticket, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetTickets(ctx, &tmp)
+	})(ctx, token)
+}
+
+// GetTicketsCursor gets ticket list with cursor pagination, which is not
+// limited in depth unlike offset pagination.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) GetTicketsCursor(ctx context.Context, opts *CursorPagination) ([]Ticket, CursorPaginationMeta, error) {
+	var data struct {
+		Tickets []Ticket             `json:"tickets"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
+	// page[size] switches the endpoint to cursor pagination
+	tmp := CursorPagination{}
+	if opts != nil {
+		tmp = *opts
+	}
+	if tmp.PageSize == 0 {
+		tmp.PageSize = defaultPerPage
+	}
+
+	u, err := addOptions("/tickets.json", &tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return data.Tickets, data.Meta, nil
+}
+
 // GetTicket gets a specified ticket
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#show-ticket
@@ -236,6 +465,14 @@ func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]T
 	return result.Tickets, nil
 }
 
+// GetTicketsByExternalIDs gets the tickets of any number of external IDs,
+// splitting them into chunks of show_many endpoint
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#show-multiple-tickets
+func (z *Client) GetTicketsByExternalIDs(ctx context.Context, externalIDs []string) ([]Ticket, error) {
+	return getByExternalIDs[Ticket](ctx, z, "/tickets/show_many.json", "tickets", externalIDs)
+}
+
 // CreateTicket create a new ticket
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#create-ticket
@@ -243,6 +480,9 @@ func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error
 	var data, result struct {
 		Ticket Ticket `json:"ticket"`
 	}
+	if err := ticket.Validate(); err != nil {
+		return Ticket{}, err
+	}
 	data.Ticket = ticket
 
 	body, err := z.post(ctx, "/tickets.json", data)
@@ -257,17 +497,62 @@ func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error
 	return result.Ticket, nil
 }
 
-// UpdateTicket update an existing ticket
+// CreateVoiceTicket creates a ticket with a voice comment, e.g. a recorded call
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-partner-edition-api/reference/#creating-tickets
+func (z *Client) CreateVoiceTicket(ctx context.Context, ticket Ticket) (Ticket, error) {
+	var data, result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+
+	if err := ticket.Validate(); err != nil {
+		return Ticket{}, err
+	}
+	data.Ticket = ticket
+
+	body, err := z.post(ctx, "/channels/voice/tickets.json", data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// UpdateTicket update an existing ticket.
+// Set SafeUpdate of ticket to true to reject the update with SafeUpdateError
+// when the ticket has been modified since it was fetched.
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-ticket
 func (z *Client) UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error) {
 	var data, result struct {
 		Ticket Ticket `json:"ticket"`
 	}
+
+	if err := ticket.Validate(); err != nil {
+		return Ticket{}, err
+	}
+
+	if ticket.SafeUpdate && ticket.UpdatedStamp == nil {
+		if ticket.UpdatedAt == nil {
+			return Ticket{}, errSafeUpdateWithoutStamp
+		}
+		ticket.UpdatedStamp = ticket.UpdatedAt
+	}
 	data.Ticket = ticket
 
 	path := fmt.Sprintf("/tickets/%d.json", ticketID)
 	body, err := z.put(ctx, path, data)
 	if err != nil {
+		if ticket.SafeUpdate && errors.Is(err, ErrConflict) {
+			return Ticket{}, &SafeUpdateError{
+				TicketID:     ticketID,
+				UpdatedStamp: *ticket.UpdatedStamp,
+				err:          err,
+			}
+		}
 		return Ticket{}, err
 	}
 
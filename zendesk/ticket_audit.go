@@ -28,11 +28,57 @@ type TicketAuditVia struct {
 	} `json:"source,omitempty"`
 }
 
+// AuditFlag is a flag set on an audit when Zendesk does not trust the update,
+// e.g. the email was sent from an address other than the requester's.
+// See the reference for the meaning of each value.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_audits/#audit-flags
+type AuditFlag int
+
+// AuditFlagOption is the detail of AuditFlag.
+// Message holds the values interpolated into the message shown to agents.
+type AuditFlagOption struct {
+	Trusted *bool             `json:"trusted,omitempty"`
+	Message map[string]string `json:"message,omitempty"`
+}
+
+// AuditFlags are the flags in the metadata of audit
+type AuditFlags struct {
+	Flags   []AuditFlag                   `json:"flags,omitempty"`
+	Options map[AuditFlag]AuditFlagOption `json:"flags_options,omitempty"`
+}
+
+// Trusted reports whether none of the flags is marked untrusted
+func (f AuditFlags) Trusted() bool {
+	for _, flag := range f.Flags {
+		if opt, ok := f.Options[flag]; ok && opt.Trusted != nil && !*opt.Trusted {
+			return false
+		}
+	}
+	return true
+}
+
+// Flags decodes the flags in Metadata of the audit
+func (a TicketAudit) Flags() (AuditFlags, error) {
+	var flags AuditFlags
+	if a.Metadata == nil {
+		return flags, nil
+	}
+
+	b, err := json.Marshal(a.Metadata)
+	if err != nil {
+		return flags, err
+	}
+	err = json.Unmarshal(b, &flags)
+	return flags, err
+}
+
 // TicketAuditAPI an interface containing all of the ticket audit related zendesk methods
 type TicketAuditAPI interface {
 	GetAllTicketAudits(ctx context.Context, opts CursorOption) ([]TicketAudit, Cursor, error)
 	GetTicketAudits(ctx context.Context, ticketID int64, opts PageOptions) ([]TicketAudit, Page, error)
 	GetTicketAudit(ctx context.Context, TicketID, ID int64) (TicketAudit, error)
+	GetFlaggedTicketAudits(ctx context.Context, ticketID int64) ([]TicketAudit, error)
 }
 
 // GetAllTicketAudits list all ticket audits
@@ -87,6 +133,14 @@ func (z *Client) GetTicketAudits(ctx context.Context, ticketID int64, opts PageO
 	return result.Audits, result.Page, err
 }
 
+// GetTicketAuditsPage fetches the page of audits of the ticket at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetTicketAuditsPage(ctx context.Context, ticketID int64, token PageToken) (ListPage[TicketAudit], error) {
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]TicketAudit, Page, error) {
+		return z.GetTicketAudits(ctx, ticketID, opts)
+	})(ctx, token)
+}
+
 // GetTicketAudit show audit
 // ref: https://developer.zendesk.com/rest_api/docs/support/ticket_audits#show-audit
 func (z *Client) GetTicketAudit(ctx context.Context, ticketID, ID int64) (TicketAudit, error) {
@@ -106,3 +160,25 @@ func (z *Client) GetTicketAudit(ctx context.Context, ticketID, ID int64) (Ticket
 
 	return result.Audit, err
 }
+
+// GetFlaggedTicketAudits lists all the audits of a ticket which have flags
+func (z *Client) GetFlaggedTicketAudits(ctx context.Context, ticketID int64) ([]TicketAudit, error) {
+	audits, err := ListAll(ctx, OffsetLister(func(ctx context.Context, opts PageOptions) ([]TicketAudit, Page, error) {
+		return z.GetTicketAudits(ctx, ticketID, opts)
+	}), PageToken{})
+	if err != nil {
+		return nil, err
+	}
+
+	var flagged []TicketAudit
+	for _, audit := range audits {
+		flags, err := audit.Flags()
+		if err != nil {
+			return nil, err
+		}
+		if len(flags.Flags) > 0 {
+			flagged = append(flagged, audit)
+		}
+	}
+	return flagged, nil
+}
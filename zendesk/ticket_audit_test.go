@@ -50,3 +50,28 @@ func TestGetTicketAudit(t *testing.T) {
 		t.Fatalf("Returned ticket audit does not have the expected ID %d. Ticket audit id is %d", expectedID, ticketAudit.ID)
 	}
 }
+
+func TestGetFlaggedTicketAudits(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audits_flagged.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audits, err := client.GetFlaggedTicketAudits(ctx, 666)
+	if err != nil {
+		t.Fatalf("Failed to get flagged ticket audits: %s", err)
+	}
+	if len(audits) != 1 || audits[0].ID != 2 {
+		t.Fatalf("unexpected audits %v", audits)
+	}
+
+	flags, err := audits[0].Flags()
+	if err != nil {
+		t.Fatalf("Failed to decode flags: %s", err)
+	}
+	if len(flags.Flags) != 2 || flags.Options[11].Message["user"] != "Mr. Roboto" {
+		t.Fatalf("unexpected flags %v", flags)
+	}
+	if flags.Trusted() {
+		t.Fatal("flags should not be trusted")
+	}
+}
@@ -0,0 +1,176 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// TicketBundleVersion is the version of the format of TicketBundle
+const TicketBundleVersion = 1
+
+// ticketBundleManifest is the name of the JSON file of TicketBundle in the archive
+const ticketBundleManifest = "ticket.json"
+
+// TicketBundle is a ticket with everything related to it in a portable
+// format, for legal hold, compliance exports and migrations between accounts.
+// Users are the requester, submitter, assignee, collaborators and the authors
+// of comments and audits.
+type TicketBundle struct {
+	Version      int                `json:"version"`
+	ExportedAt   Time               `json:"exported_at"`
+	Ticket       Ticket             `json:"ticket"`
+	Comments     []TicketComment    `json:"comments"`
+	Audits       []TicketAudit      `json:"audits"`
+	Users        []User             `json:"users"`
+	Organization *Organization      `json:"organization,omitempty"`
+	Attachments  []BundleAttachment `json:"attachments,omitempty"`
+}
+
+// BundleAttachment is an attachment of a comment in TicketBundle.
+// Path is the location of the content in the archive.
+type BundleAttachment struct {
+	Attachment
+	CommentID int64  `json:"comment_id"`
+	Path      string `json:"path"`
+}
+
+// User returns the user of the ID in the bundle
+func (b TicketBundle) User(id int64) (User, bool) {
+	for _, u := range b.Users {
+		if u.ID == id {
+			return u, true
+		}
+	}
+	return User{}, false
+}
+
+// TicketBundleAPI an interface containing the methods exporting tickets
+type TicketBundleAPI interface {
+	GetTicketBundle(ctx context.Context, ticketID int64) (TicketBundle, error)
+	ExportTicket(ctx context.Context, ticketID int64, w io.Writer) (TicketBundle, error)
+}
+
+// GetTicketBundle gathers the ticket, its comments, audits, users and
+// organization. The content of attachments is not downloaded.
+func (z *Client) GetTicketBundle(ctx context.Context, ticketID int64) (TicketBundle, error) {
+	ticket, err := z.GetTicket(ctx, ticketID)
+	if err != nil {
+		return TicketBundle{}, err
+	}
+	bundle := TicketBundle{
+		Version:    TicketBundleVersion,
+		ExportedAt: NewTime(time.Now().UTC()),
+		Ticket:     ticket,
+	}
+
+	comments := CursorLister(func(ctx context.Context, opts CursorPagination) ([]TicketComment, CursorPaginationMeta, error) {
+		if opts.PageSize == 0 {
+			opts.PageSize = ListTicketCommentsMaxPageSize
+		}
+		result, err := z.ListTicketComments(ctx, ticketID, &ListTicketCommentsOptions{CursorPagination: opts})
+		if err != nil {
+			return nil, CursorPaginationMeta{}, err
+		}
+		return result.TicketComments, result.Meta, nil
+	})
+	if bundle.Comments, err = ListAll(ctx, comments, PageToken{}); err != nil {
+		return TicketBundle{}, err
+	}
+
+	audits := OffsetLister(func(ctx context.Context, opts PageOptions) ([]TicketAudit, Page, error) {
+		return z.GetTicketAudits(ctx, ticketID, opts)
+	})
+	if bundle.Audits, err = ListAll(ctx, audits, PageToken{}); err != nil {
+		return TicketBundle{}, err
+	}
+
+	userIDs := []int64{ticket.RequesterID, ticket.SubmitterID, ticket.AssigneeID}
+	userIDs = append(userIDs, ticket.CollaboratorIDs...)
+	userIDs = append(userIDs, ticket.FollowerIDs...)
+	userIDs = append(userIDs, ticket.EmailCCIDs...)
+	for _, c := range bundle.Comments {
+		userIDs = append(userIDs, c.AuthorID)
+		for _, a := range c.Attachments {
+			bundle.Attachments = append(bundle.Attachments, BundleAttachment{
+				Attachment: a,
+				CommentID:  c.ID,
+				Path:       bundleAttachmentPath(a),
+			})
+		}
+	}
+	for _, a := range bundle.Audits {
+		userIDs = append(userIDs, a.AuthorID)
+	}
+	if bundle.Users, err = z.GetUsersByIDs(ctx, uniquePositiveIDs(userIDs), 1); err != nil {
+		return TicketBundle{}, err
+	}
+
+	if ticket.OrganizationID != 0 {
+		org, err := z.GetOrganization(ctx, ticket.OrganizationID)
+		if err != nil {
+			return TicketBundle{}, err
+		}
+		bundle.Organization = &org
+	}
+	return bundle, nil
+}
+
+// ExportTicket writes the bundle of the ticket to w as a zip archive with
+// the attachments downloaded. The archive has the bundle as "ticket.json"
+// and the attachments at their Path.
+func (z *Client) ExportTicket(ctx context.Context, ticketID int64, w io.Writer) (TicketBundle, error) {
+	bundle, err := z.GetTicketBundle(ctx, ticketID)
+	if err != nil {
+		return TicketBundle{}, err
+	}
+
+	archive := zip.NewWriter(w)
+	for _, a := range bundle.Attachments {
+		f, err := archive.Create(a.Path)
+		if err != nil {
+			return TicketBundle{}, err
+		}
+		if _, err := z.DownloadAttachment(ctx, a.Attachment, f); err != nil {
+			return TicketBundle{}, fmt.Errorf("failed to download attachment %d: %w", a.ID, err)
+		}
+	}
+
+	f, err := archive.Create(ticketBundleManifest)
+	if err != nil {
+		return TicketBundle{}, err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(bundle); err != nil {
+		return TicketBundle{}, err
+	}
+	return bundle, archive.Close()
+}
+
+// bundleAttachmentPath returns the path of the attachment in the archive
+func bundleAttachmentPath(a Attachment) string {
+	name := path.Base(strings.ReplaceAll(a.FileName, "\\", "/"))
+	if name == "." || name == "/" || name == ".." {
+		name = "file"
+	}
+	return fmt.Sprintf("attachments/%d/%s", a.ID, name)
+}
+
+// uniquePositiveIDs returns the positive IDs without duplicates in the original order
+func uniquePositiveIDs(ids []int64) []int64 {
+	seen := map[int64]bool{}
+	var unique []int64
+	for _, id := range ids {
+		if id > 0 && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
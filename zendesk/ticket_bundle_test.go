@@ -0,0 +1,87 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTicketBundleMockAPI(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/1.json":
+			w.Write([]byte(`{"ticket": {"id": 1, "subject": "Help", "requester_id": 10, "assignee_id": 11, "organization_id": 20}}`))
+		case "/tickets/1/comments.json":
+			fmt.Fprintf(w, `{"comments": [
+				{"id": 100, "body": "Help me", "author_id": 10, "attachments": [
+					{"id": 500, "file_name": "../screen.png", "content_url": "%s/attachments/token/x/?name=screen.png", "content_type": "image/png"}
+				]},
+				{"id": 101, "body": "Sure", "author_id": 11}
+			], "meta": {"has_more": false}}`, server.URL)
+		case "/tickets/1/audits.json":
+			w.Write([]byte(`{"audits": [{"id": 200, "ticket_id": 1, "author_id": -1}], "next_page": null}`))
+		case "/users/show_many.json":
+			if ids := r.URL.Query().Get("ids"); ids != "10,11" {
+				t.Fatalf("unexpected user IDs %s", ids)
+			}
+			w.Write([]byte(`{"users": [{"id": 10, "email": "requester@example.com", "role": "end-user"}, {"id": 11, "email": "agent@example.com", "role": "agent"}]}`))
+		case "/organizations/20.json":
+			w.Write([]byte(`{"organization": {"id": 20, "name": "Example"}}`))
+		case "/attachments/token/x/":
+			w.Write([]byte("png"))
+		default:
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+	}))
+	return server
+}
+
+func TestExportTicket(t *testing.T) {
+	mockAPI := newTicketBundleMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var buf bytes.Buffer
+	bundle, err := client.ExportTicket(ctx, 1, &buf)
+	if err != nil {
+		t.Fatalf("Failed to export ticket: %s", err)
+	}
+	if len(bundle.Comments) != 2 || len(bundle.Audits) != 1 || len(bundle.Users) != 2 || bundle.Organization == nil {
+		t.Fatalf("unexpected bundle %+v", bundle)
+	}
+	if u, ok := bundle.User(11); !ok || u.Email != "agent@example.com" {
+		t.Fatalf("unexpected user %v", u)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	if files["attachments/500/screen.png"] != "png" {
+		t.Fatalf("unexpected files %v", files)
+	}
+
+	var manifest TicketBundle
+	if err := json.Unmarshal([]byte(files["ticket.json"]), &manifest); err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Version != TicketBundleVersion || manifest.Ticket.Subject != "Help" || manifest.Attachments[0].CommentID != 100 {
+		t.Fatalf("unexpected manifest %+v", manifest)
+	}
+}
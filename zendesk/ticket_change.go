@@ -0,0 +1,70 @@
+package zendesk
+
+import "time"
+
+// TicketArchiveAfter is how long after closed tickets are archived by Zendesk.
+// Archived tickets are still exported and fetched by ID, but no longer
+// returned by search, views and list endpoints.
+const TicketArchiveAfter = 120 * 24 * time.Hour
+
+// TicketChangeKind is the kind of change of a ticket in incremental exports
+type TicketChangeKind string
+
+const (
+	// TicketChangeUpdated is a ticket created or updated
+	TicketChangeUpdated TicketChangeKind = "updated"
+	// TicketChangeDeleted is the tombstone of a deleted ticket. Only ID and
+	// some of the fields are reliable, so it should be deleted from the copy.
+	TicketChangeDeleted TicketChangeKind = "deleted"
+	// TicketChangeArchived is a ticket which has been closed long enough to be archived
+	TicketChangeArchived TicketChangeKind = "archived"
+)
+
+// TicketChange is a ticket exported by incremental export with the kind of change
+type TicketChange struct {
+	Kind   TicketChangeKind
+	Ticket Ticket
+}
+
+// IsDeleted reports whether the ticket is the tombstone of a deleted ticket
+func (t Ticket) IsDeleted() bool {
+	return t.Status == TicketStatusDeleted
+}
+
+// IsArchived reports whether the closed ticket has been archived at now.
+// Tickets don't have the time they were closed, so UpdatedAt is used instead
+// as closed tickets can no longer be updated.
+func (t Ticket) IsArchived(now time.Time) bool {
+	if t.Status != TicketStatusClosed || t.UpdatedAt == nil {
+		return false
+	}
+	return now.Sub(*t.UpdatedAt) >= TicketArchiveAfter
+}
+
+// NewTicketChange classifies the exported ticket at now
+func NewTicketChange(t Ticket, now time.Time) TicketChange {
+	kind := TicketChangeUpdated
+	switch {
+	case t.IsDeleted():
+		kind = TicketChangeDeleted
+	case t.IsArchived(now):
+		kind = TicketChangeArchived
+	}
+	return TicketChange{Kind: kind, Ticket: t}
+}
+
+// TicketChanges adapts fn to the callback of SyncRunner and StreamList
+// exporting tickets, so that deletions are processed explicitly.
+//
+//	runner := zendesk.NewSyncRunner[zendesk.Ticket](client, "tickets", store)
+//	err := runner.Run(ctx, zendesk.TicketChanges(func(c zendesk.TicketChange) error {
+//		if c.Kind == zendesk.TicketChangeDeleted {
+//			return db.Delete(c.Ticket.ID)
+//		}
+//		return db.Upsert(c.Ticket)
+//	}))
+func TicketChanges(fn func(TicketChange) error) func(Ticket) error {
+	return func(t Ticket) error {
+		return fn(NewTicketChange(t, time.Now()))
+	}
+}
@@ -0,0 +1,55 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTicketChanges(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"tickets": [
+				{"id": 1, "status": "open", "updated_at": "2019-06-03T02:34:52Z"},
+				{"id": 2, "status": "deleted", "updated_at": "2019-06-03T02:34:52Z"},
+				{"id": 3, "status": "closed", "updated_at": "2019-06-03T02:34:52Z"}
+			],
+			"after_cursor": "c1",
+			"end_of_stream": true
+		}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	changes := map[int64]TicketChangeKind{}
+	runner := NewSyncRunner[Ticket](client, "tickets", NewMemoryCursorStore())
+	err := runner.Run(ctx, TicketChanges(func(c TicketChange) error {
+		changes[c.Ticket.ID] = c.Kind
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("Failed to run: %s", err)
+	}
+	if changes[1] != TicketChangeUpdated || changes[2] != TicketChangeDeleted || changes[3] != TicketChangeArchived {
+		t.Fatalf("unexpected changes %v", changes)
+	}
+}
+
+func TestTicketIsArchived(t *testing.T) {
+	closedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ticket := Ticket{Status: TicketStatusClosed, UpdatedAt: &closedAt}
+	if ticket.IsArchived(closedAt.Add(TicketArchiveAfter - time.Hour)) {
+		t.Fatal("ticket should not be archived yet")
+	}
+	if !ticket.IsArchived(closedAt.Add(TicketArchiveAfter)) {
+		t.Fatal("ticket should be archived")
+	}
+	ticket.Status = TicketStatusSolved
+	if ticket.IsArchived(closedAt.Add(2 * TicketArchiveAfter)) {
+		t.Fatal("solved ticket should not be archived")
+	}
+	if TicketStatusDeleted.Valid() {
+		t.Fatal("deleted should not be a valid status to set")
+	}
+}
@@ -13,6 +13,7 @@ type TicketCommentAPI interface {
 	ListTicketComments(ctx context.Context, ticketID int64, opts *ListTicketCommentsOptions) (*ListTicketCommentsResult, error)
 	MakeCommentPrivate(ctx context.Context, ticketID int64, ticketCommentID int64) error
 	RedactTicketComment(ctx context.Context, ticketCommentID int64, body RedactTicketCommentRequest) (*TicketComment, error)
+	RedactTicketCommentString(ctx context.Context, ticketID int64, ticketCommentID int64, text string) (*TicketComment, error)
 }
 
 // TicketComment is a struct for ticket comment payload
@@ -160,7 +161,9 @@ func (z *Client) MakeCommentPrivate(ctx context.Context, ticketID int64, ticketC
 	return err
 }
 
-// RedactTicketComment permanently removes words, strings, or attachments from a ticket comment
+// RedactTicketComment permanently removes words, strings, or attachments from a ticket comment.
+// The parts of HTMLBody wrapped in <redact> tags are redacted, and so are the attachments
+// with ExternalAttachmentUrls. Use RedactTicketCommentString for accounts without Agent Workspace.
 //
 // ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_comments/#redact-ticket-comment-in-agent-workspace
 func (z *Client) RedactTicketComment(
@@ -184,3 +187,30 @@ func (z *Client) RedactTicketComment(
 	}
 	return &redacted.Comment, nil
 }
+
+// RedactTicketCommentString permanently removes the text from a ticket comment.
+// This is the legacy redaction which does not support HTML body nor attachments.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_comments/#redact-string-in-comment
+func (z *Client) RedactTicketCommentString(ctx context.Context, ticketID int64, ticketCommentID int64, text string) (*TicketComment, error) {
+	var data struct {
+		Text string `json:"text"`
+	}
+	data.Text = text
+
+	var redacted struct {
+		Comment TicketComment `json:"comment"`
+	}
+
+	path := fmt.Sprintf("/tickets/%d/comments/%d/redact.json", ticketID, ticketCommentID)
+	resp, err := z.put(ctx, path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(resp, &redacted)
+	if err != nil {
+		return nil, err
+	}
+	return &redacted.Comment, nil
+}
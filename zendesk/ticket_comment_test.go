@@ -1,9 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -130,3 +132,29 @@ func TestRedactTicketComment(t *testing.T) {
 		t.Fatalf("incorrect response")
 	}
 }
+
+func TestRedactTicketCommentString(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/100/comments/123/redact.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Text != "847564" {
+			t.Fatalf("unexpected payload %v: %v", payload, err)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "redact_ticket_comment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	out, err := client.RedactTicketCommentString(ctx, 100, 123, "847564")
+	if err != nil {
+		t.Fatalf("Failed to redact ticket comment: %s", err)
+	}
+	if out == nil || out.ID != 123 {
+		t.Fatalf("incorrect response")
+	}
+}
@@ -0,0 +1,174 @@
+package zendesk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TicketMatcher is the strategy of finding the existing ticket which a new
+// ticket duplicates
+type TicketMatcher interface {
+	// Query returns the search query finding the candidates of the ticket
+	Query(ticket Ticket) string
+	// Match reports whether the candidate is a duplicate of the ticket
+	Match(candidate Ticket, ticket Ticket) bool
+	// Prepare returns the ticket to create, e.g. with its fingerprint recorded,
+	// so that it is found as a duplicate later
+	Prepare(ticket Ticket) Ticket
+}
+
+var subjectReplyPrefixRegexp = regexp.MustCompile(`^(?i)((re|fw|fwd|aw|wg)\s*:\s*)+`)
+
+// normalizeSubject removes the prefixes of replies and forwards, and
+// differences of letter case and spaces from the subject
+func normalizeSubject(subject string) string {
+	subject = subjectReplyPrefixRegexp.ReplaceAllString(strings.TrimSpace(subject), "")
+	return strings.ToLower(strings.Join(strings.Fields(subject), " "))
+}
+
+// TicketFingerprint returns the stable fingerprint of the ticket computed from
+// the normalized subject, the requester and the values of the custom fields.
+// The requester is identified by RequesterID, or Requester.Email if not set.
+func TicketFingerprint(ticket Ticket, fieldIDs ...int64) string {
+	requester := strconv.FormatInt(ticket.RequesterID, 10)
+	if ticket.RequesterID == 0 && ticket.Requester != nil {
+		requester = strings.ToLower(ticket.Requester.Email)
+	}
+
+	ids := append([]int64(nil), fieldIDs...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := sha256.New()
+	fmt.Fprintf(h, "subject:%s\nrequester:%s\n", normalizeSubject(ticket.Subject), requester)
+	for _, id := range ids {
+		value, _ := ticket.CustomFieldValue(id)
+		fmt.Fprintf(h, "field:%d:%s\n", id, ruleValueString(value))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// FingerprintTagMatcher records the fingerprint of tickets as a tag, and finds
+// the tickets with the same tag. It is the most reliable strategy as long as
+// the tag is kept on the tickets.
+type FingerprintTagMatcher struct {
+	// TagPrefix is prepended to the fingerprint. The default is "fingerprint_".
+	TagPrefix string
+	// FieldIDs are the custom fields included in the fingerprint
+	FieldIDs []int64
+}
+
+// Tag returns the tag recording the fingerprint of the ticket
+func (m FingerprintTagMatcher) Tag(ticket Ticket) string {
+	prefix := m.TagPrefix
+	if prefix == "" {
+		prefix = "fingerprint_"
+	}
+	return prefix + TicketFingerprint(ticket, m.FieldIDs...)
+}
+
+// Query implements TicketMatcher
+func (m FingerprintTagMatcher) Query(ticket Ticket) string {
+	return "type:ticket tags:" + m.Tag(ticket)
+}
+
+// Match implements TicketMatcher
+func (m FingerprintTagMatcher) Match(candidate Ticket, ticket Ticket) bool {
+	tag := m.Tag(ticket)
+	for _, t := range candidate.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Prepare implements TicketMatcher
+func (m FingerprintTagMatcher) Prepare(ticket Ticket) Ticket {
+	tag := m.Tag(ticket)
+	ticket.Tags = append(append([]string(nil), ticket.Tags...), tag)
+	return ticket
+}
+
+// SubjectRequesterMatcher finds the unsolved tickets of the same requester
+// with the same subject, which works for the tickets created by others too.
+// The ticket must have RequesterID.
+type SubjectRequesterMatcher struct {
+	// Within limits the candidates to the tickets created recently if set
+	Within time.Duration
+}
+
+// Query implements TicketMatcher
+func (m SubjectRequesterMatcher) Query(ticket Ticket) string {
+	q := fmt.Sprintf("type:ticket requester:%d status<solved", ticket.RequesterID)
+	if m.Within > 0 {
+		q += " created>" + time.Now().Add(-m.Within).UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if subject := strings.ReplaceAll(normalizeSubject(ticket.Subject), `"`, ""); subject != "" {
+		q += fmt.Sprintf(` subject:"%s"`, subject)
+	}
+	return q
+}
+
+// Match implements TicketMatcher
+func (m SubjectRequesterMatcher) Match(candidate Ticket, ticket Ticket) bool {
+	return candidate.RequesterID == ticket.RequesterID &&
+		normalizeSubject(candidate.Subject) == normalizeSubject(ticket.Subject)
+}
+
+// Prepare implements TicketMatcher
+func (m SubjectRequesterMatcher) Prepare(ticket Ticket) Ticket {
+	return ticket
+}
+
+// TicketDedupAPI an interface containing the methods deduplicating tickets
+type TicketDedupAPI interface {
+	FindDuplicateTicket(ctx context.Context, ticket Ticket, matcher TicketMatcher) (*Ticket, error)
+	CreateTicketIfNotExists(ctx context.Context, ticket Ticket, matcher TicketMatcher) (Ticket, bool, error)
+}
+
+// FindDuplicateTicket searches the existing ticket which the ticket duplicates.
+// It returns nil if no ticket matches. As the search index is updated with a
+// delay, tickets created in the last minutes may not be found.
+func (z *Client) FindDuplicateTicket(ctx context.Context, ticket Ticket, matcher TicketMatcher) (*Ticket, error) {
+	results, _, err := z.Search(ctx, &SearchOptions{
+		Query:     matcher.Query(ticket),
+		SortBy:    SearchSortByCreatedAt,
+		SortOrder: SortAscending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results.List() {
+		candidate, ok := result.(Ticket)
+		if ok && matcher.Match(candidate, ticket) {
+			return &candidate, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateTicketIfNotExists creates the ticket prepared by the matcher unless a
+// duplicate is found. It returns the existing ticket and false if found.
+func (z *Client) CreateTicketIfNotExists(ctx context.Context, ticket Ticket, matcher TicketMatcher) (Ticket, bool, error) {
+	existing, err := z.FindDuplicateTicket(ctx, ticket, matcher)
+	if err != nil {
+		return Ticket{}, false, err
+	}
+	if existing != nil {
+		return *existing, false, nil
+	}
+
+	created, err := z.CreateTicket(ctx, matcher.Prepare(ticket))
+	if err != nil {
+		return Ticket{}, false, err
+	}
+	return created, true, nil
+}
@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTicketFingerprint(t *testing.T) {
+	a := Ticket{Subject: "Re: FW:  Printer   is broken", RequesterID: 1}
+	b := Ticket{Subject: "printer is broken", RequesterID: 1}
+	if TicketFingerprint(a) != TicketFingerprint(b) {
+		t.Fatal("fingerprints of the same subject should be equal")
+	}
+	if TicketFingerprint(a) == TicketFingerprint(Ticket{Subject: "printer is broken", RequesterID: 2}) {
+		t.Fatal("fingerprints of different requesters should differ")
+	}
+
+	withField := func(v interface{}) Ticket {
+		return Ticket{Subject: "x", RequesterID: 1, CustomFields: []CustomField{{ID: 10, Value: v}}}
+	}
+	if TicketFingerprint(withField("a"), 10) == TicketFingerprint(withField("b"), 10) {
+		t.Fatal("fingerprints of different field values should differ")
+	}
+	if TicketFingerprint(withField("a")) != TicketFingerprint(withField("b")) {
+		t.Fatal("fields not listed should be ignored")
+	}
+}
+
+func TestCreateTicketIfNotExists(t *testing.T) {
+	matcher := FingerprintTagMatcher{}
+	ticket := Ticket{Subject: "nyanyanyanya", RequesterID: 377922500012}
+	tag := matcher.Tag(ticket)
+
+	var created Ticket
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/search.json":
+			if q := r.URL.Query().Get("query"); q != "type:ticket tags:"+tag {
+				t.Fatalf("unexpected query %q", q)
+			}
+			w.Write([]byte(`{"results": [], "count": 0}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/tickets.json":
+			var data struct {
+				Ticket Ticket `json:"ticket"`
+			}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &data)
+			created = data.Ticket
+			created.ID = 5
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]Ticket{"ticket": created})
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, ok, err := client.CreateTicketIfNotExists(ctx, ticket, matcher)
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	if !ok || result.ID != 5 {
+		t.Fatalf("expected the ticket to be created, got %v", result)
+	}
+	if len(created.Tags) != 1 || created.Tags[0] != tag {
+		t.Fatalf("expected the fingerprint tag, got %v", created.Tags)
+	}
+}
+
+func TestFindDuplicateTicket(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "search_ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	matcher := SubjectRequesterMatcher{}
+	dup, err := client.FindDuplicateTicket(ctx, Ticket{Subject: "Re: NyaNyaNyaNya", RequesterID: 377922500012}, matcher)
+	if err != nil {
+		t.Fatalf("Failed to find duplicate ticket: %s", err)
+	}
+	if dup == nil || dup.ID != 4 {
+		t.Fatalf("expected ticket 4, got %v", dup)
+	}
+
+	dup, err = client.FindDuplicateTicket(ctx, Ticket{Subject: "other", RequesterID: 377922500012}, matcher)
+	if err != nil {
+		t.Fatalf("Failed to find duplicate ticket: %s", err)
+	}
+	if dup != nil {
+		t.Fatalf("expected no duplicate, got %v", dup)
+	}
+
+	q := matcher.Query(Ticket{Subject: `Re: say "hi"`, RequesterID: 1})
+	if !strings.HasPrefix(q, "type:ticket requester:1 ") || !strings.HasSuffix(q, `subject:"say hi"`) {
+		t.Fatalf("unexpected query %q", q)
+	}
+}
@@ -0,0 +1,229 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+// IncrementalTicketEvent is an event of incremental ticket event export.
+// ChildEvents holds the changes made by the event, each of which is one of
+// *TicketCommentEvent, *TicketVoiceCommentEvent, *TicketChangeEvent and
+// *UnknownTicketChildEvent.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+type IncrementalTicketEvent struct {
+	ID              int64                  `json:"id"`
+	TicketID        int64                  `json:"ticket_id"`
+	UpdaterID       int64                  `json:"updater_id"`
+	Timestamp       int64                  `json:"timestamp"`
+	CreatedAt       Time                   `json:"created_at"`
+	EventType       string                 `json:"event_type"`
+	Via             *Via                   `json:"via,omitempty"`
+	System          map[string]interface{} `json:"system,omitempty"`
+	MergedTicketIDs []int64                `json:"merged_ticket_ids,omitempty"`
+	ChildEvents     []TicketChildEvent     `json:"-"`
+}
+
+// UnmarshalJSON decodes child_events into typed events
+func (e *IncrementalTicketEvent) UnmarshalJSON(b []byte) error {
+	type event IncrementalTicketEvent
+	var payload struct {
+		event
+		ChildEvents []json.RawMessage `json:"child_events"`
+	}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+
+	*e = IncrementalTicketEvent(payload.event)
+	e.ChildEvents = make([]TicketChildEvent, 0, len(payload.ChildEvents))
+	for _, raw := range payload.ChildEvents {
+		child, err := decodeTicketChildEvent(raw)
+		if err != nil {
+			return err
+		}
+		e.ChildEvents = append(e.ChildEvents, child)
+	}
+	return nil
+}
+
+// TicketChildEvent is a child event of IncrementalTicketEvent
+type TicketChildEvent interface {
+	// ChildEventType returns event_type of the child event, e.g. "Comment"
+	ChildEventType() string
+}
+
+// TicketCommentEvent is a comment added by the event
+type TicketCommentEvent struct {
+	ID          int64        `json:"id"`
+	EventType   string       `json:"event_type"`
+	AuthorID    int64        `json:"author_id"`
+	Body        string       `json:"body"`
+	HTMLBody    string       `json:"html_body"`
+	Public      bool         `json:"public"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+	Via         string       `json:"via,omitempty"`
+}
+
+// ChildEventType implements TicketChildEvent
+func (e *TicketCommentEvent) ChildEventType() string { return e.EventType }
+
+// TicketVoiceCommentEvent is a voice comment added by the event
+type TicketVoiceCommentEvent struct {
+	ID            int64        `json:"id"`
+	EventType     string       `json:"event_type"`
+	AuthorID      int64        `json:"author_id"`
+	Body          string       `json:"body"`
+	HTMLBody      string       `json:"html_body"`
+	Public        bool         `json:"public"`
+	FormattedFrom string       `json:"formatted_from,omitempty"`
+	FormattedTo   string       `json:"formatted_to,omitempty"`
+	Data          VoiceComment `json:"data"`
+	Via           string       `json:"via,omitempty"`
+}
+
+// ChildEventType implements TicketChildEvent
+func (e *TicketVoiceCommentEvent) ChildEventType() string { return e.EventType }
+
+// TicketChangeEvent is a field set by "Create" event or changed by "Change" event.
+// PreviousValue is empty for "Create" events.
+type TicketChangeEvent struct {
+	ID            int64
+	EventType     string
+	Field         string
+	Value         json.RawMessage
+	PreviousValue json.RawMessage
+	Via           string
+	// AddedTags and RemovedTags are the difference of the tags if Field is "tags"
+	AddedTags   []string
+	RemovedTags []string
+}
+
+// ChildEventType implements TicketChildEvent
+func (e *TicketChangeEvent) ChildEventType() string { return e.EventType }
+
+// UnknownTicketChildEvent is a child event of other types, e.g. "Notification"
+type UnknownTicketChildEvent struct {
+	EventType string
+	Raw       json.RawMessage
+}
+
+// ChildEventType implements TicketChildEvent
+func (e *UnknownTicketChildEvent) ChildEventType() string { return e.EventType }
+
+// ticketChangeEventKeys are the members of change events other than the changed field
+var ticketChangeEventKeys = map[string]bool{
+	"id":               true,
+	"event_type":       true,
+	"previous_value":   true,
+	"via":              true,
+	"via_reference_id": true,
+	"added_tags":       true,
+	"removed_tags":     true,
+}
+
+func decodeTicketChildEvent(raw json.RawMessage) (TicketChildEvent, error) {
+	var header struct {
+		EventType string `json:"event_type"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+
+	switch header.EventType {
+	case "Comment":
+		event := &TicketCommentEvent{}
+		err := json.Unmarshal(raw, event)
+		return event, err
+	case "VoiceComment":
+		event := &TicketVoiceCommentEvent{}
+		err := json.Unmarshal(raw, event)
+		return event, err
+	case "Create", "Change":
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &members); err != nil {
+			return nil, err
+		}
+
+		event := &TicketChangeEvent{
+			EventType:     header.EventType,
+			PreviousValue: members["previous_value"],
+		}
+		_ = json.Unmarshal(members["id"], &event.ID)
+		_ = json.Unmarshal(members["via"], &event.Via)
+		if tags, ok := members["tags"]; ok {
+			event.Field = "tags"
+			event.Value = tags
+			_ = json.Unmarshal(members["added_tags"], &event.AddedTags)
+			_ = json.Unmarshal(members["removed_tags"], &event.RemovedTags)
+			return event, nil
+		}
+
+		// a change event has one field, but pick the first name if there are
+		// more so that the result does not depend on the order of the map
+		var fields []string
+		for name := range members {
+			if !ticketChangeEventKeys[name] {
+				fields = append(fields, name)
+			}
+		}
+		sort.Strings(fields)
+		if len(fields) > 0 {
+			event.Field = fields[0]
+			event.Value = members[fields[0]]
+		}
+		return event, nil
+	}
+	return &UnknownTicketChildEvent{EventType: header.EventType, Raw: raw}, nil
+}
+
+// IncrementalTicketEventsOptions is options for GetIncrementalTicketEvents
+type IncrementalTicketEventsOptions struct {
+	StartTime int64 `url:"start_time"`
+	// Include can take "comment_events" to include comments in child events
+	Include string `url:"include,omitempty"`
+}
+
+// IncrementalExportPage is the pagination of time based incremental export.
+// Pass EndTime as StartTime of the next request until EndOfStream is true.
+type IncrementalExportPage struct {
+	NextPage    string `json:"next_page"`
+	EndTime     int64  `json:"end_time"`
+	EndOfStream bool   `json:"end_of_stream"`
+	Count       int64  `json:"count"`
+}
+
+// TicketEventAPI an interface containing all ticket event related methods
+type TicketEventAPI interface {
+	GetIncrementalTicketEvents(ctx context.Context, opts *IncrementalTicketEventsOptions) ([]IncrementalTicketEvent, IncrementalExportPage, error)
+}
+
+// GetIncrementalTicketEvents fetches the ticket events changed since opts.StartTime
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+func (z *Client) GetIncrementalTicketEvents(ctx context.Context, opts *IncrementalTicketEventsOptions) ([]IncrementalTicketEvent, IncrementalExportPage, error) {
+	var result struct {
+		TicketEvents []IncrementalTicketEvent `json:"ticket_events"`
+		IncrementalExportPage
+	}
+
+	if opts == nil {
+		return nil, IncrementalExportPage{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/incremental/ticket_events.json", opts)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+	return result.TicketEvents, result.IncrementalExportPage, nil
+}
@@ -0,0 +1,67 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetIncrementalTicketEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, page, err := client.GetIncrementalTicketEvents(ctx, &IncrementalTicketEventsOptions{
+		StartTime: 1601357400,
+		Include:   "comment_events",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get ticket events: %s", err)
+	}
+	if !page.EndOfStream || page.EndTime != 1601357503 {
+		t.Fatalf("unexpected page %v", page)
+	}
+	if len(events) != 1 || len(events[0].ChildEvents) != 4 {
+		t.Fatalf("unexpected events %v", events)
+	}
+
+	children := events[0].ChildEvents
+	if comment, ok := children[0].(*TicketCommentEvent); !ok || comment.Body != "Thanks for your patience." {
+		t.Fatalf("unexpected comment event %v", children[0])
+	}
+	if change, ok := children[1].(*TicketChangeEvent); !ok || change.Field != "status" ||
+		string(change.Value) != `"solved"` || string(change.PreviousValue) != `"open"` || change.Via != "Web form" {
+		t.Fatalf("unexpected change event %v", children[1])
+	}
+	if voice, ok := children[2].(*TicketVoiceCommentEvent); !ok || voice.Data.CallDuration != 42 {
+		t.Fatalf("unexpected voice comment event %v", children[2])
+	}
+	if unknown, ok := children[3].(*UnknownTicketChildEvent); !ok || unknown.ChildEventType() != "Notification" {
+		t.Fatalf("unexpected event %v", children[3])
+	}
+}
+
+func TestDecodeTicketTagsChangeEvent(t *testing.T) {
+	raw := json.RawMessage(`{"id": 7, "event_type": "Change", "via": "Web form", "via_reference_id": null,
+		"tags": ["vip", "billing"], "added_tags": ["billing"], "removed_tags": ["new"], "previous_value": ["vip", "new"]}`)
+
+	for i := 0; i < 10; i++ {
+		child, err := decodeTicketChildEvent(raw)
+		if err != nil {
+			t.Fatalf("Failed to decode event: %s", err)
+		}
+		change, ok := child.(*TicketChangeEvent)
+		if !ok || change.Field != "tags" || string(change.Value) != `["vip", "billing"]` ||
+			!reflect.DeepEqual(change.AddedTags, []string{"billing"}) || !reflect.DeepEqual(change.RemovedTags, []string{"new"}) {
+			t.Fatalf("unexpected change event %+v", child)
+		}
+	}
+}
+
+func TestGetIncrementalTicketEventsWithoutOptions(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, _, err := client.GetIncrementalTicketEvents(ctx, nil); err == nil {
+		t.Fatal("Client did not return error for nil options")
+	}
+}
@@ -46,9 +46,15 @@ type TicketField struct {
 	AgentDescription    string                         `json:"agent_description,omitempty"`
 }
 
+// TicketFieldListOptions is options for GetTicketFieldsWithOptions
+type TicketFieldListOptions struct {
+	PageOptions
+}
+
 // TicketFieldAPI an interface containing all of the ticket field related zendesk methods
 type TicketFieldAPI interface {
 	GetTicketFields(ctx context.Context) ([]TicketField, Page, error)
+	GetTicketFieldsWithOptions(ctx context.Context, opts *TicketFieldListOptions) ([]TicketField, Page, error)
 	CreateTicketField(ctx context.Context, ticketField TicketField) (TicketField, error)
 	GetTicketField(ctx context.Context, ticketID int64) (TicketField, error)
 	UpdateTicketField(ctx context.Context, ticketID int64, field TicketField) (TicketField, error)
@@ -58,12 +64,22 @@ type TicketFieldAPI interface {
 // GetTicketFields fetches ticket field list
 // ref: https://developer.zendesk.com/rest_api/docs/core/ticket_fields#list-ticket-fields
 func (z *Client) GetTicketFields(ctx context.Context) ([]TicketField, Page, error) {
+	return z.getTicketFields(ctx, PageOptions{})
+}
+
+// getTicketFields fetches the page of ticket fields of opts
+func (z *Client) getTicketFields(ctx context.Context, opts PageOptions) ([]TicketField, Page, error) {
 	var data struct {
 		TicketFields []TicketField `json:"ticket_fields"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/ticket_fields.json")
+	u, err := addOptions("/ticket_fields.json", opts)
+	if err != nil {
+		return []TicketField{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
 	if err != nil {
 		return []TicketField{}, Page{}, err
 	}
@@ -75,6 +91,22 @@ func (z *Client) GetTicketFields(ctx context.Context) ([]TicketField, Page, erro
 	return data.TicketFields, data.Page, nil
 }
 
+// GetTicketFieldsWithOptions fetches the page of ticket fields of opts,
+// while GetTicketFields fetches only the first page
+func (z *Client) GetTicketFieldsWithOptions(ctx context.Context, opts *TicketFieldListOptions) ([]TicketField, Page, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &TicketFieldListOptions{}
+	}
+	return z.getTicketFields(ctx, tmp.PageOptions)
+}
+
+// GetTicketFieldsPage fetches the page of ticket fields at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetTicketFieldsPage(ctx context.Context, token PageToken) (ListPage[TicketField], error) {
+	return OffsetLister(z.getTicketFields)(ctx, token)
+}
+
 // CreateTicketField creates new ticket field
 // ref: https://developer.zendesk.com/rest_api/docs/core/ticket_fields#create-ticket-field
 func (z *Client) CreateTicketField(ctx context.Context, ticketField TicketField) (TicketField, error) {
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -21,6 +22,27 @@ func TestGetTicketFields(t *testing.T) {
 	}
 }
 
+func TestGetTicketFieldsWithOptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "2" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_fields.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketFields, _, err := client.GetTicketFieldsWithOptions(ctx, &TicketFieldListOptions{
+		PageOptions: PageOptions{Page: 2},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields: %s", err)
+	}
+	if len(ticketFields) != 15 {
+		t.Fatalf("expected length of ticket fields is 15, but got %d", len(ticketFields))
+	}
+}
+
 func TestGetTicketField(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket_field.json")
 	client := newTestClient(mockAPI)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // TicketForm is JSON payload struct
@@ -21,6 +22,186 @@ type TicketForm struct {
 	TicketFieldIDs     []int64 `json:"ticket_field_ids,omitempty"`
 	InAllBrands        bool    `json:"in_all_brands,omitempty"`
 	RestrictedBrandIDs []int64 `json:"restricted_brand_ids,omitempty"`
+
+	AgentConditions   []TicketFormCondition `json:"agent_conditions,omitempty"`
+	EndUserConditions []TicketFormCondition `json:"end_user_conditions,omitempty"`
+}
+
+// TicketFormCondition shows ChildFields when the field of ParentFieldID has Value
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#json-format
+type TicketFormCondition struct {
+	ParentFieldID int64 `json:"parent_field_id"`
+	// Value is string for dropdown fields and bool for checkbox fields
+	Value       interface{}                `json:"value"`
+	ChildFields []TicketFormConditionChild `json:"child_fields"`
+}
+
+// TicketFormConditionChild is a field shown by TicketFormCondition
+type TicketFormConditionChild struct {
+	ID         int64 `json:"id"`
+	IsRequired bool  `json:"is_required"`
+	// RequiredOnStatuses is only for agent conditions
+	RequiredOnStatuses *RequiredOnStatuses `json:"required_on_statuses,omitempty"`
+}
+
+// RequiredOnStatuses is the statuses on which the child field is required.
+// Type is one of "NO_STATUSES", "ALL_STATUSES" and "SOME_STATUSES", and
+// Statuses are set only for "SOME_STATUSES".
+type RequiredOnStatuses struct {
+	Type     string         `json:"type"`
+	Statuses []TicketStatus `json:"statuses,omitempty"`
+}
+
+// ValidateConditions checks that the parent and child fields of the conditions
+// are in TicketFieldIDs of the form and that no field is a child of itself.
+// It is skipped if TicketFieldIDs is empty, e.g. on partial update.
+func (f TicketForm) ValidateConditions() error {
+	if len(f.TicketFieldIDs) == 0 {
+		return nil
+	}
+
+	fields := make(map[int64]bool, len(f.TicketFieldIDs))
+	for _, id := range f.TicketFieldIDs {
+		fields[id] = true
+	}
+
+	var missing []string
+	check := func(kind string, conditions []TicketFormCondition) error {
+		for _, c := range conditions {
+			if !fields[c.ParentFieldID] {
+				missing = append(missing, fmt.Sprintf("%s parent field %d", kind, c.ParentFieldID))
+			}
+			for _, child := range c.ChildFields {
+				if child.ID == c.ParentFieldID {
+					return fmt.Errorf("field %d is a child of itself in %s conditions", child.ID, kind)
+				}
+				if !fields[child.ID] {
+					missing = append(missing, fmt.Sprintf("%s child field %d", kind, child.ID))
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := check("agent", f.AgentConditions); err != nil {
+		return err
+	}
+	if err := check("end user", f.EndUserConditions); err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("ticket form does not have %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// TicketFormVisibleField is a field shown on the form.
+// RequiredByCondition is true if a condition showing the field makes it required.
+// Fields required by their own settings are not reflected.
+type TicketFormVisibleField struct {
+	ID                  int64
+	RequiredByCondition bool
+}
+
+// VisibleEndUserFields computes the fields shown to end users on the form
+// with the values by end user conditions, in the order of TicketFieldIDs.
+func (f TicketForm) VisibleEndUserFields(values []CustomField) []TicketFormVisibleField {
+	return f.visibleFields(f.EndUserConditions, values, func(child TicketFormConditionChild) bool {
+		return child.IsRequired
+	})
+}
+
+// VisibleAgentFields computes the fields shown to agents on the form with
+// the values by agent conditions, in the order of TicketFieldIDs.
+// status is used to evaluate RequiredOnStatuses of the conditions.
+func (f TicketForm) VisibleAgentFields(values []CustomField, status TicketStatus) []TicketFormVisibleField {
+	return f.visibleFields(f.AgentConditions, values, func(child TicketFormConditionChild) bool {
+		r := child.RequiredOnStatuses
+		if r == nil {
+			return child.IsRequired
+		}
+		switch r.Type {
+		case "ALL_STATUSES":
+			return true
+		case "SOME_STATUSES":
+			for _, s := range r.Statuses {
+				if s == status {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// visibleFields shows the fields which are not children of any condition, and
+// then the children of conditions whose parent is shown and has the value
+// until no more fields are shown, since children can be parents of other conditions.
+func (f TicketForm) visibleFields(conditions []TicketFormCondition, values []CustomField, required func(TicketFormConditionChild) bool) []TicketFormVisibleField {
+	valueOf := make(map[int64]interface{}, len(values))
+	for _, v := range values {
+		valueOf[v.ID] = v.Value
+	}
+
+	conditional := map[int64]bool{}
+	for _, c := range conditions {
+		for _, child := range c.ChildFields {
+			conditional[child.ID] = true
+		}
+	}
+
+	shown := map[int64]bool{}
+	for _, id := range f.TicketFieldIDs {
+		if !conditional[id] {
+			shown[id] = true
+		}
+	}
+
+	requiredBy := map[int64]bool{}
+	applied := make([]bool, len(conditions))
+	for changed := true; changed; {
+		changed = false
+		for i, c := range conditions {
+			if applied[i] || !shown[c.ParentFieldID] || !conditionValueMatches(c.Value, valueOf[c.ParentFieldID]) {
+				continue
+			}
+			applied[i] = true
+			changed = true
+			for _, child := range c.ChildFields {
+				shown[child.ID] = true
+				if required(child) {
+					requiredBy[child.ID] = true
+				}
+			}
+		}
+	}
+
+	var fields []TicketFormVisibleField
+	for _, id := range f.TicketFieldIDs {
+		if shown[id] {
+			fields = append(fields, TicketFormVisibleField{ID: id, RequiredByCondition: requiredBy[id]})
+		}
+	}
+	return fields
+}
+
+// conditionValueMatches compares the value of condition with the field value.
+// A multi-select value matches if it contains the value of condition, and
+// an empty checkbox is unchecked.
+func conditionValueMatches(expected, actual interface{}) bool {
+	if actual == nil && expected == false {
+		return true
+	}
+	if values, ok := customFieldMulti(actual); ok {
+		for _, v := range values {
+			if v == expected {
+				return true
+			}
+		}
+		return false
+	}
+	return expected == actual
 }
 
 // TicketFormListOptions is options for GetTicketForms
@@ -73,12 +254,30 @@ func (z *Client) GetTicketForms(ctx context.Context, options *TicketFormListOpti
 	return data.TicketForms, data.Page, nil
 }
 
+// GetTicketFormsPage fetches the page of ticket forms at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetTicketFormsPage(ctx context.Context, token PageToken, opts *TicketFormListOptions) (ListPage[TicketForm], error) {
+	tmp := TicketFormListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]TicketForm, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetTicketForms(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateTicketForm creates new ticket form
 // ref: https://developer.zendesk.com/rest_api/docs/support/ticket_forms#create-ticket-forms
 func (z *Client) CreateTicketForm(ctx context.Context, ticketForm TicketForm) (TicketForm, error) {
 	var data, result struct {
 		TicketForm TicketForm `json:"ticket_form"`
 	}
+
+	if err := ticketForm.ValidateConditions(); err != nil {
+		return TicketForm{}, err
+	}
 	data.TicketForm = ticketForm
 
 	body, err := z.post(ctx, "/ticket_forms.json", data)
@@ -119,6 +318,9 @@ func (z *Client) UpdateTicketForm(ctx context.Context, id int64, form TicketForm
 		TicketForm TicketForm `json:"ticket_form"`
 	}
 
+	if err := form.ValidateConditions(); err != nil {
+		return TicketForm{}, err
+	}
 	data.TicketForm = form
 	body, err := z.put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), data)
 	if err != nil {
@@ -1,8 +1,11 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -115,3 +118,101 @@ func TestUpdateTicketFormFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestTicketFormConditionsJSON(t *testing.T) {
+	var form TicketForm
+	err := json.Unmarshal([]byte(`{
+		"id": 47,
+		"ticket_field_ids": [1, 2, 3],
+		"agent_conditions": [{
+			"parent_field_id": 1,
+			"value": "matching_value",
+			"child_fields": [{"id": 2, "is_required": true, "required_on_statuses": {"type": "SOME_STATUSES", "statuses": ["new", "open"]}}]
+		}],
+		"end_user_conditions": [{
+			"parent_field_id": 1,
+			"value": true,
+			"child_fields": [{"id": 3, "is_required": false}]
+		}]
+	}`), &form)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ticket form: %s", err)
+	}
+
+	child := form.AgentConditions[0].ChildFields[0]
+	if child.ID != 2 || child.RequiredOnStatuses == nil || child.RequiredOnStatuses.Statuses[1] != TicketStatusOpen {
+		t.Fatalf("unexpected agent condition child %v", child)
+	}
+	if form.EndUserConditions[0].Value != true {
+		t.Fatalf("unexpected end user condition %v", form.EndUserConditions[0])
+	}
+	if err := form.ValidateConditions(); err != nil {
+		t.Fatalf("unexpected validation error %s", err)
+	}
+}
+
+func TestCreateTicketFormInvalidConditions(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "ticket_form.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicketForm(ctx, TicketForm{
+		Name:           "Form",
+		TicketFieldIDs: []int64{1, 2},
+		AgentConditions: []TicketFormCondition{
+			{ParentFieldID: 1, Value: "x", ChildFields: []TicketFormConditionChild{{ID: 99}}},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "agent child field 99") {
+		t.Fatalf("expected error of missing child field, but got %v", err)
+	}
+
+	_, err = client.CreateTicketForm(ctx, TicketForm{
+		TicketFieldIDs: []int64{1},
+		EndUserConditions: []TicketFormCondition{
+			{ParentFieldID: 1, Value: true, ChildFields: []TicketFormConditionChild{{ID: 1}}},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error of field being a child of itself")
+	}
+}
+
+func TestTicketFormVisibleFields(t *testing.T) {
+	form := TicketForm{
+		TicketFieldIDs: []int64{1, 2, 3, 4, 5},
+		EndUserConditions: []TicketFormCondition{
+			{ParentFieldID: 1, Value: "hardware", ChildFields: []TicketFormConditionChild{{ID: 2, IsRequired: true}}},
+			{ParentFieldID: 2, Value: true, ChildFields: []TicketFormConditionChild{{ID: 3}}},
+			{ParentFieldID: 1, Value: "software", ChildFields: []TicketFormConditionChild{{ID: 4}}},
+		},
+		AgentConditions: []TicketFormCondition{
+			{ParentFieldID: 1, Value: "hardware", ChildFields: []TicketFormConditionChild{
+				{ID: 2, RequiredOnStatuses: &RequiredOnStatuses{Type: "SOME_STATUSES", Statuses: []TicketStatus{TicketStatusSolved}}},
+			}},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		values   []CustomField
+		expected []TicketFormVisibleField
+	}{
+		{"no values", nil, []TicketFormVisibleField{{ID: 1}, {ID: 5}}},
+		{"nested", []CustomField{{ID: 1, Value: "hardware"}, {ID: 2, Value: true}}, []TicketFormVisibleField{{ID: 1}, {ID: 2, RequiredByCondition: true}, {ID: 3}, {ID: 5}}},
+		{"hidden parent", []CustomField{{ID: 1, Value: "software"}, {ID: 2, Value: true}}, []TicketFormVisibleField{{ID: 1}, {ID: 4}, {ID: 5}}},
+	}
+	for _, c := range cases {
+		fields := form.VisibleEndUserFields(c.values)
+		if !reflect.DeepEqual(fields, c.expected) {
+			t.Errorf("%s: expected %v, but got %v", c.name, c.expected, fields)
+		}
+	}
+
+	values := []CustomField{{ID: 1, Value: "hardware"}}
+	open := form.VisibleAgentFields(values, TicketStatusOpen)
+	solved := form.VisibleAgentFields(values, TicketStatusSolved)
+	if len(open) != 5 || open[1].RequiredByCondition || !solved[1].RequiredByCondition {
+		t.Fatalf("unexpected agent fields %v %v", open, solved)
+	}
+}
@@ -0,0 +1,270 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// errDeletedTicketImport is returned by ImportTicket when the bundle is of a deleted ticket
+var errDeletedTicketImport = errors.New("deleted ticket cannot be imported")
+
+// ErrImportAgentNotFound is returned by ImportTicket when the assignee or an agent
+// author of the bundle is not an agent in this account. Agents are never created
+// by ImportTicket, so add them to the account before importing.
+var ErrImportAgentNotFound = errors.New("zendesk: agent is not found in this account")
+
+// TicketArchive is a zip archive written by ExportTicket
+type TicketArchive struct {
+	Bundle TicketBundle
+	files  map[string]*zip.File
+}
+
+// ReadTicketArchive reads the bundle of the archive written by ExportTicket.
+// The content of attachments is read on demand, so r must be available
+// until the archive is no longer used.
+func ReadTicketArchive(r io.ReaderAt, size int64) (*TicketArchive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &TicketArchive{files: map[string]*zip.File{}}
+	for _, f := range zr.File {
+		archive.files[f.Name] = f
+	}
+
+	rc, err := archive.Open(ticketBundleManifest)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	if err := json.NewDecoder(rc).Decode(&archive.Bundle); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", ticketBundleManifest, err)
+	}
+	if archive.Bundle.Version != TicketBundleVersion {
+		return nil, fmt.Errorf("unsupported ticket bundle version %d", archive.Bundle.Version)
+	}
+	return archive, nil
+}
+
+// Open opens the file of the name in the archive, e.g. the Path of BundleAttachment
+func (a *TicketArchive) Open(name string) (io.ReadCloser, error) {
+	f, ok := a.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not found in the ticket archive", name)
+	}
+	return f.Open()
+}
+
+// TicketImportOptions is options for ImportTicket
+type TicketImportOptions struct {
+	// ArchiveImmediately archives the ticket right after the import if it is closed
+	ArchiveImmediately bool `url:"archive_immediately,omitempty"`
+	// CreateMissingUsers creates the end users who are not found by email.
+	// Otherwise ImportTicket fails if any user is not found. Agents are
+	// never created and must exist in the account.
+	CreateMissingUsers bool `url:"-"`
+	// FieldIDs maps the IDs of custom ticket fields in the source account to
+	// the ones in the target account. The fields not mapped are not imported.
+	FieldIDs map[int64]int64 `url:"-"`
+}
+
+// TicketImportAPI an interface containing the methods importing tickets
+type TicketImportAPI interface {
+	ImportTicket(ctx context.Context, archive *TicketArchive, opts *TicketImportOptions) (Ticket, error)
+}
+
+// ImportTicket reconstructs the ticket of the archive written by ExportTicket
+// in this account with the Ticket Import API, keeping the timestamps of the
+// ticket and comments. The users are mapped by email, including the
+// collaborators, followers and CCs, and the attachments are uploaded again
+// except empty ones, which cannot be uploaded. The assignee and agents must be
+// agents in this account, otherwise ErrImportAgentNotFound is returned. The
+// group, brand, form and organization are not imported because their IDs are
+// different between accounts, so the ticket belongs to the default
+// organization of the requester.
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_import/#ticket-import
+func (z *Client) ImportTicket(ctx context.Context, archive *TicketArchive, opts *TicketImportOptions) (Ticket, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &TicketImportOptions{}
+	}
+
+	bundle := archive.Bundle
+	if bundle.Ticket.IsDeleted() {
+		return Ticket{}, errDeletedTicketImport
+	}
+
+	userIDs, err := z.importBundleUsers(ctx, bundle, tmp.CreateMissingUsers)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	uploads := map[int64][]string{}
+	for _, a := range bundle.Attachments {
+		token, err := z.importBundleAttachment(ctx, archive, a)
+		if errors.Is(err, errEmptyUpload) {
+			continue
+		}
+		if err != nil {
+			return Ticket{}, fmt.Errorf("failed to upload attachment %d: %w", a.ID, err)
+		}
+		uploads[a.CommentID] = append(uploads[a.CommentID], token)
+	}
+
+	source := bundle.Ticket
+	ticket := Ticket{
+		ExternalID:  source.ExternalID,
+		Type:        source.Type,
+		Subject:     source.Subject,
+		Priority:    source.Priority,
+		Status:      source.Status,
+		RequesterID: userIDs[source.RequesterID],
+		SubmitterID: userIDs[source.SubmitterID],
+		AssigneeID:  userIDs[source.AssigneeID],
+		DueAt:       source.DueAt,
+		Tags:        source.Tags,
+		CreatedAt:   source.CreatedAt,
+		UpdatedAt:   source.UpdatedAt,
+	}
+	for _, id := range source.CollaboratorIDs {
+		ticket.CollaboratorIDs = append(ticket.CollaboratorIDs, userIDs[id])
+	}
+	for _, id := range source.FollowerIDs {
+		ticket.FollowerIDs = append(ticket.FollowerIDs, userIDs[id])
+	}
+	for _, id := range source.EmailCCIDs {
+		ticket.EmailCCIDs = append(ticket.EmailCCIDs, userIDs[id])
+	}
+	for _, f := range source.CustomFields {
+		if id, ok := tmp.FieldIDs[f.ID]; ok {
+			ticket.CustomFields = append(ticket.CustomFields, CustomField{ID: id, Value: f.Value})
+		}
+	}
+
+	var comments []TicketComment
+	for _, c := range bundle.Comments {
+		comment := TicketComment{
+			Public:    c.Public,
+			AuthorID:  userIDs[c.AuthorID],
+			CreatedAt: c.CreatedAt,
+			Uploads:   uploads[c.ID],
+		}
+		if c.HTMLBody != "" {
+			comment.HTMLBody = c.HTMLBody
+		} else {
+			comment.Body = c.Body
+		}
+		comments = append(comments, comment)
+	}
+
+	// importTicket has no MarshalJSON of Ticket, which would drop the comments
+	type importTicket Ticket
+	var data struct {
+		Ticket struct {
+			importTicket
+			Comments []TicketComment `json:"comments"`
+		} `json:"ticket"`
+	}
+	data.Ticket.importTicket = importTicket(ticket)
+	data.Ticket.Comments = comments
+
+	u, err := addOptions("/imports/tickets.json", tmp)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	body, err := z.post(ctx, u, data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// importBundleUsers maps the IDs of the users referred by the ticket and
+// comments of the bundle to the IDs of the users of the same email in this account.
+// The assignee and the agents of the source account must be agents in this account.
+func (z *Client) importBundleUsers(ctx context.Context, bundle TicketBundle, create bool) (map[int64]int64, error) {
+	ids := []int64{bundle.Ticket.RequesterID, bundle.Ticket.SubmitterID, bundle.Ticket.AssigneeID}
+	ids = append(ids, bundle.Ticket.CollaboratorIDs...)
+	ids = append(ids, bundle.Ticket.FollowerIDs...)
+	ids = append(ids, bundle.Ticket.EmailCCIDs...)
+	for _, c := range bundle.Comments {
+		ids = append(ids, c.AuthorID)
+	}
+
+	userIDs := map[int64]int64{}
+	for _, id := range uniquePositiveIDs(ids) {
+		source, ok := bundle.User(id)
+		if !ok || source.Email == "" {
+			return nil, fmt.Errorf("email of user %d is not found in the ticket bundle", id)
+		}
+
+		user, found, err := z.findUserByEmail(ctx, source.Email)
+		if err != nil {
+			return nil, err
+		}
+		if id == bundle.Ticket.AssigneeID || isAgentRole(source.Role) {
+			if !found || !isAgentRole(user.Role) {
+				return nil, fmt.Errorf("%w: %s", ErrImportAgentNotFound, source.Email)
+			}
+			userIDs[id] = user.ID
+			continue
+		}
+		if !found {
+			if !create {
+				return nil, fmt.Errorf("user %s is not found", source.Email)
+			}
+			user, err = z.CreateUser(ctx, User{Name: source.Name, Email: source.Email, Role: UserRoleText(UserRoleEndUser)})
+			if err != nil {
+				return nil, err
+			}
+		}
+		userIDs[id] = user.ID
+	}
+	return userIDs, nil
+}
+
+// isAgentRole reports whether the role is agent or admin
+func isAgentRole(role string) bool {
+	return role == UserRoleText(UserRoleAgent) || role == UserRoleText(UserRoleAdmin)
+}
+
+// findUserByEmail returns the user whose email is exactly the email
+func (z *Client) findUserByEmail(ctx context.Context, email string) (User, bool, error) {
+	users, _, err := z.SearchUsers(ctx, &SearchUsersOptions{Query: "email:" + email})
+	if err != nil {
+		return User{}, false, err
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Email, email) {
+			return u, true, nil
+		}
+	}
+	return User{}, false, nil
+}
+
+// importBundleAttachment uploads the content of the attachment in the archive
+func (z *Client) importBundleAttachment(ctx context.Context, archive *TicketArchive, a BundleAttachment) (string, error) {
+	rc, err := archive.Open(a.Path)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	return z.uploadFile(ctx, a.FileName, rc)
+}
@@ -0,0 +1,197 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func exportTestTicketArchive(t *testing.T) *TicketArchive {
+	mockAPI := newTicketBundleMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var buf bytes.Buffer
+	if _, err := client.ExportTicket(ctx, 1, &buf); err != nil {
+		t.Fatalf("Failed to export ticket: %s", err)
+	}
+	archive, err := ReadTicketArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Failed to read ticket archive: %s", err)
+	}
+	return archive
+}
+
+func TestReadTicketArchive(t *testing.T) {
+	archive := exportTestTicketArchive(t)
+	if archive.Bundle.Ticket.Subject != "Help" || len(archive.Bundle.Attachments) != 1 {
+		t.Fatalf("unexpected bundle %+v", archive.Bundle)
+	}
+
+	rc, err := archive.Open(archive.Bundle.Attachments[0].Path)
+	if err != nil {
+		t.Fatalf("Failed to open attachment: %s", err)
+	}
+	defer rc.Close()
+	if b, _ := io.ReadAll(rc); string(b) != "png" {
+		t.Fatalf("unexpected attachment content %q", b)
+	}
+
+	if _, err := archive.Open("missing"); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
+
+// addEmptyTestAttachment adds an empty attachment to the first comment of the archive
+func addEmptyTestAttachment(t *testing.T, archive *TicketArchive) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if _, err := zw.Create("attachments/empty.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive.files[zr.File[0].Name] = zr.File[0]
+	archive.Bundle.Attachments = append(archive.Bundle.Attachments, BundleAttachment{
+		Attachment: Attachment{ID: 501, FileName: "empty.txt"},
+		CommentID:  archive.Bundle.Comments[0].ID,
+		Path:       zr.File[0].Name,
+	})
+}
+
+func TestImportTicket(t *testing.T) {
+	archive := exportTestTicketArchive(t)
+	addEmptyTestAttachment(t, archive)
+	archive.Bundle.Ticket.FollowerIDs = []int64{11}
+	archive.Bundle.Ticket.EmailCCIDs = []int64{10}
+
+	var imported struct {
+		Ticket struct {
+			RequesterID int64   `json:"requester_id"`
+			AssigneeID  int64   `json:"assignee_id"`
+			FollowerIDs []int64 `json:"follower_ids"`
+			EmailCCIDs  []int64 `json:"email_cc_ids"`
+			Comments    []struct {
+				AuthorID int64    `json:"author_id"`
+				Body     string   `json:"body"`
+				Uploads  []string `json:"uploads"`
+			} `json:"comments"`
+		} `json:"ticket"`
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/search.json":
+			if r.URL.Query().Get("query") == "email:agent@example.com" {
+				w.Write([]byte(`{"users": [{"id": 911, "email": "Agent@example.com", "role": "agent"}]}`))
+				return
+			}
+			w.Write([]byte(`{"users": []}`))
+		case "/users.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"user": {"id": 910, "email": "requester@example.com"}}`))
+		case "/uploads.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "upload.json")))
+		case "/imports/tickets.json":
+			if r.URL.Query().Get("archive_immediately") != "true" {
+				t.Fatalf("unexpected query %s", r.URL.RawQuery)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&imported); err != nil {
+				t.Fatal(err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"ticket": {"id": 2, "subject": "Help"}}`))
+		default:
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.ImportTicket(ctx, archive, &TicketImportOptions{
+		ArchiveImmediately: true,
+		CreateMissingUsers: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to import ticket: %s", err)
+	}
+	if ticket.ID != 2 {
+		t.Fatalf("unexpected ticket %v", ticket)
+	}
+
+	got := imported.Ticket
+	if got.RequesterID != 910 || got.AssigneeID != 911 || len(got.Comments) != 2 {
+		t.Fatalf("unexpected import %+v", got)
+	}
+	if len(got.FollowerIDs) != 1 || got.FollowerIDs[0] != 911 || len(got.EmailCCIDs) != 1 || got.EmailCCIDs[0] != 910 {
+		t.Fatalf("unexpected followers and CCs %+v", got)
+	}
+	if c := got.Comments[0]; c.AuthorID != 910 || c.Body != "Help me" || len(c.Uploads) != 1 || c.Uploads[0] != "6bk3gql82em5nmf" {
+		t.Fatalf("unexpected comment %+v", c)
+	}
+	if c := got.Comments[1]; c.AuthorID != 911 || len(c.Uploads) != 0 {
+		t.Fatalf("unexpected comment %+v", c)
+	}
+}
+
+func TestImportTicketUserNotFound(t *testing.T) {
+	archive := exportTestTicketArchive(t)
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/search.json" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"users": []}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.ImportTicket(ctx, archive, nil); err == nil {
+		t.Fatal("expected error for user not found")
+	}
+}
+
+func TestImportTicketAgentNotFound(t *testing.T) {
+	archive := exportTestTicketArchive(t)
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/search.json":
+			if r.URL.Query().Get("query") == "email:agent@example.com" {
+				w.Write([]byte(`{"users": [{"id": 911, "email": "agent@example.com", "role": "end-user"}]}`))
+				return
+			}
+			w.Write([]byte(`{"users": []}`))
+		case "/users.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"user": {"id": 910, "email": "requester@example.com"}}`))
+		default:
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.ImportTicket(ctx, archive, &TicketImportOptions{CreateMissingUsers: true})
+	if !errors.Is(err, ErrImportAgentNotFound) {
+		t.Fatalf("expected agent not found error, but got %v", err)
+	}
+
+	archive.Bundle.Users[1].Email = "missing@example.com"
+	_, err = client.ImportTicket(ctx, archive, &TicketImportOptions{CreateMissingUsers: true})
+	if !errors.Is(err, ErrImportAgentNotFound) {
+		t.Fatalf("expected agent not found error for missing assignee, but got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Ticket metric event types
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/
+const (
+	TicketMetricEventActivate      = "activate"
+	TicketMetricEventPause         = "pause"
+	TicketMetricEventFulfill       = "fulfill"
+	TicketMetricEventApplySLA      = "apply_sla"
+	TicketMetricEventApplyGroupSLA = "apply_group_sla"
+	TicketMetricEventBreach        = "breach"
+	TicketMetricEventUpdateStatus  = "update_status"
+	TicketMetricEventMeasure       = "measure"
+)
+
+// TicketMetricEvent is an event of the metrics of a ticket, e.g. the reply
+// time being activated, paused, fulfilled or breached.
+// SLA is set for apply_sla events, and Time of breach events is when the
+// metric breaches, which can be in the future.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/
+type TicketMetricEvent struct {
+	ID         int64                   `json:"id"`
+	TicketID   int64                   `json:"ticket_id"`
+	Metric     string                  `json:"metric"`
+	InstanceID int64                   `json:"instance_id"`
+	Type       string                  `json:"type"`
+	Time       Time                    `json:"time"`
+	SLA        *TicketMetricEventSLA   `json:"sla,omitempty"`
+	Status     *TicketMetricEventValue `json:"status,omitempty"`
+	Deleted    bool                    `json:"deleted,omitempty"`
+}
+
+// TicketMetricEventSLA is the SLA target applied to the metric
+type TicketMetricEventSLA struct {
+	// Target is in minutes
+	Target        int  `json:"target"`
+	BusinessHours bool `json:"business_hours"`
+	Policy        struct {
+		ID          int64  `json:"id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"policy"`
+}
+
+// TicketMetricEventValue is the elapsed minutes of the metric
+type TicketMetricEventValue struct {
+	Calendar int `json:"calendar"`
+	Business int `json:"business"`
+}
+
+// IncrementalTicketMetricEventsOptions is options for GetIncrementalTicketMetricEvents
+type IncrementalTicketMetricEventsOptions struct {
+	StartTime int64 `url:"start_time"`
+}
+
+// TicketMetricEventAPI an interface containing all ticket metric event related methods
+type TicketMetricEventAPI interface {
+	GetIncrementalTicketMetricEvents(ctx context.Context, opts *IncrementalTicketMetricEventsOptions) ([]TicketMetricEvent, IncrementalExportPage, error)
+}
+
+// GetIncrementalTicketMetricEvents fetches the ticket metric events changed since opts.StartTime
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/#list-ticket-metric-events
+func (z *Client) GetIncrementalTicketMetricEvents(ctx context.Context, opts *IncrementalTicketMetricEventsOptions) ([]TicketMetricEvent, IncrementalExportPage, error) {
+	var result struct {
+		TicketMetricEvents []TicketMetricEvent `json:"ticket_metric_events"`
+		IncrementalExportPage
+	}
+
+	if opts == nil {
+		return nil, IncrementalExportPage{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/incremental/ticket_metric_events.json", opts)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, IncrementalExportPage{}, err
+	}
+	return result.TicketMetricEvents, result.IncrementalExportPage, nil
+}
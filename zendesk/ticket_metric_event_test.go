@@ -0,0 +1,33 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetIncrementalTicketMetricEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_metric_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, page, err := client.GetIncrementalTicketMetricEvents(ctx, &IncrementalTicketMetricEventsOptions{StartTime: 1601355600})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric events: %s", err)
+	}
+	if page.EndTime != 1601356200 || len(events) != 4 {
+		t.Fatalf("unexpected events %v %v", events, page)
+	}
+	if sla := events[1].SLA; sla == nil || sla.Target != 60 || sla.Policy.Title != "Urgent tickets" {
+		t.Fatalf("unexpected sla %v", events[1].SLA)
+	}
+	if status := events[3].Status; status == nil || status.Calendar != 10 {
+		t.Fatalf("unexpected status %v", events[3].Status)
+	}
+}
+
+func TestGetIncrementalTicketMetricEventsWithoutOptions(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, _, err := client.GetIncrementalTicketMetricEvents(ctx, nil); err == nil {
+		t.Fatal("Client did not return error for nil options")
+	}
+}
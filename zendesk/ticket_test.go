@@ -3,12 +3,15 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 )
 
 func TestGetTickets(t *testing.T) {
@@ -34,6 +37,61 @@ func TestGetTickets(t *testing.T) {
 	}
 }
 
+func TestGetTicketsCursor(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("page[size]") != "100" || q.Get("page[after]") != "abc" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"tickets": [{"id": 1}, {"id": 2}], "meta": {"has_more": true, "after_cursor": "def"}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, meta, err := client.GetTicketsCursor(ctx, &CursorPagination{PageAfter: "abc"})
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 2 || !meta.HasMore || meta.AfterCursor != "def" {
+		t.Fatalf("unexpected tickets %v, meta %v", tickets, meta)
+	}
+}
+
+func TestGetTicketsWithExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("external_id") != "order-1" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetTickets(ctx, &TicketListOptions{ExternalID: "order-1"})
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+}
+
+func TestGetTicketsByExternalIDs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/show_many.json" || r.URL.Query().Get("external_ids") != "order-1,order-2" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.GetTicketsByExternalIDs(ctx, []string{"order-1", "order-2"})
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected 2 tickets, but got %d", len(tickets))
+	}
+}
+
 func TestGetTicket(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
 	client := newTestClient(mockAPI)
@@ -86,18 +144,18 @@ func TestGetTicketCanceledContext(t *testing.T) {
 }
 
 // Test the CustomField unmarshalling fails on an invalid value.
-// In this case a float64 as CustomField.Value should cause an error.
+// In this case an array of numbers as CustomField.Value should cause an error.
 func TestGetTicketWithInvalidCustomField(t *testing.T) {
-	// Test with a number value.
-	invalidCustomFieldJson := `{ "id": 360005657120, "value": 123.456 }`
+	// Test with a number value, which is valid.
+	customFieldJson := `{ "id": 360005657120, "value": 123.456 }`
 	var customField CustomField
-	err := json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
-	if err == nil {
-		t.Fatalf("Expected an error when parsing a custom field of type number.")
+	err := json.Unmarshal([]byte(customFieldJson), &customField)
+	if err != nil || customField.Value != 123.456 {
+		t.Fatalf("Failed to parse a custom field of type number: %v %v", customField.Value, err)
 	}
 
 	// Test with an array of numbers.
-	invalidCustomFieldJson = `{ "id": 360005657120, "value": [123, 456] }`
+	invalidCustomFieldJson := `{ "id": 360005657120, "value": [123, 456] }`
 	err = json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
 	if err == nil {
 		t.Fatalf("Expected an error when parsing a custom field of type [number, ...].")
@@ -147,6 +205,14 @@ func TestGetTicketWithCustomFields(t *testing.T) {
 			if !cf.Value.(bool) {
 				t.Fatal("Expected to find true in custom fields")
 			}
+		case int64:
+			if cf.Value.(int64) != 9007199254740993 {
+				t.Fatalf("Returned custom field value is not the expected value %d", cf.Value)
+			}
+		case float64:
+			if cf.Value.(float64) != 12.5 {
+				t.Fatalf("Returned custom field value is not the expected value %v", cf.Value)
+			}
 		default:
 			t.Fatalf("Invalid value type in custom field:  %v.", cf)
 		}
@@ -248,3 +314,138 @@ func TestTicketMarshalling(t *testing.T) {
 	}
 
 }
+
+func TestUpdateTicketSafeUpdate(t *testing.T) {
+	updatedAt := time.Date(2019, 6, 4, 5, 48, 9, 0, time.UTC)
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket Ticket `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if !data.Ticket.SafeUpdate {
+			t.Fatal("safe_update was not sent")
+		}
+		if data.Ticket.UpdatedStamp == nil || !data.Ticket.UpdatedStamp.Equal(updatedAt) {
+			t.Fatalf("updated_stamp should be %s, but got %v", updatedAt, data.Ticket.UpdatedStamp)
+		}
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error":"UpdateConflict"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{
+		Status:     "solved",
+		SafeUpdate: true,
+		UpdatedAt:  &updatedAt,
+	})
+
+	var safeErr *SafeUpdateError
+	if !errors.As(err, &safeErr) {
+		t.Fatalf("expected SafeUpdateError, but got %v", err)
+	}
+	if safeErr.TicketID != 2 {
+		t.Fatalf("expected ticket id 2, but got %d", safeErr.TicketID)
+	}
+	if !errors.Is(err, ErrConflict) {
+		t.Fatal("SafeUpdateError should match ErrConflict")
+	}
+}
+
+func TestUpdateTicketSafeUpdateWithoutStamp(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPut, "ticket.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{SafeUpdate: true})
+	if err == nil {
+		t.Fatal("Client did not return error when updated stamp is missing")
+	}
+}
+
+func TestUpdateTicketNullFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket map[string]json.RawMessage `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if v, ok := data.Ticket["assignee_id"]; !ok || string(v) != "null" {
+			t.Fatalf("assignee_id should be null, but got %s", v)
+		}
+		if _, ok := data.Ticket["group_id"]; ok {
+			t.Fatal("group_id should be omitted")
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 2, Ticket{NullFields: []string{"assignee_id"}})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+}
+
+func TestCreateVoiceTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/voice/tickets.json" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+
+		var data struct {
+			Ticket Ticket `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if data.Ticket.ViaID != ViaAPIPhoneCallInbound || data.Ticket.VoiceComment == nil {
+			t.Fatalf("voice comment was not sent: %v", data.Ticket)
+		}
+		if data.Ticket.VoiceComment.RecordingURL != "https://example.com/call.mp3" {
+			t.Fatalf("unexpected recording url %s", data.Ticket.VoiceComment.RecordingURL)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	startedAt := NewTime(time.Date(2019, 6, 3, 2, 34, 52, 0, time.UTC))
+	_, err := client.CreateVoiceTicket(ctx, Ticket{
+		ViaID: ViaAPIPhoneCallInbound,
+		VoiceComment: &VoiceComment{
+			From:         "+16617480240",
+			To:           "+16617480241",
+			RecordingURL: "https://example.com/call.mp3",
+			StartedAt:    &startedAt,
+			CallDuration: 40,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create voice ticket: %s", err)
+	}
+}
+
+func TestViaSourceParty(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.GetTicket(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	from := ticket.Via.Source.FromParty()
+	if from.Address != "nukosuke@lavabit.com" || from.Name != "Yosuke Tamura" {
+		t.Fatalf("unexpected from %v", from)
+	}
+	if to := ticket.Via.Source.ToParty(); to.Address != "support@d3v-terraform-provider.zendesk.com" {
+		t.Fatalf("unexpected to %v", to)
+	}
+}
@@ -0,0 +1,134 @@
+package zendesk
+
+import "fmt"
+
+// TicketStatus is the status of Ticket.
+// When custom ticket statuses are activated, Status holds the status category
+// of the custom status identified by CustomStatusID, so the predicates below
+// work for custom statuses as well.
+//
+// https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#json-format
+type TicketStatus string
+
+const (
+	// TicketStatusNew : new
+	TicketStatusNew TicketStatus = "new"
+	// TicketStatusOpen : open
+	TicketStatusOpen TicketStatus = "open"
+	// TicketStatusPending : pending
+	TicketStatusPending TicketStatus = "pending"
+	// TicketStatusHold : on-hold
+	TicketStatusHold TicketStatus = "hold"
+	// TicketStatusSolved : solved
+	TicketStatusSolved TicketStatus = "solved"
+	// TicketStatusClosed : closed
+	TicketStatusClosed TicketStatus = "closed"
+	// TicketStatusDeleted is the status of deleted tickets, which appear only in incremental exports
+	TicketStatusDeleted TicketStatus = "deleted"
+)
+
+// Valid reports whether s is one of the statuses which tickets can be set to.
+// TicketStatusDeleted is not valid because tickets are deleted by DeleteTicket.
+func (s TicketStatus) Valid() bool {
+	switch s {
+	case TicketStatusNew, TicketStatusOpen, TicketStatusPending, TicketStatusHold, TicketStatusSolved, TicketStatusClosed:
+		return true
+	}
+	return false
+}
+
+// IsOpen reports whether s is an unsolved status, i.e. new, open, pending or hold
+func (s TicketStatus) IsOpen() bool {
+	switch s {
+	case TicketStatusNew, TicketStatusOpen, TicketStatusPending, TicketStatusHold:
+		return true
+	}
+	return false
+}
+
+// IsSolved reports whether s is solved or closed
+func (s TicketStatus) IsSolved() bool {
+	return s == TicketStatusSolved || s == TicketStatusClosed
+}
+
+// TicketPriority is the priority of Ticket
+type TicketPriority string
+
+const (
+	// TicketPriorityLow : low
+	TicketPriorityLow TicketPriority = "low"
+	// TicketPriorityNormal : normal
+	TicketPriorityNormal TicketPriority = "normal"
+	// TicketPriorityHigh : high
+	TicketPriorityHigh TicketPriority = "high"
+	// TicketPriorityUrgent : urgent
+	TicketPriorityUrgent TicketPriority = "urgent"
+)
+
+// Valid reports whether p is one of the known priorities
+func (p TicketPriority) Valid() bool {
+	switch p {
+	case TicketPriorityLow, TicketPriorityNormal, TicketPriorityHigh, TicketPriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// TicketType is the type of Ticket
+type TicketType string
+
+const (
+	// TicketTypeProblem : problem
+	TicketTypeProblem TicketType = "problem"
+	// TicketTypeIncident : incident
+	TicketTypeIncident TicketType = "incident"
+	// TicketTypeQuestion : question
+	TicketTypeQuestion TicketType = "question"
+	// TicketTypeTask : task
+	TicketTypeTask TicketType = "task"
+)
+
+// Valid reports whether t is one of the known types
+func (t TicketType) Valid() bool {
+	switch t {
+	case TicketTypeProblem, TicketTypeIncident, TicketTypeQuestion, TicketTypeTask:
+		return true
+	}
+	return false
+}
+
+// TicketValidationError is returned by CreateTicket and UpdateTicket
+// when the ticket has an unknown status, priority or type.
+type TicketValidationError struct {
+	Field string
+	Value string
+}
+
+func (e *TicketValidationError) Error() string {
+	return fmt.Sprintf("invalid ticket %s %q", e.Field, e.Value)
+}
+
+// Validate checks status, priority and type of the ticket.
+// Empty values are valid because they are omitted from the request.
+func (t Ticket) Validate() error {
+	if t.Status != "" && !t.Status.Valid() {
+		return &TicketValidationError{Field: "status", Value: string(t.Status)}
+	}
+	if t.Priority != "" && !t.Priority.Valid() {
+		return &TicketValidationError{Field: "priority", Value: string(t.Priority)}
+	}
+	if t.Type != "" && !t.Type.Valid() {
+		return &TicketValidationError{Field: "type", Value: string(t.Type)}
+	}
+	return nil
+}
+
+// IsOpen reports whether the ticket is unsolved
+func (t Ticket) IsOpen() bool {
+	return t.Status.IsOpen()
+}
+
+// IsSolved reports whether the ticket is solved or closed
+func (t Ticket) IsSolved() bool {
+	return t.Status.IsSolved()
+}
@@ -0,0 +1,58 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestTicketStatusPredicates(t *testing.T) {
+	for _, s := range []TicketStatus{TicketStatusNew, TicketStatusOpen, TicketStatusPending, TicketStatusHold} {
+		if !s.IsOpen() || s.IsSolved() {
+			t.Fatalf("%s should be open", s)
+		}
+	}
+	for _, s := range []TicketStatus{TicketStatusSolved, TicketStatusClosed} {
+		if s.IsOpen() || !s.IsSolved() {
+			t.Fatalf("%s should be solved", s)
+		}
+	}
+	if TicketStatus("pendng").Valid() {
+		t.Fatal("pendng should be invalid")
+	}
+}
+
+func TestTicketValidate(t *testing.T) {
+	valid := Ticket{Status: TicketStatusOpen, Priority: TicketPriorityHigh, Type: TicketTypeTask}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if err := (Ticket{CustomStatusID: 123}).Validate(); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	var verr *TicketValidationError
+	err := Ticket{Priority: "hgih"}.Validate()
+	if !errors.As(err, &verr) || verr.Field != "priority" {
+		t.Fatalf("expected priority validation error, but got %v", err)
+	}
+}
+
+func TestCreateTicketInvalidStatus(t *testing.T) {
+	requested := false
+	mockAPI := newMockAPI(http.MethodPost, "ticket.json")
+	mockAPI.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	})
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicket(ctx, Ticket{Status: "pendng"})
+	var verr *TicketValidationError
+	if !errors.As(err, &verr) || verr.Field != "status" {
+		t.Fatalf("expected status validation error, but got %v", err)
+	}
+	if requested {
+		t.Fatal("invalid ticket should not be sent")
+	}
+}
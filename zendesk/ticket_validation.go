@@ -0,0 +1,202 @@
+package zendesk
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TicketFieldErrorRequired is the reason of a required field without value
+	TicketFieldErrorRequired = "required"
+	// TicketFieldErrorInvalidType is the reason of a value of unexpected type
+	TicketFieldErrorInvalidType = "invalid_type"
+	// TicketFieldErrorInvalidOption is the reason of a dropdown or multi-select value which is not an option
+	TicketFieldErrorInvalidOption = "invalid_option"
+	// TicketFieldErrorPatternMismatch is the reason of a regex field value not matching the pattern
+	TicketFieldErrorPatternMismatch = "pattern_mismatch"
+)
+
+// TicketFieldError is an invalid custom field value found by ValidateTicket
+type TicketFieldError struct {
+	FieldID int64
+	Title   string
+	Reason  string
+	Value   interface{}
+}
+
+func (e TicketFieldError) Error() string {
+	if e.Reason == TicketFieldErrorRequired {
+		return fmt.Sprintf("ticket field %q (%d) is required", e.Title, e.FieldID)
+	}
+	return fmt.Sprintf("ticket field %q (%d) has %s value %v", e.Title, e.FieldID, strings.ReplaceAll(e.Reason, "_", " "), e.Value)
+}
+
+// TicketFieldErrors is the errors returned by ValidateTicket
+type TicketFieldErrors []TicketFieldError
+
+func (e TicketFieldErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// systemTicketFieldTypes are the types of system fields, whose values are not custom fields
+var systemTicketFieldTypes = map[string]bool{
+	"subject":        true,
+	"description":    true,
+	"status":         true,
+	"custom_status":  true,
+	"tickettype":     true,
+	"priority":       true,
+	"basic_priority": true,
+	"group":          true,
+	"assignee":       true,
+}
+
+// ValidateTicket checks the custom field values of the ticket against the
+// fields shown to agents on the form, so that invalid tickets are rejected
+// before the API round trip. fields are the ticket fields of the account,
+// e.g. from GetTicketFields. It checks
+//
+//   - values of fields required to solve the ticket or by the form conditions
+//   - types of checkbox, numeric and date values
+//   - options of dropdown and multi-select values
+//   - patterns of regex fields
+//
+// The result is TicketFieldErrors if the ticket has invalid values.
+// If form has no TicketFieldIDs, all the active fields are checked.
+func ValidateTicket(ticket Ticket, form TicketForm, fields []TicketField) error {
+	fieldByID := make(map[int64]TicketField, len(fields))
+	for _, f := range fields {
+		fieldByID[f.ID] = f
+	}
+
+	visible := form.VisibleAgentFields(ticket.CustomFields, ticket.Status)
+	if len(form.TicketFieldIDs) == 0 {
+		visible = nil
+		for _, f := range fields {
+			if f.Active {
+				visible = append(visible, TicketFormVisibleField{ID: f.ID})
+			}
+		}
+	}
+
+	var errs TicketFieldErrors
+	for _, v := range visible {
+		field, ok := fieldByID[v.ID]
+		if !ok || systemTicketFieldTypes[field.Type] {
+			continue
+		}
+
+		value, _ := ticket.CustomFieldValue(field.ID)
+		if isEmptyTicketFieldValue(field.Type, value) {
+			if v.RequiredByCondition || (field.Required && ticket.IsSolved()) {
+				errs = append(errs, TicketFieldError{FieldID: field.ID, Title: field.Title, Reason: TicketFieldErrorRequired})
+			}
+			continue
+		}
+
+		if reason := checkTicketFieldValue(field, value); reason != "" {
+			errs = append(errs, TicketFieldError{FieldID: field.ID, Title: field.Title, Reason: reason, Value: value})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func isEmptyTicketFieldValue(fieldType string, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		// required checkbox must be checked
+		return fieldType == "checkbox" && !v
+	}
+	if values, ok := customFieldMulti(value); ok {
+		return len(values) == 0
+	}
+	return false
+}
+
+// checkTicketFieldValue returns the reason if value is invalid for the field
+func checkTicketFieldValue(field TicketField, value interface{}) string {
+	switch field.Type {
+	case "checkbox":
+		if _, ok := customFieldBool(value); !ok {
+			return TicketFieldErrorInvalidType
+		}
+	case "integer", "lookup":
+		if _, ok := customFieldInt(value); !ok {
+			return TicketFieldErrorInvalidType
+		}
+	case "decimal":
+		switch v := value.(type) {
+		case float64, int64, int:
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				return TicketFieldErrorInvalidType
+			}
+		default:
+			return TicketFieldErrorInvalidType
+		}
+	case "date":
+		s, ok := customFieldString(value)
+		if !ok {
+			return TicketFieldErrorInvalidType
+		}
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return TicketFieldErrorInvalidType
+		}
+	case "tagger":
+		s, ok := customFieldString(value)
+		if !ok {
+			return TicketFieldErrorInvalidType
+		}
+		if !hasCustomFieldOption(field.CustomFieldOptions, s) {
+			return TicketFieldErrorInvalidOption
+		}
+	case "multiselect":
+		values, ok := customFieldMulti(value)
+		if !ok {
+			return TicketFieldErrorInvalidType
+		}
+		for _, s := range values {
+			if !hasCustomFieldOption(field.CustomFieldOptions, s) {
+				return TicketFieldErrorInvalidOption
+			}
+		}
+	case "regexp":
+		s, ok := customFieldString(value)
+		if !ok {
+			return TicketFieldErrorInvalidType
+		}
+		// patterns which Go can't compile are left to Zendesk
+		if re, err := regexp.Compile(field.RegexpForValidation); err == nil && !re.MatchString(s) {
+			return TicketFieldErrorPatternMismatch
+		}
+	default:
+		if _, ok := customFieldString(value); !ok {
+			return TicketFieldErrorInvalidType
+		}
+	}
+	return ""
+}
+
+func hasCustomFieldOption(options []CustomFieldOption, value string) bool {
+	for _, o := range options {
+		if o.Value == value {
+			return true
+		}
+	}
+	return false
+}
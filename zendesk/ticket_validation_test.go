@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateTicket(t *testing.T) {
+	fields := []TicketField{
+		{ID: 1, Type: "subject", Title: "Subject", Required: true, Active: true},
+		{ID: 2, Type: "tagger", Title: "Category", Required: true, Active: true, CustomFieldOptions: []CustomFieldOption{
+			{Name: "Hardware", Value: "hardware"},
+			{Name: "Software", Value: "software"},
+		}},
+		{ID: 3, Type: "regexp", Title: "Serial", RegexpForValidation: `^[A-Z]{2}\d{4}$`, Active: true},
+		{ID: 4, Type: "multiselect", Title: "Products", Active: true, CustomFieldOptions: []CustomFieldOption{
+			{Name: "Laptop", Value: "laptop"},
+		}},
+		{ID: 5, Type: "integer", Title: "Quantity", Active: true},
+	}
+	form := TicketForm{
+		TicketFieldIDs: []int64{1, 2, 3, 4, 5},
+		AgentConditions: []TicketFormCondition{
+			{ParentFieldID: 2, Value: "hardware", ChildFields: []TicketFormConditionChild{{ID: 3, IsRequired: true}}},
+		},
+	}
+
+	ticket := Ticket{Status: TicketStatusOpen}
+	if err := ValidateTicket(ticket, form, fields); err != nil {
+		t.Fatalf("expected open ticket without values to be valid, but got %s", err)
+	}
+
+	ticket.Status = TicketStatusSolved
+	ticket.SetCustomField(2, "hardware")
+	ticket.SetCustomField(4, []string{"laptop", "phone"})
+	ticket.SetCustomField(5, "ten")
+
+	err := ValidateTicket(ticket, form, fields)
+	var errs TicketFieldErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected TicketFieldErrors, but got %v", err)
+	}
+	expected := TicketFieldErrors{
+		{FieldID: 3, Title: "Serial", Reason: TicketFieldErrorRequired},
+		{FieldID: 4, Title: "Products", Reason: TicketFieldErrorInvalidOption, Value: []string{"laptop", "phone"}},
+		{FieldID: 5, Title: "Quantity", Reason: TicketFieldErrorInvalidType, Value: "ten"},
+	}
+	if !reflect.DeepEqual(errs, expected) {
+		t.Fatalf("expected %v, but got %v", expected, errs)
+	}
+
+	ticket.SetCustomField(3, "ab1234")
+	ticket.SetCustomField(4, []string{"laptop"})
+	ticket.SetCustomField(5, int64(10))
+	err = ValidateTicket(ticket, form, fields)
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Reason != TicketFieldErrorPatternMismatch {
+		t.Fatalf("expected pattern mismatch, but got %v", err)
+	}
+}
+
+func TestValidateTicketWithoutForm(t *testing.T) {
+	fields := []TicketField{
+		{ID: 1, Type: "checkbox", Title: "Agreed", Required: true, Active: true},
+		{ID: 2, Type: "date", Title: "Due", Required: true},
+	}
+
+	err := ValidateTicket(Ticket{Status: TicketStatusSolved, CustomFields: []CustomField{{ID: 1, Value: false}}}, TicketForm{}, fields)
+	if err == nil || err.Error() != `ticket field "Agreed" (1) is required` {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
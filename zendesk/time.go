@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timeLayouts are the timestamp formats Zendesk returns
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02",
+}
+
+// Time is time.Time which tolerates the several timestamp formats in Zendesk API:
+// ISO 8601 with or without fractional seconds, and Unix epoch seconds as number
+// or string as in incremental exports. null and empty string are decoded to the
+// zero Time.
+//
+// It is marshalled in the ISO 8601 format with second precision in UTC, and
+// the zero Time is marshalled as null. The structs which existed before Time
+// keep time.Time for compatibility, and the others use Time. Since omitempty
+// has no effect on struct types, the fields which can be omitted in requests
+// are *Time.
+type Time struct {
+	time.Time
+}
+
+// NewTime returns Time of t
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// UnmarshalJSON is unmarshaller for Time
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		var epoch json.Number
+		if err := json.Unmarshal(data, &epoch); err != nil {
+			return err
+		}
+		return t.parseEpoch(epoch.String())
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.parse(s)
+}
+
+func (t *Time) parse(s string) error {
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	if err := t.parseEpoch(s); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%q is an invalid time", s)
+}
+
+func (t *Time) parseEpoch(s string) error {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%q is an invalid time", s)
+	}
+	t.Time = time.Unix(sec, 0).UTC()
+	return nil
+}
+
+// MarshalJSON is marshaller for Time
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.UTC().Format(time.RFC3339))
+}
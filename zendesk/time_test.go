@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	expected := time.Date(2019, 6, 3, 2, 34, 52, 0, time.UTC)
+
+	for _, data := range []string{
+		`"2019-06-03T02:34:52Z"`,
+		`"2019-06-03T02:34:52.000Z"`,
+		`"2019-06-03T11:34:52+09:00"`,
+		`"2019-06-03T11:34:52+0900"`,
+		`"2019-06-03 11:34:52 +0900"`,
+		`1559529292`,
+		`"1559529292"`,
+	} {
+		var v Time
+		if err := json.Unmarshal([]byte(data), &v); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %s", data, err)
+		}
+		if !v.Equal(expected) {
+			t.Fatalf("expected %s, but got %s from %s", expected, v, data)
+		}
+	}
+}
+
+func TestTimeUnmarshalJSONEmpty(t *testing.T) {
+	var v struct {
+		A Time `json:"a"`
+		B Time `json:"b"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":null,"b":""}`), &v); err != nil {
+		t.Fatalf("Failed to unmarshal: %s", err)
+	}
+	if !v.A.IsZero() || !v.B.IsZero() {
+		t.Fatal("null and empty string should be zero time")
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":"yesterday"}`), &v); err == nil {
+		t.Fatal("invalid time should cause an error")
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	loc := time.FixedZone("JST", 9*60*60)
+	b, err := json.Marshal([]Time{
+		NewTime(time.Date(2019, 6, 3, 11, 34, 52, 123, loc)),
+		{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal: %s", err)
+	}
+	if string(b) != `["2019-06-03T02:34:52Z",null]` {
+		t.Fatalf("unexpected json %s", b)
+	}
+}
+
+func TestTimePointerOmitted(t *testing.T) {
+	b, err := json.Marshal(ContentTag{Name: "tag"})
+	if err != nil {
+		t.Fatalf("Failed to marshal: %s", err)
+	}
+	if string(b) != `{"name":"tag"}` {
+		t.Fatalf("unexpected json %s", b)
+	}
+}
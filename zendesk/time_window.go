@@ -0,0 +1,128 @@
+package zendesk
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrTimeWindowUnsupported is returned when the endpoint cannot filter by the time window,
+// e.g. incremental exports which only start from the time of changes
+var ErrTimeWindowUnsupported = errors.New("zendesk: time window is not supported by the endpoint")
+
+// TimeWindowField is the timestamp which TimeWindow filters by
+type TimeWindowField string
+
+const (
+	// TimeWindowCreated filters by the time records were created
+	TimeWindowCreated TimeWindowField = "created"
+	// TimeWindowUpdated filters by the time records were updated
+	TimeWindowUpdated TimeWindowField = "updated"
+)
+
+// TimeWindow is the range of time to filter records, which is translated into
+// the parameters of each endpoint. From and To are exclusive, and the zero
+// time means unbounded.
+type TimeWindow struct {
+	Field TimeWindowField
+	From  time.Time
+	To    time.Time
+}
+
+// CreatedBetween returns the window of records created between from and to
+func CreatedBetween(from, to time.Time) TimeWindow {
+	return TimeWindow{Field: TimeWindowCreated, From: from, To: to}
+}
+
+// CreatedSince returns the window of records created after t
+func CreatedSince(t time.Time) TimeWindow {
+	return TimeWindow{Field: TimeWindowCreated, From: t}
+}
+
+// UpdatedBetween returns the window of records updated between from and to
+func UpdatedBetween(from, to time.Time) TimeWindow {
+	return TimeWindow{Field: TimeWindowUpdated, From: from, To: to}
+}
+
+// UpdatedSince returns the window of records updated after t
+func UpdatedSince(t time.Time) TimeWindow {
+	return TimeWindow{Field: TimeWindowUpdated, From: t}
+}
+
+// Contains reports whether t is in the window. It is used to filter the
+// records of endpoints which cannot filter by the end of the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	if !w.From.IsZero() && !t.After(w.From) {
+		return false
+	}
+	if !w.To.IsZero() && !t.Before(w.To) {
+		return false
+	}
+	return true
+}
+
+// SearchQuery returns the search terms of the window like
+// "created>2024-01-01T00:00:00Z created<2024-02-01T00:00:00Z"
+func (w TimeWindow) SearchQuery() string {
+	var terms []string
+	if !w.From.IsZero() {
+		terms = append(terms, string(w.Field)+">"+w.From.UTC().Format(time.RFC3339))
+	}
+	if !w.To.IsZero() {
+		terms = append(terms, string(w.Field)+"<"+w.To.UTC().Format(time.RFC3339))
+	}
+	return strings.Join(terms, " ")
+}
+
+// StartTime returns start_time of incremental exports, which return the records
+// changed after the time. Only updated windows are supported, and the records
+// after To must be skipped by the caller, e.g. with Contains.
+func (w TimeWindow) StartTime() (int64, error) {
+	if w.Field != TimeWindowUpdated {
+		return 0, ErrTimeWindowUnsupported
+	}
+	if w.From.IsZero() {
+		return 0, nil
+	}
+	return w.From.Unix(), nil
+}
+
+// AddTimeWindow adds the terms of the window to the query
+func (o *SearchOptions) AddTimeWindow(w TimeWindow) {
+	o.Query = strings.TrimSpace(o.Query + " " + w.SearchQuery())
+}
+
+// AddTimeWindow adds the terms of the window to the query
+func (o *CountOptions) AddTimeWindow(w TimeWindow) {
+	o.Query = strings.TrimSpace(o.Query + " " + w.SearchQuery())
+}
+
+// SetTimeWindow sets StartTime to the start of the updated window
+func (o *CursorOption) SetTimeWindow(w TimeWindow) error {
+	start, err := w.StartTime()
+	if err != nil {
+		return err
+	}
+	o.StartTime = start
+	return nil
+}
+
+// SetTimeWindow sets StartTime to the start of the updated window
+func (o *IncrementalTicketEventsOptions) SetTimeWindow(w TimeWindow) error {
+	start, err := w.StartTime()
+	if err != nil {
+		return err
+	}
+	o.StartTime = start
+	return nil
+}
+
+// SetTimeWindow sets StartTime to the start of the updated window
+func (o *IncrementalTicketMetricEventsOptions) SetTimeWindow(w TimeWindow) error {
+	start, err := w.StartTime()
+	if err != nil {
+		return err
+	}
+	o.StartTime = start
+	return nil
+}
@@ -0,0 +1,47 @@
+package zendesk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeWindowSearchQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	opts := SearchOptions{Query: "type:ticket"}
+	opts.AddTimeWindow(CreatedBetween(from, to))
+	if opts.Query != "type:ticket created>2024-01-01T00:00:00Z created<2024-02-01T00:00:00Z" {
+		t.Fatalf("unexpected query %q", opts.Query)
+	}
+
+	count := CountOptions{}
+	count.AddTimeWindow(UpdatedSince(to))
+	if count.Query != "updated>2024-02-01T00:00:00Z" {
+		t.Fatalf("unexpected query %q", count.Query)
+	}
+}
+
+func TestTimeWindowStartTime(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+
+	var opts IncrementalTicketEventsOptions
+	if err := opts.SetTimeWindow(UpdatedSince(since)); err != nil || opts.StartTime != 1700000000 {
+		t.Fatalf("unexpected start time %d, %v", opts.StartTime, err)
+	}
+	if err := opts.SetTimeWindow(CreatedSince(since)); !errors.Is(err, ErrTimeWindowUnsupported) {
+		t.Fatalf("expected ErrTimeWindowUnsupported, got %v", err)
+	}
+}
+
+func TestTimeWindowContains(t *testing.T) {
+	from := time.Unix(100, 0)
+	w := UpdatedBetween(from, from.Add(time.Hour))
+	if w.Contains(from) || !w.Contains(from.Add(time.Minute)) || w.Contains(from.Add(time.Hour)) {
+		t.Fatal("unexpected Contains")
+	}
+	if !UpdatedSince(time.Time{}).Contains(from) {
+		t.Fatal("unbounded window should contain any time")
+	}
+}
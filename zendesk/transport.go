@@ -0,0 +1,63 @@
+package zendesk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// TransportOptions is options for SetTransport
+type TransportOptions struct {
+	// ProxyURL is the URL of HTTP(S) proxy, e.g. "http://proxy.example.com:3128".
+	// The proxy is taken from HTTPS_PROXY and NO_PROXY environment variables if empty.
+	ProxyURL string
+	// RootCAs is the PEM encoded certificates trusted in addition to the
+	// system pool, e.g. of TLS intercepting proxy
+	RootCAs []byte
+	// MinTLSVersion is the minimum TLS version such as tls.VersionTLS12.
+	// The default of crypto/tls is used if zero.
+	MinTLSVersion uint16
+}
+
+// SetTransport replaces the HTTP client with a new one connecting through the
+// proxy and TLS configuration, so that callers don't need to build *http.Client
+// themselves. The timeout of the current HTTP client is kept.
+func (z *Client) SetTransport(opts *TransportOptions) error {
+	tmp := opts
+	if tmp == nil {
+		tmp = &TransportOptions{}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tmp.ProxyURL != "" {
+		proxyURL, err := url.Parse(tmp.ProxyURL)
+		if err != nil {
+			return err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if len(tmp.RootCAs) > 0 || tmp.MinTLSVersion != 0 {
+		tlsConfig := &tls.Config{MinVersion: tmp.MinTLSVersion}
+		if len(tmp.RootCAs) > 0 {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(tmp.RootCAs) {
+				return errors.New("no valid certificates in RootCAs")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	if z.httpClient != nil {
+		httpClient.Timeout = z.httpClient.Timeout
+	}
+	z.httpClient = httpClient
+	return nil
+}
@@ -0,0 +1,58 @@
+package zendesk
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSetTransport(t *testing.T) {
+	client, _ := NewClient(&http.Client{Timeout: time.Minute})
+	err := client.SetTransport(&TransportOptions{
+		ProxyURL:      "http://proxy.example.com:3128",
+		MinTLSVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		t.Fatalf("Failed to set transport: %s", err)
+	}
+	if client.httpClient == http.DefaultClient || client.httpClient.Timeout != time.Minute {
+		t.Fatalf("unexpected http client %v", client.httpClient)
+	}
+
+	transport := client.httpClient.Transport.(*http.Transport)
+	req, _ := http.NewRequest(http.MethodGet, "https://example.zendesk.com/api/v2/groups.json", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy.Host != "proxy.example.com:3128" {
+		t.Fatalf("unexpected proxy %v %v", proxy, err)
+	}
+	if transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+		t.Fatalf("unexpected TLS config %v", transport.TLSClientConfig)
+	}
+}
+
+func TestSetTransportRootCAs(t *testing.T) {
+	mockAPI := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(readFixture("GET/groups.json"))
+	}))
+	defer mockAPI.Close()
+
+	client := newTestClient(mockAPI)
+	if _, err := client.get(ctx, "/groups.json"); err == nil {
+		t.Fatal("self-signed certificate should not be trusted")
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: mockAPI.Certificate().Raw})
+	if err := client.SetTransport(&TransportOptions{RootCAs: cert}); err != nil {
+		t.Fatalf("Failed to set transport: %s", err)
+	}
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+
+	if err := client.SetTransport(&TransportOptions{RootCAs: []byte("invalid")}); err == nil {
+		t.Fatal("SetTransport did not return error for invalid certificates")
+	}
+}
@@ -48,10 +48,20 @@ type Trigger struct {
 // ref: https://developer.zendesk.com/rest_api/docs/support/triggers#list-triggers
 type TriggerListOptions struct {
 	PageOptions
-	Active     bool   `url:"active,omitempty"`
-	CategoryID string `url:"category_id,omitempty"`
-	SortBy     string `url:"sort_by,omitempty"`
-	SortOrder  string `url:"sort_order,omitempty"`
+	Active     bool          `url:"active,omitempty"`
+	CategoryID string        `url:"category_id,omitempty"`
+	SortBy     RuleSortField `url:"sort_by,omitempty"`
+	SortOrder  SortOrder     `url:"sort_order,omitempty"`
+}
+
+// Validate checks the sort field and order
+func (o TriggerListOptions) Validate() error {
+	if err := validateSort("sort_by", o.SortBy,
+		RuleSortByAlphabetical, RuleSortByCreatedAt, RuleSortByUpdatedAt, RuleSortByPosition,
+		RuleSortByUsage1h, RuleSortByUsage24h, RuleSortByUsage7d, RuleSortByUsage30d); err != nil {
+		return err
+	}
+	return validateSortOrder(o.SortOrder)
 }
 
 // TriggerAPI an interface containing all trigger related methods
@@ -93,6 +103,20 @@ func (z *Client) GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]T
 	return data.Triggers, data.Page, nil
 }
 
+// GetTriggersPage fetches the page of triggers at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetTriggersPage(ctx context.Context, token PageToken, opts *TriggerListOptions) (ListPage[Trigger], error) {
+	tmp := TriggerListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]Trigger, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetTriggers(ctx, &tmp)
+	})(ctx, token)
+}
+
 // CreateTrigger creates new trigger
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/triggers#create-trigger
@@ -0,0 +1,130 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MonitoredTwitterHandle is a Twitter (X) account monitored by the account
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/
+type MonitoredTwitterHandle struct {
+	ID            int64  `json:"id"`
+	ScreenName    string `json:"screen_name"`
+	TwitterUserID int64  `json:"twitter_user_id"`
+	BrandID       int64  `json:"brand_id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Avatar        string `json:"avatar,omitempty"`
+	AllowReply    bool   `json:"allow_reply"`
+	CanReply      bool   `json:"can_reply"`
+	State         string `json:"state,omitempty"`
+	CreatedAt     *Time  `json:"created_at,omitempty"`
+	UpdatedAt     *Time  `json:"updated_at,omitempty"`
+}
+
+// TwitterStatus is the status of a tweet in a ticket
+type TwitterStatus struct {
+	ID           int64 `json:"id"`
+	Favorited    bool  `json:"favorited"`
+	Retweeted    bool  `json:"retweeted"`
+	UserFollowed bool  `json:"user_followed"`
+}
+
+// TwitterChannelAPI an interface containing all Twitter channel related methods
+type TwitterChannelAPI interface {
+	GetMonitoredTwitterHandles(ctx context.Context) ([]MonitoredTwitterHandle, error)
+	GetMonitoredTwitterHandle(ctx context.Context, handleID int64) (MonitoredTwitterHandle, error)
+	CreateTicketFromTweet(ctx context.Context, statusMessageID int64, handleID int64) (Ticket, error)
+	GetTwitterStatuses(ctx context.Context, commentID int64, statusIDs []int64) ([]TwitterStatus, error)
+}
+
+// GetMonitoredTwitterHandles fetches the monitored Twitter handles
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#list-monitored-x-handles
+func (z *Client) GetMonitoredTwitterHandles(ctx context.Context) ([]MonitoredTwitterHandle, error) {
+	var result struct {
+		Handles []MonitoredTwitterHandle `json:"monitored_twitter_handles"`
+	}
+
+	body, err := z.get(ctx, "/channels/twitter/monitored_twitter_handles.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Handles, nil
+}
+
+// GetMonitoredTwitterHandle fetches the monitored Twitter handle
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#show-monitored-x-handle
+func (z *Client) GetMonitoredTwitterHandle(ctx context.Context, handleID int64) (MonitoredTwitterHandle, error) {
+	var result struct {
+		Handle MonitoredTwitterHandle `json:"monitored_twitter_handle"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/twitter/monitored_twitter_handles/%d.json", handleID))
+	if err != nil {
+		return MonitoredTwitterHandle{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MonitoredTwitterHandle{}, err
+	}
+	return result.Handle, nil
+}
+
+// CreateTicketFromTweet creates a ticket from the tweet mentioning the monitored handle
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#create-ticket-from-tweet
+func (z *Client) CreateTicketFromTweet(ctx context.Context, statusMessageID int64, handleID int64) (Ticket, error) {
+	var data struct {
+		Ticket struct {
+			TwitterStatusMessageID   int64 `json:"twitter_status_message_id"`
+			MonitoredTwitterHandleID int64 `json:"monitored_twitter_handle_id"`
+		} `json:"ticket"`
+	}
+	data.Ticket.TwitterStatusMessageID = statusMessageID
+	data.Ticket.MonitoredTwitterHandleID = handleID
+
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+
+	body, err := z.post(ctx, "/channels/twitter/tickets.json", data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// GetTwitterStatuses fetches the statuses of the tweets in the ticket comment.
+// If statusIDs is empty, the statuses of all the tweets are returned.
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#list-ticket-statuses
+func (z *Client) GetTwitterStatuses(ctx context.Context, commentID int64, statusIDs []int64) ([]TwitterStatus, error) {
+	var result struct {
+		Statuses []TwitterStatus `json:"statuses"`
+	}
+
+	path := fmt.Sprintf("/channels/twitter/tickets/%d/statuses.json", commentID)
+	if len(statusIDs) > 0 {
+		path += "?ids=" + joinIDs(statusIDs)
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Statuses, nil
+}
@@ -0,0 +1,83 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetMonitoredTwitterHandles(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "monitored_twitter_handles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	handles, err := client.GetMonitoredTwitterHandles(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get monitored twitter handles: %s", err)
+	}
+	if len(handles) != 1 || handles[0].ScreenName != "@zendesk" {
+		t.Fatalf("unexpected handles %v", handles)
+	}
+}
+
+func TestGetMonitoredTwitterHandle(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "monitored_twitter_handle.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	handle, err := client.GetMonitoredTwitterHandle(ctx, 211)
+	if err != nil {
+		t.Fatalf("Failed to get monitored twitter handle: %s", err)
+	}
+	if handle.ID != 211 || !handle.CanReply {
+		t.Fatalf("unexpected handle %v", handle)
+	}
+}
+
+func TestCreateTicketFromTweet(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Ticket map[string]int64 `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if payload.Ticket["twitter_status_message_id"] != 8605426295771136 || payload.Ticket["monitored_twitter_handle_id"] != 211 {
+			t.Fatalf("unexpected payload %v", payload.Ticket)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.CreateTicketFromTweet(ctx, 8605426295771136, 211)
+	if err != nil {
+		t.Fatalf("Failed to create ticket from tweet: %s", err)
+	}
+	if ticket.ID == 0 {
+		t.Fatal("Failed to create ticket from tweet")
+	}
+}
+
+func TestGetTwitterStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/twitter/tickets/123/statuses.json" || r.URL.Query().Get("ids") != "2459870001,2459870002" {
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "twitter_statuses.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetTwitterStatuses(ctx, 123, []int64{2459870001, 2459870002})
+	if err != nil {
+		t.Fatalf("Failed to get twitter statuses: %s", err)
+	}
+	if len(statuses) != 2 || !statuses[1].Retweeted {
+		t.Fatalf("unexpected statuses %v", statuses)
+	}
+}
@@ -3,7 +3,10 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -13,47 +16,82 @@ type UserFields map[string]interface{}
 // User is zendesk user JSON payload format
 // https://developer.zendesk.com/rest_api/docs/support/users
 type User struct {
-	ID                   int64      `json:"id,omitempty"`
-	URL                  string     `json:"url,omitempty"`
-	Email                string     `json:"email,omitempty"`
-	Name                 string     `json:"name"`
-	Active               bool       `json:"active,omitempty"`
-	Alias                string     `json:"alias,omitempty"`
-	ChatOnly             bool       `json:"chat_only,omitempty"`
-	CustomRoleID         int64      `json:"custom_role_id,omitempty"`
-	DefaultGroupID       int64      `json:"default_group_id,omitempty"`
-	Details              string     `json:"details,omitempty"`
-	ExternalID           string     `json:"external_id,omitempty"`
-	IanaTimezone         string     `json:"iana_time_zone,omitempty"`
-	Locale               string     `json:"locale,omitempty"`
-	LocaleID             int64      `json:"locale_id,omitempty"`
-	Moderator            bool       `json:"moderator,omitempty"`
-	Notes                string     `json:"notes,omitempty"`
-	OnlyPrivateComments  bool       `json:"only_private_comments,omitempty"`
-	OrganizationID       int64      `json:"organization_id,omitempty"`
-	Phone                string     `json:"phone,omitempty"`
-	Photo                Attachment `json:"photo,omitempty"`
-	RemotePhotoURL       string     `json:"remote_photo_url,omitempty"`
-	RestrictedAgent      bool       `json:"restricted_agent,omitempty"`
-	Role                 string     `json:"role,omitempty"`
-	RoleType             int64      `json:"role_type,omitempty"`
-	Shared               bool       `json:"shared,omitempty"`
-	SharedAgent          bool       `json:"shared_agent,omitempty"`
-	SharedPhoneNumber    bool       `json:"shared_phone_number,omitempty"`
-	Signature            string     `json:"signature,omitempty"`
-	Suspended            bool       `json:"suspended,omitempty"`
-	Tags                 []string   `json:"tags,omitempty"`
-	TicketRestriction    string     `json:"ticket_restriction,omitempty"`
-	Timezone             string     `json:"time_zone,omitempty"`
-	TwoFactorAuthEnabled bool       `json:"two_factor_auth_enabled,omitempty"`
-	UserFields           UserFields `json:"user_fields"`
-	Verified             bool       `json:"verified,omitempty"`
-	ReportCSV            bool       `json:"report_csv,omitempty"`
-	LastLoginAt          time.Time  `json:"last_login_at,omitempty"`
-	CreatedAt            time.Time  `json:"created_at,omitempty"`
-	UpdatedAt            time.Time  `json:"updated_at,omitempty"`
+	ID                   int64          `json:"id,omitempty"`
+	URL                  string         `json:"url,omitempty"`
+	Email                string         `json:"email,omitempty"`
+	Name                 string         `json:"name"`
+	Active               bool           `json:"active,omitempty"`
+	Alias                string         `json:"alias,omitempty"`
+	ChatOnly             bool           `json:"chat_only,omitempty"`
+	CustomRoleID         int64          `json:"custom_role_id,omitempty"`
+	DefaultGroupID       int64          `json:"default_group_id,omitempty"`
+	Details              string         `json:"details,omitempty"`
+	ExternalID           string         `json:"external_id,omitempty"`
+	IanaTimezone         string         `json:"iana_time_zone,omitempty"`
+	Locale               string         `json:"locale,omitempty"`
+	LocaleID             int64          `json:"locale_id,omitempty"`
+	Moderator            bool           `json:"moderator,omitempty"`
+	Notes                string         `json:"notes,omitempty"`
+	OnlyPrivateComments  bool           `json:"only_private_comments,omitempty"`
+	OrganizationID       int64          `json:"organization_id,omitempty"`
+	Phone                string         `json:"phone,omitempty"`
+	Photo                Attachment     `json:"photo,omitempty"`
+	RemotePhotoURL       string         `json:"remote_photo_url,omitempty"`
+	RestrictedAgent      bool           `json:"restricted_agent,omitempty"`
+	Role                 string         `json:"role,omitempty"`
+	RoleType             int64          `json:"role_type,omitempty"`
+	Shared               bool           `json:"shared,omitempty"`
+	SharedAgent          bool           `json:"shared_agent,omitempty"`
+	SharedPhoneNumber    bool           `json:"shared_phone_number,omitempty"`
+	Signature            string         `json:"signature,omitempty"`
+	Suspended            bool           `json:"suspended,omitempty"`
+	Tags                 []string       `json:"tags,omitempty"`
+	TicketRestriction    string         `json:"ticket_restriction,omitempty"`
+	Timezone             string         `json:"time_zone,omitempty"`
+	TwoFactorAuthEnabled bool           `json:"two_factor_auth_enabled,omitempty"`
+	UserFields           UserFields     `json:"user_fields"`
+	Verified             bool           `json:"verified,omitempty"`
+	SkipVerifyEmail      bool           `json:"skip_verify_email,omitempty"`
+	Identities           []UserIdentity `json:"identities,omitempty"`
+	ReportCSV            bool           `json:"report_csv,omitempty"`
+	LastLoginAt          time.Time      `json:"last_login_at,omitempty"`
+	CreatedAt            time.Time      `json:"created_at,omitempty"`
+	UpdatedAt            time.Time      `json:"updated_at,omitempty"`
+
+	// NullFields is a list of JSON field names sent as null to clear them on update,
+	// e.g. []string{"organization_id"}.
+	NullFields []string `json:"-"`
+}
+
+// MarshalJSON is marshaller for User which sends NullFields as null
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalWithNullFields(user(u), u.NullFields)
+}
+
+// UserIdentity is an identity seeded on user creation
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#specifying-an-identity
+type UserIdentity struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Verified bool   `json:"verified,omitempty"`
+	Primary  bool   `json:"primary,omitempty"`
 }
 
+const (
+	// UserIdentityTypeEmail is email identity
+	UserIdentityTypeEmail = "email"
+	// UserIdentityTypePhoneNumber is phone number identity
+	UserIdentityTypePhoneNumber = "phone_number"
+	// UserIdentityTypeTwitter is twitter identity
+	UserIdentityTypeTwitter = "twitter"
+	// UserIdentityTypeFacebook is facebook identity
+	UserIdentityTypeFacebook = "facebook"
+	// UserIdentityTypeGoogle is google identity
+	UserIdentityTypeGoogle = "google"
+)
+
 const (
 	// UserRoleEndUser end-user
 	UserRoleEndUser = iota
@@ -102,6 +140,22 @@ type UserRelated struct {
 	OrganizationSubscriptions int64 `json:"organization_subscriptions"`
 }
 
+// UserWorkload is the related information of the user with the numbers of
+// unsolved tickets assigned to the user per status
+type UserWorkload struct {
+	UserRelated
+	AssignedByStatus map[TicketStatus]int
+}
+
+// UnsolvedAssignedTickets returns the number of unsolved tickets assigned to the user
+func (w UserWorkload) UnsolvedAssignedTickets() int {
+	total := 0
+	for _, n := range w.AssignedByStatus {
+		total += n
+	}
+	return total
+}
+
 // SearchUsersOptions is options for SearchUsers
 //
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#search-users
@@ -115,12 +169,17 @@ type SearchUsersOptions struct {
 type UserAPI interface {
 	SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]User, Page, error)
 	GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([]User, Page, error)
+	GetUsersByIDs(ctx context.Context, ids []int64, concurrency int) ([]User, error)
 	GetUsers(ctx context.Context, opts *UserListOptions) ([]User, Page, error)
+	GetUsersCursor(ctx context.Context, opts *CursorPagination) ([]User, CursorPaginationMeta, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
 	CreateUser(ctx context.Context, user User) (User, error)
 	CreateOrUpdateUser(ctx context.Context, user User) (User, error)
+	CreateOrUpdateUserByEmail(ctx context.Context, user User) (User, bool, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
+	SetUserPhoto(ctx context.Context, userID int64, filename string, content io.Reader) (User, error)
 	GetUserRelated(ctx context.Context, userID int64) (UserRelated, error)
+	GetUserWorkload(ctx context.Context, userID int64) (UserWorkload, error)
 }
 
 // GetUsers fetch user list
@@ -153,6 +212,55 @@ func (z *Client) GetUsers(ctx context.Context, opts *UserListOptions) ([]User, P
 	return data.Users, data.Page, nil
 }
 
+// GetUsersPage fetches the page of users at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetUsersPage(ctx context.Context, token PageToken, opts *UserListOptions) (ListPage[User], error) {
+	tmp := UserListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]User, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetUsers(ctx, &tmp)
+	})(ctx, token)
+}
+
+// GetUsersCursor fetch user list with cursor pagination, which is not
+// limited in depth unlike offset pagination.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+func (z *Client) GetUsersCursor(ctx context.Context, opts *CursorPagination) ([]User, CursorPaginationMeta, error) {
+	var data struct {
+		Users []User               `json:"users"`
+		Meta  CursorPaginationMeta `json:"meta"`
+	}
+
+	// page[size] switches the endpoint to cursor pagination
+	tmp := CursorPagination{}
+	if opts != nil {
+		tmp = *opts
+	}
+	if tmp.PageSize == 0 {
+		tmp.PageSize = defaultPerPage
+	}
+
+	u, err := addOptions("/users.json", &tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return data.Users, data.Meta, nil
+}
+
 // SearchUsers Returns an array of users who meet the search criteria.
 // https://developer.zendesk.com/api-reference/ticketing/users/users/#search-users
 func (z *Client) SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]User, Page, error) {
@@ -185,6 +293,20 @@ func (z *Client) SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]U
 	return data.Users, data.Page, nil
 }
 
+// SearchUsersPage fetches the page of users matching the search at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) SearchUsersPage(ctx context.Context, token PageToken, opts *SearchUsersOptions) (ListPage[User], error) {
+	tmp := SearchUsersOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]User, Page, error) {
+		tmp.PageOptions = opts
+		return z.SearchUsers(ctx, &tmp)
+	})(ctx, token)
+}
+
 // GetManyUsers fetch user list
 // https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
 func (z *Client) GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([]User, Page, error) {
@@ -259,7 +381,29 @@ func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error
 	return result.User, nil
 }
 
-// TODO: CreateOrUpdateManyUsers(users []User)
+// errUserWithoutEmail is returned by CreateOrUpdateUserByEmail when the user does not have email
+var errUserWithoutEmail = errors.New("user does not have email")
+
+// CreateOrUpdateUserByEmail creates new user, or updates the user matching the email.
+// It returns true if the user was created, and false if the existing user was found.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-user
+func (z *Client) CreateOrUpdateUserByEmail(ctx context.Context, user User) (User, bool, error) {
+	if user.Email == "" {
+		return User{}, false, errUserWithoutEmail
+	}
+
+	meta := responseMetaFromContext(ctx)
+	if meta == nil {
+		meta = &ResponseMeta{}
+		ctx = WithResponseMeta(ctx, meta)
+	}
+
+	user, err := z.CreateOrUpdateUser(ctx, user)
+	if err != nil {
+		return User{}, false, err
+	}
+	return user, meta.StatusCode == http.StatusCreated, nil
+}
 
 // GetUser get an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#show-user
@@ -300,6 +444,39 @@ func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User,
 	return result.User, nil
 }
 
+// SetUserPhoto uploads the image and sets it as the profile photo of the user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-user
+func (z *Client) SetUserPhoto(ctx context.Context, userID int64, filename string, content io.Reader) (User, error) {
+	token, err := z.uploadFile(ctx, filename, content)
+	if err != nil {
+		return User{}, err
+	}
+
+	var data struct {
+		User struct {
+			Photo struct {
+				Token string `json:"token"`
+			} `json:"photo"`
+		} `json:"user"`
+	}
+	data.User.Photo.Token = token
+
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
 // GetUserRelated retrieves user related user information
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-user-related-information
 func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated, error) {
@@ -318,3 +495,28 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	return data.UserRelated, nil
 }
+
+// GetUserWorkload fetches the related information of the agent and counts
+// the open, pending and on-hold tickets assigned to the agent. The counts are
+// based on search, so they may not include the tickets updated in the last minutes.
+func (z *Client) GetUserWorkload(ctx context.Context, userID int64) (UserWorkload, error) {
+	related, err := z.GetUserRelated(ctx, userID)
+	if err != nil {
+		return UserWorkload{}, err
+	}
+
+	workload := UserWorkload{
+		UserRelated:      related,
+		AssignedByStatus: map[TicketStatus]int{},
+	}
+	for _, status := range []TicketStatus{TicketStatusOpen, TicketStatusPending, TicketStatusHold} {
+		count, err := z.SearchCount(ctx, &CountOptions{
+			Query: fmt.Sprintf("type:ticket assignee:%d status:%s", userID, status),
+		})
+		if err != nil {
+			return UserWorkload{}, err
+		}
+		workload.AssignedByStatus[status] = count
+	}
+	return workload, nil
+}
@@ -0,0 +1,53 @@
+package zendesk
+
+import (
+	"runtime/debug"
+	"strings"
+)
+
+const (
+	userAgentName = "nukosuke/go-zendesk"
+	modulePath    = "github.com/nukosuke/go-zendesk"
+)
+
+// libraryVersion is the version of this module built into the program,
+// e.g. "v0.18.0", or empty if it is unknown such as in tests of this module
+var libraryVersion = readLibraryVersion()
+
+func readLibraryVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return ""
+}
+
+// userAgent composes User-Agent header of the library version and the suffix
+func userAgent(suffix string) string {
+	ua := userAgentName
+	if libraryVersion != "" {
+		ua += "/" + libraryVersion
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
+
+// SetUserAgentSuffix appends the identifier of the application to User-Agent
+// header, e.g. "my-app/1.2.3", which helps Zendesk support to troubleshoot
+// the requests of the application.
+func (z *Client) SetUserAgentSuffix(suffix string) {
+	z.SetHeader("User-Agent", userAgent(suffix))
+}
@@ -0,0 +1,35 @@
+package zendesk
+
+import (
+	"testing"
+)
+
+func TestSetUserAgentSuffix(t *testing.T) {
+	client, _ := NewClient(nil)
+	other, _ := NewClient(nil)
+	if ua := client.headers["User-Agent"]; ua != userAgent("") {
+		t.Fatalf("unexpected User-Agent %s", ua)
+	}
+
+	client.SetUserAgentSuffix("my-app/1.2.3")
+	if ua := client.headers["User-Agent"]; ua != userAgent("")+" my-app/1.2.3" {
+		t.Fatalf("unexpected User-Agent %s", ua)
+	}
+	if ua := other.headers["User-Agent"]; ua != userAgent("") {
+		t.Fatalf("User-Agent of other client was changed: %s", ua)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	defer func(v string) { libraryVersion = v }(libraryVersion)
+
+	libraryVersion = "v0.18.0"
+	if ua := userAgent(" my-app/1.2.3 "); ua != "nukosuke/go-zendesk/v0.18.0 my-app/1.2.3" {
+		t.Fatalf("unexpected User-Agent %s", ua)
+	}
+
+	libraryVersion = ""
+	if ua := userAgent(""); ua != "nukosuke/go-zendesk" {
+		t.Fatalf("unexpected User-Agent %s", ua)
+	}
+}
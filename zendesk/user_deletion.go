@@ -0,0 +1,147 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ComplianceDeletionStatus is a step of the GDPR deletion of a user in an application
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#show-compliance-deletion-statuses
+type ComplianceDeletionStatus struct {
+	Action           string `json:"action"`
+	Application      string `json:"application"`
+	AccountSubdomain string `json:"account_subdomain"`
+	ExecuterID       int64  `json:"executer_id"`
+	UserID           int64  `json:"user_id"`
+	CreatedAt        Time   `json:"created_at"`
+}
+
+const (
+	// ComplianceDeletionActionRequestDeletion means the deletion was requested
+	ComplianceDeletionActionRequestDeletion = "request_deletion"
+	// ComplianceDeletionActionStarted means the application started the deletion
+	ComplianceDeletionActionStarted = "started"
+	// ComplianceDeletionActionComplete means the application completed the deletion
+	ComplianceDeletionActionComplete = "complete"
+)
+
+// erasePollInterval is the interval EraseUser checks the compliance deletion statuses
+var erasePollInterval = 10 * time.Second
+
+// UserDeletionAPI an interface containing all user deletion related methods
+type UserDeletionAPI interface {
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) error
+	GetComplianceDeletionStatuses(ctx context.Context, userID int64, application string) ([]ComplianceDeletionStatus, error)
+	EraseUser(ctx context.Context, userID int64) error
+}
+
+// DeleteUser deletes the user. The user can be restored until it is permanently deleted.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.execRequest(ctx, fmt.Sprintf("/users/%d.json", userID), http.MethodDelete, nil, []int{http.StatusOK})
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// PermanentlyDeleteUser permanently deletes the deleted user for GDPR compliance.
+// The deletion proceeds asynchronously, and its progress is reported by GetComplianceDeletionStatuses.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) error {
+	_, err := z.execRequest(ctx, fmt.Sprintf("/deleted_users/%d.json", userID), http.MethodDelete, nil, []int{http.StatusOK, http.StatusNoContent})
+	return err
+}
+
+// GetComplianceDeletionStatuses fetches the GDPR deletion statuses of the user.
+// If application is not empty, only the statuses of the application are returned.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-compliance-deletion-statuses
+func (z *Client) GetComplianceDeletionStatuses(ctx context.Context, userID int64, application string) ([]ComplianceDeletionStatus, error) {
+	var result struct {
+		Statuses []ComplianceDeletionStatus `json:"compliance_deletion_statuses"`
+	}
+
+	var opts struct {
+		Application string `url:"application,omitempty"`
+	}
+	opts.Application = application
+
+	u, err := addOptions(fmt.Sprintf("/users/%d/compliance_deletion_statuses.json", userID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Statuses, nil
+}
+
+// EraseUser deletes and permanently deletes the user, then waits until all the
+// applications complete the deletion. A user which is already deleted is permanently
+// deleted. Use ctx to limit the time to wait.
+func (z *Client) EraseUser(ctx context.Context, userID int64) error {
+	if _, err := z.DeleteUser(ctx, userID); err != nil {
+		var zerr Error
+		if !errors.As(err, &zerr) || zerr.Status() != http.StatusNotFound {
+			return err
+		}
+	}
+
+	if err := z.PermanentlyDeleteUser(ctx, userID); err != nil {
+		return err
+	}
+
+	for {
+		statuses, err := z.GetComplianceDeletionStatuses(ctx, userID, "")
+		if err != nil {
+			return err
+		}
+		if deletionCompleted(statuses) {
+			return nil
+		}
+
+		if err := sleepContext(ctx, erasePollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// deletionCompleted reports whether all the applications in statuses completed the deletion.
+// The request for "all" applications is not an application itself.
+func deletionCompleted(statuses []ComplianceDeletionStatus) bool {
+	completed := map[string]bool{}
+	for _, s := range statuses {
+		if s.Application == "all" {
+			continue
+		}
+		completed[s.Application] = completed[s.Application] || s.Action == ComplianceDeletionActionComplete
+	}
+
+	for _, ok := range completed {
+		if !ok {
+			return false
+		}
+	}
+	return len(completed) > 0
+}
@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDeleteUser(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodDelete, "user.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to delete user: %s", err)
+	}
+	if user.ID != 369531345753 {
+		t.Fatalf("unexpected user %v", user)
+	}
+}
+
+func TestGetComplianceDeletionStatuses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "compliance_deletion_statuses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetComplianceDeletionStatuses(ctx, 1, "support")
+	if err != nil {
+		t.Fatalf("Failed to get compliance deletion statuses: %s", err)
+	}
+	if len(statuses) != 3 || statuses[2].Action != ComplianceDeletionActionComplete {
+		t.Fatalf("unexpected statuses %v", statuses)
+	}
+}
+
+func TestEraseUser(t *testing.T) {
+	defer func(d time.Duration) { erasePollInterval = d }(erasePollInterval)
+	erasePollInterval = time.Millisecond
+
+	var requests []string
+	statusPolls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/users/1.json":
+			// already deleted
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"RecordNotFound"}`))
+		case "/deleted_users/1.json":
+			w.Write([]byte(`{"deleted_user":{"id":1}}`))
+		case "/users/1/compliance_deletion_statuses.json":
+			statusPolls++
+			if statusPolls == 1 {
+				w.Write([]byte(`{"compliance_deletion_statuses":[{"action":"request_deletion","application":"all"},{"action":"started","application":"support"}]}`))
+				return
+			}
+			w.Write(readFixture(filepath.Join(http.MethodGet, "compliance_deletion_statuses.json")))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.EraseUser(ctx, 1); err != nil {
+		t.Fatalf("Failed to erase user: %s", err)
+	}
+	if len(requests) != 4 || requests[1] != "DELETE /deleted_users/1.json" {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+}
+
+func TestEraseUserCanceled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/1.json", "/deleted_users/1.json":
+			w.Write([]byte(`{}`))
+		default:
+			w.Write([]byte(`{"compliance_deletion_statuses":[{"action":"started","application":"support"}]}`))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := client.EraseUser(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, but got %v", err)
+	}
+}
+
+func TestDeletionCompleted(t *testing.T) {
+	if deletionCompleted(nil) {
+		t.Fatal("deletion without statuses should not be completed")
+	}
+	if deletionCompleted([]ComplianceDeletionStatus{
+		{Action: ComplianceDeletionActionComplete, Application: "support"},
+		{Action: ComplianceDeletionActionStarted, Application: "chat"},
+	}) {
+		t.Fatal("deletion in chat is not completed")
+	}
+}
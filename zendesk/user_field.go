@@ -64,3 +64,17 @@ func (z *Client) GetUserFields(ctx context.Context, opts *UserFieldListOptions)
 	}
 	return data.UserFields, data.Page, nil
 }
+
+// GetUserFieldsPage fetches the page of user fields at token as ListPage.
+// The zero PageToken is the first page, and the page options of opts are
+// replaced with token.
+func (z *Client) GetUserFieldsPage(ctx context.Context, token PageToken, opts *UserFieldListOptions) (ListPage[UserField], error) {
+	tmp := UserFieldListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]UserField, Page, error) {
+		tmp.PageOptions = opts
+		return z.GetUserFields(ctx, &tmp)
+	})(ctx, token)
+}
@@ -0,0 +1,142 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// UserSegmentUserTypeSignedIn is the user type of segment matching all the signed-in users
+	UserSegmentUserTypeSignedIn = "signed_in_users"
+	// UserSegmentUserTypeStaff is the user type of segment matching agents and admins
+	UserSegmentUserTypeStaff = "staff"
+)
+
+// UserSegment defines the users who can view Help Center content
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/
+type UserSegment struct {
+	ID              int64    `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	UserType        string   `json:"user_type"`
+	BuiltIn         bool     `json:"built_in,omitempty"`
+	GroupIDs        []int64  `json:"group_ids,omitempty"`
+	OrganizationIDs []int64  `json:"organization_ids,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	OrTags          []string `json:"or_tags,omitempty"`
+	AddedUserIDs    []int64  `json:"added_user_ids,omitempty"`
+	CreatedAt       *Time    `json:"created_at,omitempty"`
+	UpdatedAt       *Time    `json:"updated_at,omitempty"`
+}
+
+// UserSegmentAPI an interface containing all user segment related methods
+type UserSegmentAPI interface {
+	GetUserSegments(ctx context.Context, opts *PageOptions) ([]UserSegment, Page, error)
+	GetUserSegment(ctx context.Context, segmentID int64) (UserSegment, error)
+	CreateUserSegment(ctx context.Context, segment UserSegment) (UserSegment, error)
+	UpdateUserSegment(ctx context.Context, segmentID int64, segment UserSegment) (UserSegment, error)
+	DeleteUserSegment(ctx context.Context, segmentID int64) error
+}
+
+// GetUserSegments fetches the user segments
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#list-user-segments
+func (z *Client) GetUserSegments(ctx context.Context, opts *PageOptions) ([]UserSegment, Page, error) {
+	var data struct {
+		UserSegments []UserSegment `json:"user_segments"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/help_center/user_segments.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.UserSegments, data.Page, nil
+}
+
+// GetUserSegmentsPage fetches the page of user segments at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetUserSegmentsPage(ctx context.Context, token PageToken) (ListPage[UserSegment], error) {
+	return OffsetLister(func(ctx context.Context, opts PageOptions) ([]UserSegment, Page, error) {
+		return z.GetUserSegments(ctx, &opts)
+	})(ctx, token)
+}
+
+// GetUserSegment fetches the user segment
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#show-user-segment
+func (z *Client) GetUserSegment(ctx context.Context, segmentID int64) (UserSegment, error) {
+	var result struct {
+		UserSegment UserSegment `json:"user_segment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", segmentID))
+	if err != nil {
+		return UserSegment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserSegment{}, err
+	}
+	return result.UserSegment, nil
+}
+
+// CreateUserSegment creates a new user segment
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#create-user-segment
+func (z *Client) CreateUserSegment(ctx context.Context, segment UserSegment) (UserSegment, error) {
+	var data, result struct {
+		UserSegment UserSegment `json:"user_segment"`
+	}
+	data.UserSegment = segment
+
+	body, err := z.post(ctx, "/help_center/user_segments.json", data)
+	if err != nil {
+		return UserSegment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserSegment{}, err
+	}
+	return result.UserSegment, nil
+}
+
+// UpdateUserSegment updates the user segment
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#update-user-segment
+func (z *Client) UpdateUserSegment(ctx context.Context, segmentID int64, segment UserSegment) (UserSegment, error) {
+	var data, result struct {
+		UserSegment UserSegment `json:"user_segment"`
+	}
+	data.UserSegment = segment
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", segmentID), data)
+	if err != nil {
+		return UserSegment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserSegment{}, err
+	}
+	return result.UserSegment, nil
+}
+
+// DeleteUserSegment deletes the user segment
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#delete-user-segment
+func (z *Client) DeleteUserSegment(ctx context.Context, segmentID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", segmentID))
+}
@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserSegments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_segments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	segments, _, err := client.GetUserSegments(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get user segments: %s", err)
+	}
+	if len(segments) != 2 || segments[0].UserType != UserSegmentUserTypeStaff || !segments[1].BuiltIn {
+		t.Fatalf("unexpected user segments %v", segments)
+	}
+}
+
+func TestCreateUserSegment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			UserSegment map[string]interface{} `json:"user_segment"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if payload.UserSegment["user_type"] != UserSegmentUserTypeSignedIn {
+			t.Fatalf("unexpected payload %v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/user_segment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	segment, err := client.CreateUserSegment(ctx, UserSegment{
+		Name:            "Partners",
+		UserType:        UserSegmentUserTypeSignedIn,
+		OrganizationIDs: []int64{361},
+		OrTags:          []string{"partner", "reseller"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user segment: %s", err)
+	}
+	if segment.ID != 7286 {
+		t.Fatalf("unexpected user segment %v", segment)
+	}
+}
+
+func TestDeleteUserSegment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/user_segments/7286.json" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserSegment(ctx, 7286)
+	if err != nil {
+		t.Fatalf("Failed to delete user segment: %s", err)
+	}
+}
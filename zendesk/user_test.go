@@ -1,9 +1,12 @@
 package zendesk
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -30,6 +33,25 @@ func TestGetUsers(t *testing.T) {
 	}
 }
 
+func TestGetUsersCursor(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page[size]") != "10" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"users": [{"id": 1}], "meta": {"has_more": false}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, meta, err := client.GetUsersCursor(ctx, &CursorPagination{PageSize: 10})
+	if err != nil {
+		t.Fatalf("Failed to get users: %s", err)
+	}
+	if len(users) != 1 || meta.HasMore {
+		t.Fatalf("unexpected users %v, meta %v", users, meta)
+	}
+}
+
 func TestGetManyUsers(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "users.json")
 	client := newTestClient(mockAPI)
@@ -176,6 +198,71 @@ func TestCreateOrUpdateUserFailure(t *testing.T) {
 	}
 }
 
+func TestCreateUserWithIdentities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			User map[string]interface{} `json:"user"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request: %s", err)
+		}
+		if payload.User["skip_verify_email"] != true || payload.User["verified"] != true {
+			t.Fatalf("verification options were not sent: %v", payload.User)
+		}
+		identities, _ := payload.User["identities"].([]interface{})
+		if len(identities) != 2 {
+			t.Fatalf("expected 2 identities, but got %v", payload.User["identities"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateUser(ctx, User{
+		Name:            "testuser",
+		Verified:        true,
+		SkipVerifyEmail: true,
+		Identities: []UserIdentity{
+			{Type: UserIdentityTypeEmail, Value: "test@example.com"},
+			{Type: UserIdentityTypePhoneNumber, Value: "+15555551234"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create user: %s", err)
+	}
+}
+
+func TestCreateOrUpdateUserByEmail(t *testing.T) {
+	for _, status := range []int{http.StatusCreated, http.StatusOK} {
+		mockAPI := newMockAPIWithStatus(http.MethodPost, "users.json", status)
+		client := newTestClient(mockAPI)
+
+		user, created, err := client.CreateOrUpdateUserByEmail(ctx, User{
+			Email: "test@example.com",
+			Name:  "testuser",
+		})
+		mockAPI.Close()
+		if err != nil {
+			t.Fatalf("Failed to create or update user: %s", err)
+		}
+		if user.ID == 0 {
+			t.Fatal("Failed to create or update user")
+		}
+		if created != (status == http.StatusCreated) {
+			t.Fatalf("created is %v for status %d", created, status)
+		}
+	}
+}
+
+func TestCreateOrUpdateUserByEmailWithoutEmail(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, _, err := client.CreateOrUpdateUserByEmail(ctx, User{Name: "testuser"}); err == nil {
+		t.Fatal("Client did not return error for user without email")
+	}
+}
+
 func TestUpdateUser(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPut, "user.json", http.StatusOK)
 	client := newTestClient(mockAPI)
@@ -218,3 +305,49 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestGetUserWorkload(t *testing.T) {
+	counts := map[string]int{
+		"type:ticket assignee:369531345753 status:open":    3,
+		"type:ticket assignee:369531345753 status:pending": 1,
+		"type:ticket assignee:369531345753 status:hold":    0,
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/369531345753/related.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "user_related.json")))
+		case "/search/count.json":
+			count, ok := counts[r.URL.Query().Get("query")]
+			if !ok {
+				t.Fatalf("unexpected query %s", r.URL.Query().Get("query"))
+			}
+			fmt.Fprintf(w, `{"count": %d}`, count)
+		default:
+			t.Fatalf("unexpected request %s", r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	workload, err := client.GetUserWorkload(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to get user workload: %s", err)
+	}
+	if workload.AssignedTickets != 5 || workload.AssignedByStatus[TicketStatusPending] != 1 || workload.UnsolvedAssignedTickets() != 4 {
+		t.Fatalf("unexpected workload %v", workload)
+	}
+}
+
+func TestSetUserPhoto(t *testing.T) {
+	mockAPI := newMockUploadAPI(t, "/users/369531345753.json", "user", "photo", "user.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.SetUserPhoto(ctx, 369531345753, "photo.png", strings.NewReader("png"))
+	if err != nil {
+		t.Fatalf("Failed to set user photo: %s", err)
+	}
+	if user.ID != 369531345753 {
+		t.Fatalf("unexpected user %v", user)
+	}
+}
@@ -89,3 +89,129 @@ var viaTypeText = map[int]string{
 func ViaTypeText(viaID int) string {
 	return viaTypeText[viaID]
 }
+
+// Channels of Via
+//
+// https://developer.zendesk.com/documentation/ticketing/reference-guides/via-object-reference/
+const (
+	ViaChannelWeb             = "web"
+	ViaChannelEmail           = "email"
+	ViaChannelAPI             = "api"
+	ViaChannelRule            = "rule"
+	ViaChannelVoice           = "voice"
+	ViaChannelChat            = "chat"
+	ViaChannelSMS             = "sms"
+	ViaChannelTwitter         = "twitter"
+	ViaChannelFacebook        = "facebook"
+	ViaChannelMobileSDK       = "mobile_sdk"
+	ViaChannelMobile          = "mobile"
+	ViaChannelWebWidget       = "web_widget"
+	ViaChannelHelpCenter      = "help_center"
+	ViaChannelAnyChannel      = "any_channel"
+	ViaChannelNativeMessaging = "native_messaging"
+	ViaChannelClosedTicket    = "closed_ticket"
+	ViaChannelTicketSharing   = "ticket_sharing"
+	ViaChannelSystem          = "system"
+)
+
+// Rels of ViaSource
+const (
+	// ViaRelFollowUp is the rel of ticket created as follow-up of closed ticket
+	ViaRelFollowUp = "follow_up"
+	// ViaRelTrigger is the rel of change made by trigger
+	ViaRelTrigger = "trigger"
+	// ViaRelAutomation is the rel of change made by automation
+	ViaRelAutomation = "automation"
+	// ViaRelMerge is the rel of comment added by merging tickets
+	ViaRelMerge = "merge"
+)
+
+// IsEmail reports whether the channel is email
+func (v *Via) IsEmail() bool {
+	return v.channel() == ViaChannelEmail
+}
+
+// IsAPI reports whether the channel is API
+func (v *Via) IsAPI() bool {
+	return v.channel() == ViaChannelAPI
+}
+
+// IsWeb reports whether the channel is web form, Help Center or web widget
+func (v *Via) IsWeb() bool {
+	switch v.channel() {
+	case ViaChannelWeb, ViaChannelHelpCenter, ViaChannelWebWidget:
+		return true
+	}
+	return false
+}
+
+// IsVoice reports whether the channel is phone call or voicemail
+func (v *Via) IsVoice() bool {
+	return v.channel() == ViaChannelVoice
+}
+
+// IsSocial reports whether the channel is a social network
+func (v *Via) IsSocial() bool {
+	switch v.channel() {
+	case ViaChannelTwitter, ViaChannelFacebook:
+		return true
+	}
+	return false
+}
+
+// IsMessaging reports whether the channel is chat, SMS, messaging or channel integrations
+func (v *Via) IsMessaging() bool {
+	switch v.channel() {
+	case ViaChannelChat, ViaChannelSMS, ViaChannelNativeMessaging, ViaChannelAnyChannel:
+		return true
+	}
+	return false
+}
+
+// IsRule reports whether the change was made by trigger or automation
+func (v *Via) IsRule() bool {
+	return v.channel() == ViaChannelRule
+}
+
+// IsFollowUp reports whether the ticket was created as follow-up of closed ticket
+func (v *Via) IsFollowUp() bool {
+	return v != nil && v.Source.Rel == ViaRelFollowUp
+}
+
+// channel returns the channel, or empty string if v is nil
+func (v *Via) channel() string {
+	if v == nil {
+		return ""
+	}
+	return v.Channel
+}
+
+// IsFromEmail reports whether the ticket was created by email
+func (t Ticket) IsFromEmail() bool {
+	return t.Via.IsEmail()
+}
+
+// IsFromAPI reports whether the ticket was created with API
+func (t Ticket) IsFromAPI() bool {
+	return t.Via.IsAPI()
+}
+
+// IsFromWeb reports whether the ticket was created with web form, Help Center or web widget
+func (t Ticket) IsFromWeb() bool {
+	return t.Via.IsWeb()
+}
+
+// IsFromVoice reports whether the ticket was created by phone call or voicemail
+func (t Ticket) IsFromVoice() bool {
+	return t.Via.IsVoice()
+}
+
+// IsFromSocial reports whether the ticket was created from a social network
+func (t Ticket) IsFromSocial() bool {
+	return t.Via.IsSocial()
+}
+
+// IsFromMessaging reports whether the ticket was created from chat, SMS, messaging or channel integrations
+func (t Ticket) IsFromMessaging() bool {
+	return t.Via.IsMessaging()
+}
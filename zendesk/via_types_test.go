@@ -1,9 +1,48 @@
 package zendesk
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestViaTypeText(t *testing.T) {
 	if viaType := ViaTypeText(ViaWebForm); viaType != "web_form" {
 		t.Fatal(`expect "web_form", but got "` + viaType + `"`)
 	}
 }
+
+func TestViaChannel(t *testing.T) {
+	var ticket Ticket
+	if ticket.IsFromEmail() || ticket.IsFromAPI() || ticket.Via.IsFollowUp() {
+		t.Fatal("expected ticket without via to have no channel")
+	}
+
+	err := json.Unmarshal([]byte(`{
+		"via": {
+			"channel": "twitter",
+			"source": {
+				"from": {"name": "Jane", "username": "jane", "profile_url": "https://twitter.com/jane", "twitter_id": "8675309"},
+				"to": {"name": "Support", "username": "support"},
+				"rel": "mention"
+			}
+		}
+	}`), &ticket)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal ticket: %s", err)
+	}
+	if !ticket.IsFromSocial() || ticket.IsFromEmail() || ticket.IsFromMessaging() {
+		t.Fatalf("unexpected channel %s", ticket.Via.Channel)
+	}
+	if from := ticket.Via.Source.FromParty(); from.Username != "jane" || from.TwitterID != "8675309" {
+		t.Fatalf("unexpected from %v", from)
+	}
+
+	via := &Via{Channel: ViaChannelHelpCenter}
+	if !via.IsWeb() {
+		t.Fatal("expected help center to be web")
+	}
+	via = &Via{Channel: ViaChannelWeb, Source: ViaSource{Rel: ViaRelFollowUp}}
+	if !via.IsFollowUp() {
+		t.Fatal("expected follow-up")
+	}
+}
@@ -24,23 +24,62 @@ type (
 		// Restriction Restriction
 	}
 
+	// ViewCount is the ticket count of a view.
+	// Zendesk caches the counts, and Fresh is false while the cached value
+	// is being recalculated. Value is nil if the count has never been calculated.
+	// https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-view
+	ViewCount struct {
+		ViewID int64  `json:"view_id"`
+		URL    string `json:"url"`
+		Value  *int64 `json:"value"`
+		Pretty string `json:"pretty"`
+		Fresh  bool   `json:"fresh"`
+	}
+
+	// ViewCountOptions are the options of GetViewCounts
+	ViewCountOptions struct {
+		// WaitFresh makes GetViewCounts poll the counts until all of them are fresh.
+		// If ctx is done while polling, the latest stale counts are returned with the error.
+		WaitFresh bool
+		// PollInterval is the interval of polling. The default is 1 second.
+		PollInterval time.Duration
+	}
+
+	// ViewListOptions is options for GetViewsWithOptions
+	ViewListOptions struct {
+		PageOptions
+	}
+
 	// ViewAPI encapsulates methods on view
 	ViewAPI interface {
 		GetView(context.Context, int64) (View, error)
 		GetViews(context.Context) ([]View, Page, error)
+		GetViewsWithOptions(context.Context, *ViewListOptions) ([]View, Page, error)
 		GetTicketsFromView(context.Context, int64) ([]Ticket, error)
+		GetViewCount(ctx context.Context, viewID int64) (ViewCount, error)
+		GetViewCounts(ctx context.Context, viewIDs []int64, opts *ViewCountOptions) ([]ViewCount, error)
 	}
 )
 
 // GetViews gets all views
 // ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-views
 func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, PageOptions{})
+}
+
+// getViews fetches the page of views of opts
+func (z *Client) getViews(ctx context.Context, opts PageOptions) ([]View, Page, error) {
 	var result struct {
 		Views []View `json:"views"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/views.json")
+	u, err := addOptions("/views.json", opts)
+	if err != nil {
+		return []View{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
 
 	if err != nil {
 		return []View{}, Page{}, err
@@ -53,6 +92,22 @@ func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
 	return result.Views, result.Page, nil
 }
 
+// GetViewsWithOptions gets the page of views of opts, while GetViews gets
+// only the first page
+func (z *Client) GetViewsWithOptions(ctx context.Context, opts *ViewListOptions) ([]View, Page, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewListOptions{}
+	}
+	return z.getViews(ctx, tmp.PageOptions)
+}
+
+// GetViewsPage fetches the page of views at token as ListPage.
+// The zero PageToken is the first page.
+func (z *Client) GetViewsPage(ctx context.Context, token PageToken) (ListPage[View], error) {
+	return OffsetLister(z.getViews)(ctx, token)
+}
+
 // GetView gets a given view
 // ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#show-view
 func (z *Client) GetView(ctx context.Context, viewID int64) (View, error) {
@@ -92,3 +147,75 @@ func (z *Client) GetTicketsFromView(ctx context.Context, viewID int64) ([]Ticket
 
 	return result.Tickets, nil
 }
+
+// GetViewCount gets the ticket count of the view, which may be a cached value
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-view
+func (z *Client) GetViewCount(ctx context.Context, viewID int64) (ViewCount, error) {
+	var result struct {
+		ViewCount ViewCount `json:"view_count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/count.json", viewID))
+
+	if err != nil {
+		return ViewCount{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewCount{}, err
+	}
+
+	return result.ViewCount, nil
+}
+
+// GetViewCounts gets the ticket counts of up to 20 views.
+// By default the cached counts are returned as they are, and opts.WaitFresh
+// makes it poll until all the counts are fresh.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-views
+func (z *Client) GetViewCounts(ctx context.Context, viewIDs []int64, opts *ViewCountOptions) ([]ViewCount, error) {
+	if opts == nil {
+		opts = &ViewCountOptions{}
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	for {
+		counts, err := z.getViewCounts(ctx, viewIDs)
+		if err != nil || !opts.WaitFresh || allFresh(counts) {
+			return counts, err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return counts, err
+		}
+	}
+}
+
+func (z *Client) getViewCounts(ctx context.Context, viewIDs []int64) ([]ViewCount, error) {
+	var result struct {
+		ViewCounts []ViewCount `json:"view_counts"`
+	}
+
+	body, err := z.get(ctx, "/views/count_many.json?ids="+joinIDs(viewIDs))
+
+	if err != nil {
+		return []ViewCount{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return []ViewCount{}, err
+	}
+
+	return result.ViewCounts, nil
+}
+
+func allFresh(counts []ViewCount) bool {
+	for _, c := range counts {
+		if !c.Fresh {
+			return false
+		}
+	}
+	return true
+}
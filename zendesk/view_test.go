@@ -1,8 +1,12 @@
 package zendesk
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestGetView(t *testing.T) {
@@ -35,3 +39,104 @@ func TestGetViews(t *testing.T) {
 		t.Fatalf("expected length of views is 2, but got %d", len(views))
 	}
 }
+
+func TestGetViewsWithOptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "2" || r.URL.Query().Get("per_page") != "2" {
+			t.Fatalf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetViewsWithOptions(ctx, &ViewListOptions{
+		PageOptions: PageOptions{Page: 2, PerPage: 2},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get views: %s", err)
+	}
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestGetViewCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetViewCount(ctx, 25)
+	if err != nil {
+		t.Fatalf("Failed to get view count: %s", err)
+	}
+
+	if count.Value == nil || *count.Value != 719 || !count.Fresh {
+		t.Fatalf("unexpected view count %v", count)
+	}
+}
+
+func TestGetViewCounts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_counts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	counts, err := client.GetViewCounts(ctx, []int64{25, 78}, nil)
+	if err != nil {
+		t.Fatalf("Failed to get view counts: %s", err)
+	}
+
+	if len(counts) != 2 || counts[1].Fresh || counts[1].Value != nil {
+		t.Fatalf("expected cached counts, but got %v", counts)
+	}
+}
+
+func TestGetViewCountsWaitFresh(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ids") != "25,78" {
+			t.Fatalf("unexpected ids %s", r.URL.Query().Get("ids"))
+		}
+
+		requests++
+		if requests < 3 {
+			w.Write(readFixture(filepath.Join(http.MethodGet, "view_counts.json")))
+			return
+		}
+		w.Write([]byte(`{"view_counts":[{"view_id":25,"value":719,"fresh":true},{"view_id":78,"value":3,"fresh":true}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	counts, err := client.GetViewCounts(ctx, []int64{25, 78}, &ViewCountOptions{
+		WaitFresh:    true,
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get view counts: %s", err)
+	}
+
+	if requests != 3 || !counts[1].Fresh || *counts[1].Value != 3 {
+		t.Fatalf("expected fresh counts after 3 requests, but got %v after %d", counts, requests)
+	}
+}
+
+func TestGetViewCountsWaitFreshCanceled(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_counts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	timeout, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	counts, err := client.GetViewCounts(timeout, []int64{25, 78}, &ViewCountOptions{
+		WaitFresh:    true,
+		PollInterval: time.Minute,
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, but got %v", err)
+	}
+	if len(counts) != 2 {
+		t.Fatalf("expected stale counts, but got %v", counts)
+	}
+}
@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+)
+
+// WatcherMode is how users are added to tickets to be notified of updates,
+// which depends on whether the account has migrated from collaborators to
+// CCs and followers
+type WatcherMode string
+
+const (
+	// WatcherModeCollaborators adds the user as a collaborator of the legacy CC experience
+	WatcherModeCollaborators WatcherMode = "collaborators"
+	// WatcherModeFollowers adds the agent as a follower
+	WatcherModeFollowers WatcherMode = "followers"
+	// WatcherModeEmailCCs adds the user as an email CC
+	WatcherModeEmailCCs WatcherMode = "email_ccs"
+)
+
+// Watcher is a user to be notified of the updates of a ticket. The user is
+// identified by UserID or Email, and Name is used when a new user is created.
+// Agent must be true for agents because only agents can be followers.
+type Watcher struct {
+	UserID int64
+	Email  string
+	Name   string
+	Agent  bool
+}
+
+// WatcherMode returns how the watcher is added to tickets under the settings.
+// Agents become followers when followers are enabled, and the others become
+// email CCs when CCs are enabled. Collaborators are used when neither is
+// enabled, i.e. the account uses the legacy CC experience. It returns an error
+// if the watcher is an end user and only followers are enabled.
+func (s CCsFollowersSettings) WatcherMode(w Watcher) (WatcherMode, error) {
+	switch {
+	case w.Agent && s.FollowersEnabled:
+		return WatcherModeFollowers, nil
+	case s.EmailCCsEnabled:
+		return WatcherModeEmailCCs, nil
+	case s.FollowersEnabled:
+		return "", errors.New("zendesk: end users cannot follow tickets while email CCs are disabled")
+	}
+	return WatcherModeCollaborators, nil
+}
+
+// AddWatchers sets the watchers to the ticket to update in the way the settings allow
+func (s CCsFollowersSettings) AddWatchers(t *Ticket, watchers ...Watcher) error {
+	for _, w := range watchers {
+		mode, err := s.WatcherMode(w)
+		if err != nil {
+			return err
+		}
+
+		switch mode {
+		case WatcherModeFollowers:
+			t.Followers = append(t.Followers, Follower{UserID: w.UserID, UserEmail: w.Email, Action: CCActionPut})
+		case WatcherModeEmailCCs:
+			t.EmailCCs = append(t.EmailCCs, EmailCC{UserID: w.UserID, UserEmail: w.Email, UserName: w.Name, Action: CCActionPut})
+		case WatcherModeCollaborators:
+			if t.AdditionalCollaborators == nil {
+				t.AdditionalCollaborators = &Collaborators{}
+			}
+			var collaborator interface{} = Collaborator{Name: w.Name, Email: w.Email}
+			if w.UserID != 0 {
+				collaborator = w.UserID
+			} else if w.Name == "" {
+				collaborator = w.Email
+			}
+			if err := t.AdditionalCollaborators.Append(collaborator); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WatcherAPI an interface containing the methods adding watchers to tickets
+type WatcherAPI interface {
+	AddTicketWatchers(ctx context.Context, ticketID int64, watchers ...Watcher) (Ticket, error)
+}
+
+// AddTicketWatchers adds the watchers to the ticket as followers, email CCs
+// or collaborators depending on the CCs and followers settings of the account
+func (z *Client) AddTicketWatchers(ctx context.Context, ticketID int64, watchers ...Watcher) (Ticket, error) {
+	settings, err := z.GetCCsFollowersSettings(ctx)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	var update Ticket
+	if err := settings.AddWatchers(&update, watchers...); err != nil {
+		return Ticket{}, err
+	}
+	return z.UpdateTicket(ctx, ticketID, update)
+}
@@ -0,0 +1,78 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCCsFollowersSettingsWatcherMode(t *testing.T) {
+	agent := Watcher{UserID: 1, Agent: true}
+	endUser := Watcher{Email: "a@example.com"}
+
+	cases := []struct {
+		settings CCsFollowersSettings
+		agent    WatcherMode
+		endUser  WatcherMode
+	}{
+		{CCsFollowersSettings{}, WatcherModeCollaborators, WatcherModeCollaborators},
+		{CCsFollowersSettings{EmailCCsEnabled: true, FollowersEnabled: true}, WatcherModeFollowers, WatcherModeEmailCCs},
+		{CCsFollowersSettings{EmailCCsEnabled: true}, WatcherModeEmailCCs, WatcherModeEmailCCs},
+	}
+	for _, c := range cases {
+		if mode, err := c.settings.WatcherMode(agent); err != nil || mode != c.agent {
+			t.Fatalf("unexpected mode of agent under %+v: %s %v", c.settings, mode, err)
+		}
+		if mode, err := c.settings.WatcherMode(endUser); err != nil || mode != c.endUser {
+			t.Fatalf("unexpected mode of end user under %+v: %s %v", c.settings, mode, err)
+		}
+	}
+
+	if _, err := (CCsFollowersSettings{FollowersEnabled: true}).WatcherMode(endUser); err == nil {
+		t.Fatal("expected error for end user follower")
+	}
+
+	var ticket Ticket
+	if err := (CCsFollowersSettings{}).AddWatchers(&ticket, agent, endUser); err != nil {
+		t.Fatal(err)
+	}
+	if list := ticket.AdditionalCollaborators.List(); len(list) != 2 || list[0] != int64(1) || list[1] != "a@example.com" {
+		t.Fatalf("unexpected collaborators %v", list)
+	}
+}
+
+func TestAddTicketWatchers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/account/settings.json":
+			w.Write([]byte(`{"settings": {"ccs_followers": {"email_ccs_enabled": true, "followers_enabled": true}}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/tickets/1.json":
+			body, _ := io.ReadAll(r.Body)
+			var data struct {
+				Ticket Ticket `json:"ticket"`
+			}
+			if err := json.Unmarshal(body, &data); err != nil {
+				t.Fatal(err)
+			}
+			if len(data.Ticket.Followers) != 1 || data.Ticket.Followers[0].UserID != 2 ||
+				len(data.Ticket.EmailCCs) != 1 || data.Ticket.EmailCCs[0].UserEmail != "a@example.com" {
+				t.Fatalf("unexpected update %s", body)
+			}
+			w.Write([]byte(`{"ticket": {"id": 1, "follower_ids": [2]}}`))
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.AddTicketWatchers(ctx, 1, Watcher{UserID: 2, Agent: true}, Watcher{Email: "a@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to add watchers: %s", err)
+	}
+	if len(ticket.FollowerIDs) != 1 {
+		t.Fatalf("unexpected ticket %v", ticket)
+	}
+}
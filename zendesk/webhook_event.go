@@ -0,0 +1,180 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// WebhookEvent is the payload of event-subscribed webhooks parsed by ParseWebhookEvent.
+// The concrete type is one of *TicketEvent, *UserEvent, *OrganizationEvent,
+// *AgentAvailabilityEvent or *UnknownEvent.
+//
+// ref: https://developer.zendesk.com/api-reference/webhooks/event-types/webhook-event-types/
+type WebhookEvent interface {
+	EventHeader() WebhookEventHeader
+}
+
+// WebhookEventHeader is the members common to all webhook events
+type WebhookEventHeader struct {
+	ID                  string `json:"id"`
+	Type                string `json:"type"`
+	AccountID           int64  `json:"account_id"`
+	Subject             string `json:"subject"`
+	Time                Time   `json:"time"`
+	ZendeskEventVersion string `json:"zendesk_event_version"`
+}
+
+// EventHeader implements WebhookEvent
+func (h WebhookEventHeader) EventHeader() WebhookEventHeader {
+	return h
+}
+
+// Name returns the event name without prefix, e.g. "ticket.status_changed"
+func (h WebhookEventHeader) Name() string {
+	return strings.TrimPrefix(h.Type, "zen:event-type:")
+}
+
+// EventChange is the event member of the events which change a value
+type EventChange struct {
+	Current  json.RawMessage `json:"current"`
+	Previous json.RawMessage `json:"previous"`
+}
+
+// TicketEventDetail is the ticket in ticket events.
+// IDs are strings and Status and Priority are in upper case as they are in the payload.
+type TicketEventDetail struct {
+	ID             string   `json:"id"`
+	ActorID        string   `json:"actor_id"`
+	AssigneeID     string   `json:"assignee_id"`
+	BrandID        string   `json:"brand_id"`
+	CreatedAt      Time     `json:"created_at"`
+	CustomStatus   string   `json:"custom_status"`
+	Description    string   `json:"description"`
+	ExternalID     string   `json:"external_id"`
+	FormID         string   `json:"form_id"`
+	GroupID        string   `json:"group_id"`
+	IsPublic       bool     `json:"is_public"`
+	OrganizationID string   `json:"organization_id"`
+	Priority       string   `json:"priority"`
+	RequesterID    string   `json:"requester_id"`
+	Status         string   `json:"status"`
+	Subject        string   `json:"subject"`
+	SubmitterID    string   `json:"submitter_id"`
+	Tags           []string `json:"tags"`
+	Type           string   `json:"type"`
+	UpdatedAt      Time     `json:"updated_at"`
+	Via            struct {
+		Channel string `json:"channel"`
+	} `json:"via"`
+}
+
+// TicketEvent is ticket event, e.g. zen:event-type:ticket.status_changed.
+// Event is the change or the added item such as a comment, which depends on Type.
+type TicketEvent struct {
+	WebhookEventHeader
+	Detail TicketEventDetail `json:"detail"`
+	Event  json.RawMessage   `json:"event"`
+}
+
+// Change decodes Event of the events which change a value
+func (e *TicketEvent) Change() (EventChange, error) {
+	var change EventChange
+	err := json.Unmarshal(e.Event, &change)
+	return change, err
+}
+
+// UserEventDetail is the user in user events
+type UserEventDetail struct {
+	ID             string `json:"id"`
+	Email          string `json:"email"`
+	ExternalID     string `json:"external_id"`
+	DefaultGroupID string `json:"default_group_id"`
+	OrganizationID string `json:"organization_id"`
+	Role           string `json:"role"`
+	CreatedAt      Time   `json:"created_at"`
+	UpdatedAt      Time   `json:"updated_at"`
+}
+
+// UserEvent is user event, e.g. zen:event-type:user.created
+type UserEvent struct {
+	WebhookEventHeader
+	Detail UserEventDetail `json:"detail"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// OrganizationEventDetail is the organization in organization events
+type OrganizationEventDetail struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ExternalID string `json:"external_id"`
+	GroupID    string `json:"group_id"`
+	CreatedAt  Time   `json:"created_at"`
+	UpdatedAt  Time   `json:"updated_at"`
+}
+
+// OrganizationEvent is organization event, e.g. zen:event-type:organization.created
+type OrganizationEvent struct {
+	WebhookEventHeader
+	Detail OrganizationEventDetail `json:"detail"`
+	Event  json.RawMessage         `json:"event"`
+}
+
+// AgentAvailabilityEventDetail is the agent in agent availability events
+type AgentAvailabilityEventDetail struct {
+	AccountID string `json:"account_id"`
+	AgentID   string `json:"agent_id"`
+	Version   string `json:"version"`
+}
+
+// AgentAvailabilityEvent is agent availability event, e.g. zen:event-type:agent.channel_status_changed
+type AgentAvailabilityEvent struct {
+	WebhookEventHeader
+	Detail AgentAvailabilityEventDetail `json:"detail"`
+	Event  json.RawMessage              `json:"event"`
+}
+
+// UnknownEvent is the event of type which this package does not model
+type UnknownEvent struct {
+	WebhookEventHeader
+	Detail json.RawMessage `json:"detail"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// ParseWebhookEvent parses the request body of event-subscribed webhook
+// into the struct of the event type. Verify the signature of the request
+// before parsing it.
+//
+//	event, err := zendesk.ParseWebhookEvent(body)
+//	switch e := event.(type) {
+//	case *zendesk.TicketEvent:
+//		log.Println(e.Name(), e.Detail.ID)
+//	}
+func ParseWebhookEvent(body []byte) (WebhookEvent, error) {
+	var header WebhookEventHeader
+	if err := json.Unmarshal(body, &header); err != nil {
+		return nil, err
+	}
+	if header.Type == "" {
+		return nil, fmt.Errorf("webhook event does not have type")
+	}
+
+	var event WebhookEvent
+	switch strings.SplitN(header.Name(), ".", 2)[0] {
+	case "ticket":
+		event = &TicketEvent{}
+	case "user":
+		event = &UserEvent{}
+	case "organization":
+		event = &OrganizationEvent{}
+	case "agent":
+		event = &AgentAvailabilityEvent{}
+	default:
+		event = &UnknownEvent{}
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("invalid %s event: %w", header.Name(), err)
+	}
+	return event, nil
+}
@@ -0,0 +1,75 @@
+package zendesk
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseWebhookEventTicket(t *testing.T) {
+	body := []byte(`{
+		"type": "zen:event-type:ticket.status_changed",
+		"account_id": 123,
+		"id": "cbe4028c-7239-495d-b020-f22348516046",
+		"time": "2024-05-15T12:00:00Z",
+		"zendesk_event_version": "2022-11-06",
+		"subject": "zen:ticket:35436",
+		"detail": {"id": "35436", "status": "OPEN", "tags": ["vip"], "via": {"channel": "web_form"}, "created_at": "2024-05-15T11:00:00Z"},
+		"event": {"current": "OPEN", "previous": "NEW"}
+	}`)
+
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		t.Fatalf("Failed to parse event: %s", err)
+	}
+
+	ticketEvent, ok := event.(*TicketEvent)
+	if !ok {
+		t.Fatalf("expected TicketEvent, but got %T", event)
+	}
+	if ticketEvent.Name() != "ticket.status_changed" || ticketEvent.AccountID != 123 {
+		t.Fatalf("unexpected header %v", ticketEvent.EventHeader())
+	}
+	if ticketEvent.Detail.ID != "35436" || ticketEvent.Detail.Via.Channel != "web_form" {
+		t.Fatalf("unexpected detail %v", ticketEvent.Detail)
+	}
+
+	change, err := ticketEvent.Change()
+	if err != nil {
+		t.Fatalf("Failed to decode change: %s", err)
+	}
+	if string(change.Current) != `"OPEN"` || string(change.Previous) != `"NEW"` {
+		t.Fatalf("unexpected change %s -> %s", change.Previous, change.Current)
+	}
+}
+
+func TestParseWebhookEventTypes(t *testing.T) {
+	cases := map[string]string{
+		"zen:event-type:user.created":                 "*zendesk.UserEvent",
+		"zen:event-type:organization.created":         "*zendesk.OrganizationEvent",
+		"zen:event-type:agent.channel_status_changed": "*zendesk.AgentAvailabilityEvent",
+		"zen:event-type:article.published":            "*zendesk.UnknownEvent",
+	}
+
+	for typ, expected := range cases {
+		event, err := ParseWebhookEvent([]byte(`{"type":"` + typ + `","detail":{"id":"1"}}`))
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %s", typ, err)
+		}
+		if got := typeName(event); got != expected {
+			t.Fatalf("expected %s for %s, but got %s", expected, typ, got)
+		}
+	}
+}
+
+func TestParseWebhookEventInvalid(t *testing.T) {
+	if _, err := ParseWebhookEvent([]byte(`{"detail":{}}`)); err == nil {
+		t.Fatal("event without type should cause an error")
+	}
+	if _, err := ParseWebhookEvent([]byte(`not json`)); err == nil {
+		t.Fatal("invalid json should cause an error")
+	}
+}
+
+func typeName(v interface{}) string {
+	return fmt.Sprintf("%T", v)
+}
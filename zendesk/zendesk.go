@@ -6,35 +6,55 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"regexp"
-	"strconv"
 	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
-	baseURLFormat = "https://%s.zendesk.com/api/v2"
+	baseURLFormat = "https://%s.zendesk.com/api/%s"
+
+	// DefaultAPIVersion is the version of Zendesk API used unless SetAPIVersion is called
+	DefaultAPIVersion = "v2"
 )
 
 var defaultHeaders = map[string]string{
-	"User-Agent":   "nukosuke/go-zendesk",
 	"Content-Type": "application/json",
 }
 
 var subdomainRegexp = regexp.MustCompile("^[a-z0-9][a-z0-9-]+[a-z0-9]$")
 
+var apiVersionRegexp = regexp.MustCompile("^v[0-9]+[a-z0-9]*$")
+
 type (
 	// Client of Zendesk API
 	Client struct {
 		baseURL    *url.URL
+		subdomain  string
+		apiVersion string
 		httpClient *http.Client
 		credential Credential
 		headers    map[string]string
 		maxSleep   time.Duration
 		maxRetry   int
+		compress   bool
+		readOnly   bool
+		cache      Cache
+
+		retryPolicy     RetryPolicy
+		retryObserver   func(RetryStats)
+		networkRetry    int
+		networkBackoff  time.Duration
+		breaker         *circuitBreaker
+		queue           *requestQueue
+		dryRun          *DryRunLog
+		maxResponseSize int64
+
+		capabilities capabilityCache
 	}
 
 	// BaseAPI encapsulates base methods for zendesk client
@@ -81,10 +101,13 @@ func NewClient(httpClient *http.Client) (*Client, error) {
 
 	client := &Client{
 		httpClient: httpClient,
-		maxSleep:   5 * time.Second,
 		maxRetry:   3,
+		compress:   true,
+	}
+	client.headers = map[string]string{"User-Agent": userAgent("")}
+	for key, value := range defaultHeaders {
+		client.headers[key] = value
 	}
-	client.headers = defaultHeaders
 	return client, nil
 }
 
@@ -100,16 +123,39 @@ func (z *Client) SetSubdomain(subdomain string) error {
 		return fmt.Errorf("%s is invalid subdomain", subdomain)
 	}
 
-	baseURLString := fmt.Sprintf(baseURLFormat, subdomain)
-	baseURL, err := url.Parse(baseURLString)
+	baseURL, err := url.Parse(fmt.Sprintf(baseURLFormat, subdomain, z.currentAPIVersion()))
 	if err != nil {
 		return err
 	}
 
 	z.baseURL = baseURL
+	z.subdomain = subdomain
 	return nil
 }
 
+// SetAPIVersion sets the version of Zendesk API in the path of endpoints,
+// e.g. "v2" for https://{subdomain}.zendesk.com/api/v2. Defaults to DefaultAPIVersion.
+// It has no effect on the URL set with SetEndpointURL.
+func (z *Client) SetAPIVersion(version string) error {
+	if !apiVersionRegexp.MatchString(version) {
+		return fmt.Errorf("%s is invalid API version", version)
+	}
+
+	z.apiVersion = version
+	if z.subdomain != "" {
+		return z.SetSubdomain(z.subdomain)
+	}
+	return nil
+}
+
+// currentAPIVersion returns the version of Zendesk API used in the base URL
+func (z *Client) currentAPIVersion() string {
+	if z.apiVersion == "" {
+		return DefaultAPIVersion
+	}
+	return z.apiVersion
+}
+
 // SetEndpointURL replace full URL of endpoint without subdomain validation.
 // This is mainly used for testing to point to mock API server.
 func (z *Client) SetEndpointURL(newURL string) error {
@@ -119,6 +165,7 @@ func (z *Client) SetEndpointURL(newURL string) error {
 	}
 
 	z.baseURL = baseURL
+	z.subdomain = ""
 	return nil
 }
 
@@ -129,7 +176,8 @@ func (z *Client) SetCredential(cred Credential) {
 }
 
 // SetMaxRetrySleepDelay sets the maximum duration that a client will support sleeping
-// if an API call returns a 429 error. Defaults to 5 seconds if not set.
+// if an API call returns a 429 error. By default there is no limit, and the client
+// waits as long as Retry-After unless the context is done.
 func (z *Client) SetMaxRetrySleepDelay(duration time.Duration) {
 	z.maxSleep = duration
 }
@@ -142,8 +190,25 @@ func (z *Client) SetMaxRetry(retries int) {
 	}
 }
 
+// SetCompression enables or disables compression of API responses.
+// When enabled, the client requests gzip or deflate encoded responses and
+// decompresses them regardless of the transport configuration. Defaults to true.
+func (z *Client) SetCompression(enabled bool) {
+	z.compress = enabled
+}
+
+// SetReadOnly enables or disables read-only mode. In read-only mode, the methods
+// sending POST, PUT or DELETE requests fail with ErrReadOnly, which guarantees
+// that the client never modifies the account. Defaults to false.
+func (z *Client) SetReadOnly(readOnly bool) {
+	z.readOnly = readOnly
+}
+
 // get fetches JSON data from API and returns its body as []bytes
 func (z *Client) get(ctx context.Context, path string) ([]byte, error) {
+	if z.cache != nil {
+		return z.getWithCache(ctx, path)
+	}
 	return z.execRequest(ctx, path, http.MethodGet, nil, []int{http.StatusOK})
 }
 
@@ -153,7 +218,7 @@ func (z *Client) post(ctx context.Context, path string, data interface{}) ([]byt
 	if err != nil {
 		return nil, err
 	}
-	return z.execRequest(ctx, path, http.MethodPost, bytes.NewReader(jsonBytes), []int{http.StatusOK, http.StatusCreated})
+	return z.execRequest(ctx, path, http.MethodPost, jsonBytes, []int{http.StatusOK, http.StatusCreated})
 }
 
 // put sends data to API and returns response body as []bytes
@@ -162,7 +227,29 @@ func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte
 	if err != nil {
 		return nil, err
 	}
-	return z.execRequest(ctx, path, http.MethodPut, bytes.NewReader(jsonBytes), []int{http.StatusOK, http.StatusNoContent})
+	return z.execRequest(ctx, path, http.MethodPut, jsonBytes, []int{http.StatusOK, http.StatusNoContent})
+}
+
+// postMultipart uploads content as the file of multipart form field and returns response body as []bytes
+func (z *Client) postMultipart(ctx context.Context, path string, field string, filename string, content io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, err
+	}
+	if err := mw.WriteField("filename", filename); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	ctx = withRequestHeader(ctx, http.Header{"Content-Type": {mw.FormDataContentType()}})
+	return z.execRequest(ctx, path, http.MethodPost, buf.Bytes(), []int{http.StatusOK, http.StatusCreated})
 }
 
 // delete sends data to API and returns an error if unsuccessful
@@ -171,47 +258,104 @@ func (z *Client) delete(ctx context.Context, path string) error {
 	return err
 }
 
-func (z *Client) execRequest(ctx context.Context, path string, verb string, reqBody io.Reader, successCodes []int) ([]byte, error) {
-	var resp *http.Response
-	var body []byte
-	for attempts := 0; attempts < z.maxRetry; attempts++ {
-		req, err := http.NewRequest(verb, z.baseURL.String()+path, reqBody)
-		if err != nil {
-			return nil, err
+func (z *Client) execRequest(ctx context.Context, path string, verb string, reqBody []byte, successCodes []int) ([]byte, error) {
+	if z.readOnly && verb != http.MethodGet && verb != http.MethodHead {
+		return nil, ErrReadOnly
+	}
+	if body, ok := z.dryRunResponse(path, verb, reqBody); ok {
+		return body, nil
+	}
+
+	resp, err := z.doRequest(ctx, path, verb, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, code := range successCodes {
+		if resp.StatusCode == code {
+			return body, nil
 		}
+	}
 
-		req = z.prepareRequest(ctx, req)
-		resp, err = z.httpClient.Do(req)
-		if err != nil {
+	return nil, Error{
+		body: body,
+		resp: resp,
+	}
+}
+
+// doRequest sends request to API through the request queue and the circuit breaker
+// if enabled, and returns the response with its body unread. The caller must close the body.
+func (z *Client) doRequest(ctx context.Context, path string, verb string, reqBody []byte) (*http.Response, error) {
+	if q := z.queue; q != nil {
+		if err := q.acquire(ctx, requestPriorityFrom(ctx)); err != nil {
 			return nil, err
 		}
+		defer q.release()
+	}
+
+	if z.breaker == nil {
+		return z.sendRequest(ctx, path, verb, reqBody)
+	}
+
+	if err := z.breaker.allow(); err != nil {
+		return nil, err
+	}
+	resp, err := z.sendRequest(ctx, path, verb, reqBody)
+	z.breaker.record(ctx, resp, err)
+	return resp, err
+}
+
+// sendRequest sends request to API, retrying as decided by the retry policy
+func (z *Client) sendRequest(ctx context.Context, path string, verb string, reqBody []byte) (*http.Response, error) {
+	policy := z.currentRetryPolicy()
+	stats := RetryStats{Method: verb, Path: path}
+	defer func() { z.reportRetryStats(ctx, stats) }()
+
+	for attempts := 0; ; attempts++ {
+		var body io.Reader
+		if reqBody != nil {
+			body = bytes.NewReader(reqBody)
+		}
 
-		body, err = io.ReadAll(resp.Body)
-		_ = resp.Body.Close()
+		req, err := http.NewRequest(verb, z.baseURL.String()+path, body)
 		if err != nil {
 			return nil, err
 		}
 
-		if resp.StatusCode == http.StatusTooManyRequests && attempts < z.maxRetry {
-			retryStr := resp.Header.Get("Retry-After")
-			retrySec, _ := strconv.Atoi(retryStr)
-			if retrySec > 0 && time.Duration(retrySec) <= z.maxSleep {
-				time.Sleep(time.Duration(retrySec) * time.Second)
-				continue
+		req = z.prepareRequest(ctx, req)
+		if z.compress {
+			req.Header.Set("Accept-Encoding", "gzip, deflate")
+		}
+
+		stats.Attempts++
+		resp, err := z.httpClient.Do(req)
+		if err == nil {
+			recordResponse(ctx, resp)
+			stats.recordThrottle(resp)
+			if err = decompressResponse(resp); err != nil {
+				_ = resp.Body.Close()
+				resp = nil
 			}
 		}
-		break
-	}
 
-	for _, code := range successCodes {
-		if resp.StatusCode == code {
-			return body, nil
+		wait, retry := policy.Retry(req, resp, err, attempts)
+		if !retry {
+			return resp, err
 		}
-	}
 
-	return nil, Error{
-		body: body,
-		resp: resp,
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		stats.TotalWait += wait
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
 }
 
@@ -219,6 +363,7 @@ func (z *Client) execRequest(ctx context.Context, path string, verb string, reqB
 func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
 	out := req.WithContext(ctx)
 	z.includeHeaders(out)
+	includeContextHeaders(ctx, out)
 	if z.credential != nil {
 		if z.credential.Bearer() {
 			out.Header.Add("Authorization", "Bearer "+z.credential.Secret())
@@ -239,6 +384,10 @@ func (z *Client) includeHeaders(req *http.Request) {
 
 // addOptions build query string
 func addOptions(s string, opts any) (string, error) {
+	if err := validateOptions(opts); err != nil {
+		return s, err
+	}
+
 	u, err := url.Parse(s)
 	if err != nil {
 		return s, err
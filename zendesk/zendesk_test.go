@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -323,3 +324,55 @@ func TestAddOptions(t *testing.T) {
 		t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, u)
 	}
 }
+
+func TestSetReadOnly(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("mutating request was sent: %s %s", r.Method, r.URL)
+		}
+		w.Write(readFixture("GET/groups.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetReadOnly(true)
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+	if _, err := client.post(ctx, "/groups.json", Group{}); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, but got %v", err)
+	}
+	if _, err := client.put(ctx, "/groups/1.json", Group{}); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, but got %v", err)
+	}
+	if err := client.delete(ctx, "/groups/1.json"); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly, but got %v", err)
+	}
+}
+
+func TestSetAPIVersion(t *testing.T) {
+	client, _ := NewClient(nil)
+	if err := client.SetSubdomain("subdomain"); err != nil {
+		t.Fatal("SetSubdomain should success")
+	}
+	if u := client.baseURL.String(); u != "https://subdomain.zendesk.com/api/v2" {
+		t.Fatalf("unexpected base URL %s", u)
+	}
+
+	if err := client.SetAPIVersion("v3"); err != nil {
+		t.Fatalf("SetAPIVersion should success: %s", err)
+	}
+	if u := client.baseURL.String(); u != "https://subdomain.zendesk.com/api/v3" {
+		t.Fatalf("unexpected base URL %s", u)
+	}
+	if err := client.SetAPIVersion("../v2"); err == nil {
+		t.Fatal("SetAPIVersion should fail")
+	}
+
+	if err := client.SetEndpointURL("http://127.0.0.1:3000"); err != nil {
+		t.Fatal("SetEndpointURL should success")
+	}
+	if err := client.SetAPIVersion("v2"); err != nil || client.baseURL.String() != "http://127.0.0.1:3000" {
+		t.Fatalf("SetAPIVersion should not change endpoint URL: %s", client.baseURL)
+	}
+}
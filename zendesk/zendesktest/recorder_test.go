@@ -0,0 +1,62 @@
+package zendesktest
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func TestRecorder(t *testing.T) {
+	server := NewServer()
+	ticket := server.AddTicket(zendesk.Ticket{Subject: "Recorded", Description: "secret@example.com"})
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := NewRecorder(cassette, ModeRecord, nil)
+	if err != nil {
+		t.Fatalf("Failed to create recorder: %s", err)
+	}
+	rec.Scrub = func(i *Interaction) {
+		i.Response.Body = strings.ReplaceAll(i.Response.Body, "secret@example.com", "[email]")
+	}
+
+	client, _ := zendesk.NewClient(&http.Client{Transport: rec})
+	client.SetEndpointURL(server.URL + basePath)
+	client.SetCredential(zendesk.NewAPITokenCredential("agent@example.com", "token"))
+
+	if _, err := client.GetTicket(ctx, ticket.ID); err != nil {
+		t.Fatalf("Failed to get ticket while recording: %s", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Failed to save cassette: %s", err)
+	}
+	server.Close()
+
+	b, _ := os.ReadFile(cassette)
+	if strings.Contains(string(b), "token") || strings.Contains(string(b), "secret@example.com") {
+		t.Fatalf("cassette is not scrubbed: %s", b)
+	}
+
+	replayer, err := NewRecorder(cassette, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("Failed to load cassette: %s", err)
+	}
+
+	client, _ = zendesk.NewClient(&http.Client{Transport: replayer})
+	client.SetEndpointURL("https://example.zendesk.com" + basePath)
+
+	replayed, err := client.GetTicket(ctx, ticket.ID)
+	if err != nil {
+		t.Fatalf("Failed to replay: %s", err)
+	}
+	if replayed.Subject != "Recorded" || replayed.Description != "[email]" {
+		t.Fatalf("unexpected replayed ticket %v", replayed)
+	}
+
+	if _, err := client.GetTicket(ctx, ticket.ID); err == nil {
+		t.Fatal("interaction should be replayed only once")
+	}
+}
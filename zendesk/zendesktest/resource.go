@@ -0,0 +1,202 @@
+package zendesktest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// router serves the endpoints of a resource
+type router interface {
+	route(w http.ResponseWriter, r *http.Request, path string, newID func() int64) bool
+	records() []map[string]interface{}
+}
+
+// resource is an in-memory collection of a Zendesk resource
+type resource[T any] struct {
+	singular string
+	plural   string
+	items    map[int64]T
+	id       func(*T) *int64
+	touch    func(item *T, now time.Time, created bool)
+}
+
+func newResource[T any](singular, plural string, id func(*T) *int64, touch func(*T, time.Time, bool)) *resource[T] {
+	return &resource[T]{
+		singular: singular,
+		plural:   plural,
+		items:    map[int64]T{},
+		id:       id,
+		touch:    touch,
+	}
+}
+
+func (res *resource[T]) add(item T, id int64) T {
+	*res.id(&item) = id
+	res.touch(&item, time.Now().UTC().Truncate(time.Second), true)
+	res.items[id] = item
+	return item
+}
+
+// sorted returns items ordered by ID
+func (res *resource[T]) sorted() []T {
+	ids := make([]int64, 0, len(res.items))
+	for id := range res.items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	items := make([]T, len(ids))
+	for i, id := range ids {
+		items[i] = res.items[id]
+	}
+	return items
+}
+
+func (res *resource[T]) records() []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, item := range res.sorted() {
+		b, _ := json.Marshal(item)
+		var record map[string]interface{}
+		_ = json.Unmarshal(b, &record)
+		record["result_type"] = res.singular
+		records = append(records, record)
+	}
+	return records
+}
+
+func (res *resource[T]) route(w http.ResponseWriter, r *http.Request, path string, newID func() int64) bool {
+	collection := "/" + res.plural
+	if !strings.HasPrefix(path, collection) {
+		return false
+	}
+
+	rest := strings.TrimSuffix(strings.TrimPrefix(path, collection), ".json")
+	switch {
+	case rest == "" && r.Method == http.MethodGet:
+		res.list(w, r)
+	case rest == "" && r.Method == http.MethodPost:
+		res.create(w, r, newID())
+	case rest == "/show_many" && r.Method == http.MethodGet:
+		res.showMany(w, r)
+	case strings.HasPrefix(rest, "/") && !strings.Contains(rest[1:], "/"):
+		id, err := strconv.ParseInt(rest[1:], 10, 64)
+		if err != nil {
+			return false
+		}
+		res.member(w, r, id)
+	default:
+		return false
+	}
+	return true
+}
+
+func (res *resource[T]) list(w http.ResponseWriter, r *http.Request) {
+	sorted := res.sorted()
+	items := make([]interface{}, len(sorted))
+	for i, item := range sorted {
+		items[i] = item
+	}
+	if r.URL.Query().Has("page[size]") {
+		writeCursorPage(w, r, res.plural, items)
+		return
+	}
+	writePage(w, r, res.plural, items)
+}
+
+func (res *resource[T]) showMany(w http.ResponseWriter, r *http.Request) {
+	items := []T{}
+	for _, s := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			continue
+		}
+		if item, ok := res.items[id]; ok {
+			items = append(items, item)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{res.plural: items})
+}
+
+func (res *resource[T]) create(w http.ResponseWriter, r *http.Request, id int64) {
+	var payload map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidJSON", err.Error())
+		return
+	}
+
+	var item T
+	if err := json.Unmarshal(payload[res.singular], &item); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "RecordInvalid", err.Error())
+		return
+	}
+
+	item = res.add(item, id)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{res.singular: item})
+}
+
+func (res *resource[T]) member(w http.ResponseWriter, r *http.Request, id int64) {
+	item, ok := res.items[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "RecordNotFound", "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{res.singular: item})
+	case http.MethodPut:
+		updated, err := res.merge(item, r)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "RecordInvalid", err.Error())
+			return
+		}
+		*res.id(&updated) = id
+		res.touch(&updated, time.Now().UTC().Truncate(time.Second), false)
+		res.items[id] = updated
+		writeJSON(w, http.StatusOK, map[string]interface{}{res.singular: updated})
+	case http.MethodDelete:
+		delete(res.items, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "InvalidEndpoint", "Method not allowed")
+	}
+}
+
+// merge overlays the fields in request payload on item as Zendesk does on update
+func (res *resource[T]) merge(item T, r *http.Request) (T, error) {
+	var payload map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return item, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload[res.singular], &fields); err != nil {
+		return item, err
+	}
+
+	b, err := json.Marshal(item)
+	if err != nil {
+		return item, err
+	}
+
+	var current map[string]json.RawMessage
+	if err := json.Unmarshal(b, &current); err != nil {
+		return item, err
+	}
+	for k, v := range fields {
+		current[k] = v
+	}
+
+	b, err = json.Marshal(current)
+	if err != nil {
+		return item, err
+	}
+
+	var merged T
+	err = json.Unmarshal(b, &merged)
+	return merged, err
+}
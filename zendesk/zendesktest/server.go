@@ -0,0 +1,351 @@
+// Package zendesktest provides an in-memory fake of Zendesk API for integration tests.
+//
+// Server implements the major endpoints of tickets, users, organizations and
+// search including offset and cursor pagination, and can simulate rate limiting.
+//
+//	server := zendesktest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	ticket, err := client.CreateTicket(ctx, zendesk.Ticket{Subject: "Help"})
+package zendesktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// basePath is the path prefix of API served by Server
+const basePath = "/api/v2"
+
+// Server is an in-memory fake of Zendesk API running on httptest.Server
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	nextID        int64
+	tickets       *resource[zendesk.Ticket]
+	users         *resource[zendesk.User]
+	organizations *resource[zendesk.Organization]
+	handlers      map[string]http.HandlerFunc
+	rateLimited   int
+	retryAfter    int
+	requests      int
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		nextID:   1,
+		handlers: map[string]http.HandlerFunc{},
+		tickets: newResource("ticket", "tickets",
+			func(t *zendesk.Ticket) *int64 { return &t.ID },
+			func(t *zendesk.Ticket, now time.Time, created bool) {
+				if created {
+					t.CreatedAt = &now
+				}
+				t.UpdatedAt = &now
+			}),
+		users: newResource("user", "users",
+			func(u *zendesk.User) *int64 { return &u.ID },
+			func(u *zendesk.User, now time.Time, created bool) {
+				if created {
+					u.CreatedAt = now
+				}
+				u.UpdatedAt = now
+			}),
+		organizations: newResource("organization", "organizations",
+			func(o *zendesk.Organization) *int64 { return &o.ID },
+			func(o *zendesk.Organization, now time.Time, created bool) {
+				if created {
+					o.CreatedAt = now
+				}
+				o.UpdatedAt = now
+			}),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns zendesk.Client configured to send requests to the server
+func (s *Server) Client() *zendesk.Client {
+	client, _ := zendesk.NewClient(s.Server.Client())
+	_ = client.SetEndpointURL(s.URL + basePath)
+	client.SetCredential(zendesk.NewAPITokenCredential("agent@example.com", "token"))
+	return client
+}
+
+// HandleFunc overrides the response for requests with method and path.
+// path is relative to /api/v2, e.g. "/ticket_fields.json".
+func (s *Server) HandleFunc(method string, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method+" "+path] = handler
+}
+
+// SimulateRateLimit makes the server respond 429 Too Many Requests
+// with Retry-After header to the next n requests.
+func (s *Server) SimulateRateLimit(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimited = n
+	s.retryAfter = int(retryAfter / time.Second)
+}
+
+// Requests returns the number of requests the server received
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// AddTicket stores ticket to the server and returns it with ID assigned
+func (s *Server) AddTicket(ticket zendesk.Ticket) zendesk.Ticket {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tickets.add(ticket, s.newID())
+}
+
+// Ticket returns the stored ticket
+func (s *Server) Ticket(id int64) (zendesk.Ticket, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tickets.items[id]
+	return t, ok
+}
+
+// AddUser stores user to the server and returns it with ID assigned
+func (s *Server) AddUser(user zendesk.User) zendesk.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.users.add(user, s.newID())
+}
+
+// User returns the stored user
+func (s *Server) User(id int64) (zendesk.User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users.items[id]
+	return u, ok
+}
+
+// AddOrganization stores organization to the server and returns it with ID assigned
+func (s *Server) AddOrganization(org zendesk.Organization) zendesk.Organization {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.organizations.add(org, s.newID())
+}
+
+// Organization returns the stored organization
+func (s *Server) Organization(id int64) (zendesk.Organization, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.organizations.items[id]
+	return o, ok
+}
+
+func (s *Server) newID() int64 {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+
+	if s.rateLimited > 0 {
+		s.rateLimited--
+		w.Header().Set("Retry-After", strconv.Itoa(s.retryAfter))
+		writeError(w, http.StatusTooManyRequests, "TooManyRequests", "Rate limit exceeded")
+		s.mu.Unlock()
+		return
+	}
+
+	// custom handlers run without the lock, so they can call the methods of
+	// the server and block without stalling other requests
+	path := strings.TrimPrefix(r.URL.Path, basePath)
+	if h, ok := s.handlers[r.Method+" "+path]; ok {
+		s.mu.Unlock()
+		h(w, r)
+		return
+	}
+	defer s.mu.Unlock()
+
+	switch {
+	case path == "/search.json" && r.Method == http.MethodGet:
+		s.search(w, r)
+		return
+	case path == "/search/count.json" && r.Method == http.MethodGet:
+		s.searchCount(w, r)
+		return
+	}
+
+	for _, res := range []router{s.tickets, s.users, s.organizations} {
+		if res.route(w, r, path, s.newID) {
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "InvalidEndpoint", "Not found")
+}
+
+// searchable returns all stored records as JSON objects with result_type
+func (s *Server) searchable() []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, res := range []router{s.tickets, s.users, s.organizations} {
+		records = append(records, res.records()...)
+	}
+	return records
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	results := filterRecords(s.searchable(), q.Get("query"))
+
+	items := make([]interface{}, len(results))
+	for i, r := range results {
+		items[i] = r
+	}
+	writePage(w, r, "results", items)
+}
+
+func (s *Server) searchCount(w http.ResponseWriter, r *http.Request) {
+	results := filterRecords(s.searchable(), r.URL.Query().Get("query"))
+	writeJSON(w, http.StatusOK, map[string]interface{}{"count": len(results)})
+}
+
+// filterRecords returns records matching search query. The query supports
+// "key:value" terms which match the field exactly ("type" matches result_type)
+// and free text terms which match any string field partially.
+func filterRecords(records []map[string]interface{}, query string) []map[string]interface{} {
+	var results []map[string]interface{}
+	for _, record := range records {
+		if matchRecord(record, strings.Fields(query)) {
+			results = append(results, record)
+		}
+	}
+	return results
+}
+
+func matchRecord(record map[string]interface{}, terms []string) bool {
+	for _, term := range terms {
+		if key, value, ok := strings.Cut(term, ":"); ok {
+			if key == "type" {
+				key = "result_type"
+			}
+			if fmt.Sprint(record[key]) != value {
+				return false
+			}
+			continue
+		}
+
+		found := false
+		for _, v := range record {
+			if s, ok := v.(string); ok && strings.Contains(strings.ToLower(s), strings.ToLower(term)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// writePage writes items paginated with page and per_page query parameters
+func writePage(w http.ResponseWriter, r *http.Request, key string, items []interface{}) {
+	q := r.URL.Query()
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(q.Get("per_page"))
+	if perPage < 1 || perPage > 100 {
+		perPage = 100
+	}
+
+	start := (page - 1) * perPage
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	pageURL := func(p int) *string {
+		u := *r.URL
+		u.Scheme = "http"
+		u.Host = r.Host
+		v := u.Query()
+		v.Set("page", strconv.Itoa(p))
+		v.Set("per_page", strconv.Itoa(perPage))
+		u.RawQuery = v.Encode()
+		s := u.String()
+		return &s
+	}
+
+	var next, prev *string
+	if end < len(items) {
+		next = pageURL(page + 1)
+	}
+	if page > 1 {
+		prev = pageURL(page - 1)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key:             items[start:end],
+		"next_page":     next,
+		"previous_page": prev,
+		"count":         len(items),
+	})
+}
+
+// writeCursorPage writes items paginated with page[size] and page[after] query
+// parameters. The cursor is the index of the next item.
+func writeCursorPage(w http.ResponseWriter, r *http.Request, key string, items []interface{}) {
+	q := r.URL.Query()
+	size, _ := strconv.Atoi(q.Get("page[size]"))
+	if size < 1 || size > 100 {
+		size = 100
+	}
+	start, _ := strconv.Atoi(q.Get("page[after]"))
+	if start < 0 || start > len(items) {
+		start = len(items)
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+
+	meta := map[string]interface{}{"has_more": end < len(items)}
+	if end < len(items) {
+		meta["after_cursor"] = strconv.Itoa(end)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		key:    items[start:end],
+		"meta": meta,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, title string, description string) {
+	writeJSON(w, status, map[string]string{
+		"error":       title,
+		"description": description,
+	})
+}
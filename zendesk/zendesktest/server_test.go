@@ -0,0 +1,185 @@
+package zendesktest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+var ctx = context.Background()
+
+func TestTicketLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	created, err := client.CreateTicket(ctx, zendesk.Ticket{
+		Subject: "Printer is on fire",
+		Status:  "new",
+		Comment: &zendesk.TicketComment{Body: "Help"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	if created.ID == 0 || created.CreatedAt == nil {
+		t.Fatalf("created ticket does not have ID and timestamps: %v", created)
+	}
+
+	updated, err := client.UpdateTicket(ctx, created.ID, zendesk.Ticket{Status: "open"})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+	if updated.Status != "open" || updated.Subject != created.Subject {
+		t.Fatalf("update was not merged: %v", updated)
+	}
+
+	fetched, err := client.GetTicket(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+	if fetched.Status != "open" {
+		t.Fatalf("expected status open, but got %s", fetched.Status)
+	}
+
+	if err := client.DeleteTicket(ctx, created.ID); err != nil {
+		t.Fatalf("Failed to delete ticket: %s", err)
+	}
+
+	_, err = client.GetTicket(ctx, created.ID)
+	if zerr, ok := err.(zendesk.Error); !ok || zerr.Status() != http.StatusNotFound {
+		t.Fatalf("expected 404 error, but got %v", err)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for i := 0; i < 5; i++ {
+		server.AddUser(zendesk.User{Name: "user"})
+	}
+
+	users, err := zendesk.ListAll(ctx, zendesk.OffsetLister(func(ctx context.Context, opts zendesk.PageOptions) ([]zendesk.User, zendesk.Page, error) {
+		return client.GetUsers(ctx, &zendesk.UserListOptions{PageOptions: opts})
+	}), zendesk.PageToken{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Failed to list users: %s", err)
+	}
+	if len(users) != 5 {
+		t.Fatalf("expected 5 users, but got %d", len(users))
+	}
+	if server.Requests() != 3 {
+		t.Fatalf("expected 3 requests, but got %d", server.Requests())
+	}
+}
+
+func TestShowMany(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	a := server.AddTicket(zendesk.Ticket{Subject: "a"})
+	server.AddTicket(zendesk.Ticket{Subject: "b"})
+	c := server.AddTicket(zendesk.Ticket{Subject: "c"})
+
+	tickets, err := client.GetMultipleTickets(ctx, []int64{a.ID, c.ID})
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected 2 tickets, but got %d", len(tickets))
+	}
+}
+
+func TestSearch(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.AddTicket(zendesk.Ticket{Subject: "Refund request", Status: "open"})
+	server.AddTicket(zendesk.Ticket{Subject: "Refund processed", Status: "solved"})
+	server.AddOrganization(zendesk.Organization{Name: "Refund Inc"})
+
+	results, _, err := client.Search(ctx, &zendesk.SearchOptions{Query: "type:ticket status:open refund"})
+	if err != nil {
+		t.Fatalf("Failed to search: %s", err)
+	}
+	if len(results.List()) != 1 {
+		t.Fatalf("expected 1 result, but got %d", len(results.List()))
+	}
+	if _, ok := results.List()[0].(zendesk.Ticket); !ok {
+		t.Fatalf("expected ticket, but got %T", results.List()[0])
+	}
+
+	count, err := client.SearchCount(ctx, &zendesk.CountOptions{Query: "refund"})
+	if err != nil {
+		t.Fatalf("Failed to count: %s", err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 results, but got %d", count)
+	}
+}
+
+func TestSimulateRateLimit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	org := server.AddOrganization(zendesk.Organization{Name: "Acme"})
+	server.SimulateRateLimit(1, time.Second)
+
+	fetched, err := client.GetOrganization(ctx, org.ID)
+	if err != nil {
+		t.Fatalf("Client should retry rate limited request: %s", err)
+	}
+	if fetched.Name != "Acme" {
+		t.Fatalf("unexpected organization %v", fetched)
+	}
+	if server.Requests() != 2 {
+		t.Fatalf("expected 2 requests, but got %d", server.Requests())
+	}
+}
+
+func TestHandleFunc(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.HandleFunc(http.MethodGet, "/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups":[{"id":1,"name":"Support"}]}`))
+	})
+
+	groups, _, err := client.GetGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "Support" {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+}
+
+func TestHandleFuncCallsServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.HandleFunc(http.MethodGet, "/groups.json", func(w http.ResponseWriter, r *http.Request) {
+		// the handler must not deadlock on the server and its endpoints
+		server.AddUser(zendesk.User{Name: "Alice"})
+		if _, _, err := client.GetUsers(r.Context(), nil); err != nil {
+			t.Errorf("Failed to get users in handler: %s", err)
+		}
+		w.Write([]byte(`{"groups":[]}`))
+	})
+
+	if _, _, err := client.GetGroups(ctx, nil); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+	if server.Requests() != 2 {
+		t.Fatalf("expected 2 requests, but got %d", server.Requests())
+	}
+}